@@ -1,21 +1,48 @@
 package main
 
 import (
+	"context"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/alerts"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/config"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/db"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/jobs"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/storage"
 )
 
 func main() {
 	// First, we grab the config from the env
 	cfg := config.NewConfig()
 
-	// Next, we grab access to the database using the config
-	gr, err := db.NewDB(cfg.DBConfig)
+	// Stock changes are watched for anything dropping below its reorder point
+	evaluator := alerts.NewFromConfig(cfg.Alerts)
+
+	// Next, we grab access to the database using the config. Tax on sales orders is worked out
+	// from the tax rates kept there, pass a tax.Calculator in place of nil to use an outside service.
+	gr, err := db.NewDB(cfg.DBConfig, nil, evaluator)
+	if err != nil {
+		panic(err)
+	}
+
+	// Then, where uploaded files are kept
+	store, err := storage.New(cfg.Storage)
 	if err != nil {
 		panic(err)
 	}
 
+	// Stock is snapshotted every night so it can be looked up as of any time
+	if cfg.Jobs.Snapshot != "" {
+		at, err := jobs.ParseTimeOfDay(cfg.Jobs.Snapshot)
+		if err != nil {
+			panic(err)
+		}
+		go jobs.Daily(context.Background(), "stock snapshot", at, func(ctx context.Context) error {
+			_, err := gr.Snapshots().Take(ctx)
+			return err
+		})
+	}
+
 	// Now, we start the server
-	api.StartServer(cfg.Port, gr)
+	api.StartServer(cfg.Port, gr, store)
 }
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS product_barcodes (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id     BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,code           TEXT NOT NULL
+    ,gtin           TEXT NOT NULL
+    ,type           TEXT NOT NULL
+    ,pack_size      INTEGER NOT NULL DEFAULT 1
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(gtin)
+);
+
+CREATE INDEX product_barcodes_product_id_idx ON product_barcodes (product_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS product_barcodes;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS product_attachments (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id     BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,file_name      TEXT NOT NULL
+    ,content_type   TEXT NOT NULL
+    ,size           BIGINT NOT NULL DEFAULT 0
+    ,storage_key    TEXT NOT NULL
+    ,thumbnail_key  TEXT
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,UNIQUE(storage_key)
+);
+
+CREATE INDEX product_attachments_product_id_idx ON product_attachments (product_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS product_attachments;
@@ -0,0 +1,32 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN reorder_point INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN reorder_qty INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS locations (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,name           TEXT NOT NULL
+    ,code           TEXT NOT NULL
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(code)
+);
+
+CREATE TABLE IF NOT EXISTS stock_levels (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id     BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,location_id    BIGINT NOT NULL REFERENCES locations(id) ON DELETE RESTRICT
+    ,qty            INTEGER NOT NULL DEFAULT 0 CHECK (qty >= 0)
+    ,reorder_point  INTEGER NOT NULL DEFAULT 0
+    ,reorder_qty    INTEGER NOT NULL DEFAULT 0
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(product_id, location_id)
+);
+
+CREATE INDEX stock_levels_location_id_idx ON stock_levels (location_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS stock_levels;
+DROP TABLE IF EXISTS locations;
+ALTER TABLE products DROP COLUMN IF EXISTS reorder_qty;
+ALTER TABLE products DROP COLUMN IF EXISTS reorder_point;
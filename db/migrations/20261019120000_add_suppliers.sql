@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS suppliers (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,name           TEXT NOT NULL
+    ,code           TEXT NOT NULL
+    ,contact_name   TEXT
+    ,email          TEXT
+    ,phone          TEXT
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(code)
+);
+
+CREATE TABLE IF NOT EXISTS product_suppliers (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id     BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,supplier_id    BIGINT NOT NULL REFERENCES suppliers(id) ON DELETE CASCADE
+    ,supplier_sku   TEXT
+    ,unit_cost      BIGINT NOT NULL DEFAULT 0
+    ,min_order_qty  INTEGER NOT NULL DEFAULT 1
+    ,lead_time_days INTEGER NOT NULL DEFAULT 0
+    ,preferred      BOOLEAN NOT NULL DEFAULT FALSE
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(product_id, supplier_id)
+);
+
+CREATE INDEX product_suppliers_supplier_id_idx ON product_suppliers (supplier_id);
+CREATE UNIQUE INDEX product_suppliers_preferred_idx ON product_suppliers (product_id) WHERE preferred;
+
+-- +goose Down
+DROP TABLE IF EXISTS product_suppliers;
+DROP TABLE IF EXISTS suppliers;
@@ -0,0 +1,34 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN on_order INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS purchase_orders (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,supplier_id    BIGINT NOT NULL REFERENCES suppliers(id) ON DELETE RESTRICT
+    ,status         TEXT NOT NULL DEFAULT 'draft'
+    ,expected_at    TIMESTAMP WITH TIME ZONE
+    ,notes          TEXT
+    ,submitted_at   TIMESTAMP WITH TIME ZONE
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX purchase_orders_supplier_id_idx ON purchase_orders (supplier_id);
+CREATE INDEX purchase_orders_status_idx ON purchase_orders (status);
+
+CREATE TABLE IF NOT EXISTS purchase_order_lines (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,purchase_order_id  BIGINT NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty_ordered        INTEGER NOT NULL CHECK (qty_ordered > 0)
+    ,qty_received       INTEGER NOT NULL DEFAULT 0
+    ,unit_cost          BIGINT NOT NULL DEFAULT 0
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX purchase_order_lines_purchase_order_id_idx ON purchase_order_lines (purchase_order_id);
+CREATE INDEX purchase_order_lines_product_id_idx ON purchase_order_lines (product_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS purchase_order_lines;
+DROP TABLE IF EXISTS purchase_orders;
+ALTER TABLE products DROP COLUMN IF EXISTS on_order;
@@ -0,0 +1,30 @@
+-- +goose Up
+ALTER TABLE purchase_order_lines ADD COLUMN closed BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS receipts (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,purchase_order_id  BIGINT NOT NULL REFERENCES purchase_orders(id) ON DELETE RESTRICT
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE RESTRICT
+    ,notes              TEXT
+    ,received_at        TIMESTAMP WITH TIME ZONE NOT NULL
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX receipts_purchase_order_id_idx ON receipts (purchase_order_id);
+
+CREATE TABLE IF NOT EXISTS receipt_lines (
+    id                      BIGSERIAL NOT NULL PRIMARY KEY
+    ,receipt_id             BIGINT NOT NULL REFERENCES receipts(id) ON DELETE CASCADE
+    ,purchase_order_line_id BIGINT NOT NULL REFERENCES purchase_order_lines(id) ON DELETE RESTRICT
+    ,product_id             BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty_received           INTEGER NOT NULL CHECK (qty_received >= 0)
+    ,qty_damaged            INTEGER NOT NULL DEFAULT 0 CHECK (qty_damaged >= 0 AND qty_damaged <= qty_received)
+    ,created_at             TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX receipt_lines_receipt_id_idx ON receipt_lines (receipt_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS receipt_lines;
+DROP TABLE IF EXISTS receipts;
+ALTER TABLE purchase_order_lines DROP COLUMN IF EXISTS closed;
@@ -0,0 +1,36 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN allocated INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD CONSTRAINT products_allocated_check CHECK (allocated >= 0 AND allocated <= qty);
+
+CREATE TABLE IF NOT EXISTS sales_orders (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,customer_name  TEXT NOT NULL
+    ,reference      TEXT
+    ,status         TEXT NOT NULL DEFAULT 'draft'
+    ,notes          TEXT
+    ,confirmed_at   TIMESTAMP WITH TIME ZONE
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX sales_orders_status_idx ON sales_orders (status);
+
+CREATE TABLE IF NOT EXISTS sales_order_lines (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,sales_order_id     BIGINT NOT NULL REFERENCES sales_orders(id) ON DELETE CASCADE
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty                INTEGER NOT NULL CHECK (qty > 0)
+    ,qty_allocated      INTEGER NOT NULL DEFAULT 0 CHECK (qty_allocated >= 0 AND qty_allocated <= qty)
+    ,backorder          BOOLEAN NOT NULL DEFAULT FALSE
+    ,unit_price         BIGINT NOT NULL DEFAULT 0
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX sales_order_lines_sales_order_id_idx ON sales_order_lines (sales_order_id);
+CREATE INDEX sales_order_lines_product_id_idx ON sales_order_lines (product_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS sales_order_lines;
+DROP TABLE IF EXISTS sales_orders;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_allocated_check;
+ALTER TABLE products DROP COLUMN IF EXISTS allocated;
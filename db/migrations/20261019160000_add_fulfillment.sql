@@ -0,0 +1,71 @@
+-- +goose Up
+ALTER TABLE sales_order_lines ADD COLUMN qty_shipped INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sales_order_lines ADD CONSTRAINT sales_order_lines_filled_check CHECK (qty_allocated + qty_shipped <= qty);
+
+CREATE TABLE IF NOT EXISTS pick_lists (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,sales_order_id     BIGINT NOT NULL REFERENCES sales_orders(id) ON DELETE RESTRICT
+    ,status             TEXT NOT NULL DEFAULT 'open'
+    ,picked_at          TIMESTAMP WITH TIME ZONE
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX pick_lists_sales_order_id_idx ON pick_lists (sales_order_id);
+
+CREATE TABLE IF NOT EXISTS pick_list_lines (
+    id                      BIGSERIAL NOT NULL PRIMARY KEY
+    ,pick_list_id           BIGINT NOT NULL REFERENCES pick_lists(id) ON DELETE CASCADE
+    ,sales_order_line_id    BIGINT NOT NULL REFERENCES sales_order_lines(id) ON DELETE RESTRICT
+    ,product_id             BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,location_id            BIGINT REFERENCES locations(id) ON DELETE RESTRICT
+    ,qty                    INTEGER NOT NULL CHECK (qty > 0)
+    ,qty_picked             INTEGER NOT NULL DEFAULT 0 CHECK (qty_picked >= 0 AND qty_picked <= qty)
+    ,created_at             TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX pick_list_lines_pick_list_id_idx ON pick_list_lines (pick_list_id);
+
+CREATE TABLE IF NOT EXISTS shipments (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,sales_order_id     BIGINT NOT NULL REFERENCES sales_orders(id) ON DELETE RESTRICT
+    ,pick_list_id       BIGINT NOT NULL REFERENCES pick_lists(id) ON DELETE RESTRICT
+    ,status             TEXT NOT NULL DEFAULT 'packed'
+    ,carrier            TEXT
+    ,tracking_number    TEXT
+    ,shipped_at         TIMESTAMP WITH TIME ZONE
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX shipments_sales_order_id_idx ON shipments (sales_order_id);
+
+CREATE TABLE IF NOT EXISTS shipment_cartons (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,shipment_id        BIGINT NOT NULL REFERENCES shipments(id) ON DELETE CASCADE
+    ,reference          TEXT NOT NULL
+    ,weight_grams       INTEGER NOT NULL DEFAULT 0
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX shipment_cartons_shipment_id_idx ON shipment_cartons (shipment_id);
+
+CREATE TABLE IF NOT EXISTS shipment_carton_items (
+    id                      BIGSERIAL NOT NULL PRIMARY KEY
+    ,carton_id              BIGINT NOT NULL REFERENCES shipment_cartons(id) ON DELETE CASCADE
+    ,pick_list_line_id      BIGINT NOT NULL REFERENCES pick_list_lines(id) ON DELETE RESTRICT
+    ,sales_order_line_id    BIGINT NOT NULL REFERENCES sales_order_lines(id) ON DELETE RESTRICT
+    ,product_id             BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty                    INTEGER NOT NULL CHECK (qty > 0)
+);
+
+CREATE INDEX shipment_carton_items_carton_id_idx ON shipment_carton_items (carton_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS shipment_carton_items;
+DROP TABLE IF EXISTS shipment_cartons;
+DROP TABLE IF EXISTS shipments;
+DROP TABLE IF EXISTS pick_list_lines;
+DROP TABLE IF EXISTS pick_lists;
+ALTER TABLE sales_order_lines DROP CONSTRAINT IF EXISTS sales_order_lines_filled_check;
+ALTER TABLE sales_order_lines DROP COLUMN IF EXISTS qty_shipped;
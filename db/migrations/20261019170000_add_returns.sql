@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS returns (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,sales_order_id     BIGINT NOT NULL REFERENCES sales_orders(id) ON DELETE RESTRICT
+    ,status             TEXT NOT NULL DEFAULT 'authorized'
+    ,reason             TEXT
+    ,notes              TEXT
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX returns_sales_order_id_idx ON returns (sales_order_id);
+CREATE INDEX returns_status_idx ON returns (status);
+
+CREATE TABLE IF NOT EXISTS return_lines (
+    id                      BIGSERIAL NOT NULL PRIMARY KEY
+    ,return_id              BIGINT NOT NULL REFERENCES returns(id) ON DELETE CASCADE
+    ,sales_order_line_id    BIGINT NOT NULL REFERENCES sales_order_lines(id) ON DELETE RESTRICT
+    ,product_id             BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty_authorized         INTEGER NOT NULL CHECK (qty_authorized > 0)
+    ,qty_received           INTEGER NOT NULL DEFAULT 0 CHECK (qty_received >= 0 AND qty_received <= qty_authorized)
+    ,qty_restocked          INTEGER NOT NULL DEFAULT 0 CHECK (qty_restocked >= 0)
+    ,qty_quarantined        INTEGER NOT NULL DEFAULT 0 CHECK (qty_quarantined >= 0)
+    ,qty_scrapped           INTEGER NOT NULL DEFAULT 0 CHECK (qty_scrapped >= 0)
+    ,created_at             TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,CONSTRAINT return_lines_disposed_check CHECK (qty_restocked + qty_quarantined + qty_scrapped = qty_received)
+);
+
+CREATE INDEX return_lines_return_id_idx ON return_lines (return_id);
+CREATE INDEX return_lines_sales_order_line_id_idx ON return_lines (sales_order_line_id);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE SET NULL
+    ,qty                INTEGER NOT NULL CHECK (qty <> 0)
+    ,reason             TEXT NOT NULL
+    ,reference_type     TEXT
+    ,reference_id       BIGINT
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX stock_movements_product_id_idx ON stock_movements (product_id, created_at);
+CREATE INDEX stock_movements_reference_idx ON stock_movements (reference_type, reference_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS stock_movements;
+DROP TABLE IF EXISTS return_lines;
+DROP TABLE IF EXISTS returns;
@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN in_transit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE products ADD CONSTRAINT products_in_transit_check CHECK (in_transit >= 0 AND in_transit <= qty);
+
+CREATE TABLE IF NOT EXISTS transfers (
+    id                          BIGSERIAL NOT NULL PRIMARY KEY
+    ,source_location_id         BIGINT NOT NULL REFERENCES locations(id) ON DELETE RESTRICT
+    ,destination_location_id    BIGINT NOT NULL REFERENCES locations(id) ON DELETE RESTRICT
+    ,status                     TEXT NOT NULL DEFAULT 'draft'
+    ,notes                      TEXT
+    ,shipped_at                 TIMESTAMP WITH TIME ZONE
+    ,received_at                TIMESTAMP WITH TIME ZONE
+    ,created_at                 TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at                 TIMESTAMP WITH TIME ZONE
+    ,CONSTRAINT transfers_locations_check CHECK (source_location_id <> destination_location_id)
+);
+
+CREATE INDEX transfers_status_idx ON transfers (status);
+
+CREATE TABLE IF NOT EXISTS transfer_lines (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,transfer_id        BIGINT NOT NULL REFERENCES transfers(id) ON DELETE CASCADE
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty                INTEGER NOT NULL CHECK (qty > 0)
+    ,qty_shipped        INTEGER NOT NULL DEFAULT 0 CHECK (qty_shipped >= 0 AND qty_shipped <= qty)
+    ,qty_received       INTEGER NOT NULL DEFAULT 0 CHECK (qty_received >= 0)
+    ,qty_lost           INTEGER NOT NULL DEFAULT 0 CHECK (qty_lost >= 0)
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,CONSTRAINT transfer_lines_in_transit_check CHECK (qty_received + qty_lost <= qty_shipped)
+);
+
+CREATE INDEX transfer_lines_transfer_id_idx ON transfer_lines (transfer_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS transfer_lines;
+DROP TABLE IF EXISTS transfers;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_in_transit_check;
+ALTER TABLE products DROP COLUMN IF EXISTS in_transit;
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS count_sessions (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,name           TEXT
+    ,notes          TEXT
+    ,blind          BOOLEAN NOT NULL DEFAULT FALSE
+    ,status         TEXT NOT NULL DEFAULT 'open'
+    ,posted_at      TIMESTAMP WITH TIME ZONE
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX count_sessions_status_idx ON count_sessions (status);
+
+CREATE TABLE IF NOT EXISTS count_lines (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,count_session_id   BIGINT NOT NULL REFERENCES count_sessions(id) ON DELETE CASCADE
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,location_id        BIGINT NOT NULL REFERENCES locations(id) ON DELETE RESTRICT
+    ,expected_qty       INTEGER NOT NULL CHECK (expected_qty >= 0)
+    ,counted_qty        INTEGER CHECK (counted_qty >= 0)
+    ,variance           INTEGER
+    ,approved           BOOLEAN NOT NULL DEFAULT FALSE
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE
+    ,UNIQUE(count_session_id, product_id, location_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS count_lines;
+DROP TABLE IF EXISTS count_sessions;
@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN lot_tracked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS lots (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,lot_number         TEXT NOT NULL
+    ,manufactured_at    TIMESTAMP WITH TIME ZONE
+    ,expires_at         TIMESTAMP WITH TIME ZONE
+    ,qty                INTEGER NOT NULL DEFAULT 0 CHECK (qty >= 0)
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE
+    ,UNIQUE(product_id, lot_number)
+    ,CONSTRAINT lots_dates_check CHECK (manufactured_at IS NULL OR expires_at IS NULL OR manufactured_at < expires_at)
+);
+
+CREATE INDEX lots_expires_at_idx ON lots (expires_at) WHERE qty > 0;
+
+ALTER TABLE receipt_lines ADD COLUMN lot_id BIGINT REFERENCES lots(id) ON DELETE RESTRICT;
+ALTER TABLE pick_list_lines ADD COLUMN lot_id BIGINT REFERENCES lots(id) ON DELETE RESTRICT;
+
+-- +goose Down
+ALTER TABLE pick_list_lines DROP COLUMN IF EXISTS lot_id;
+ALTER TABLE receipt_lines DROP COLUMN IF EXISTS lot_id;
+DROP TABLE IF EXISTS lots;
+ALTER TABLE products DROP COLUMN IF EXISTS lot_tracked;
@@ -0,0 +1,33 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN serialized BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS serials (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,serial_number      TEXT NOT NULL UNIQUE
+    ,status             TEXT NOT NULL
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE SET NULL
+    ,pick_list_line_id  BIGINT REFERENCES pick_list_lines(id) ON DELETE SET NULL
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX serials_product_id_idx ON serials (product_id);
+CREATE INDEX serials_pick_list_line_id_idx ON serials (pick_list_line_id);
+
+CREATE TABLE IF NOT EXISTS serial_events (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,serial_id          BIGINT NOT NULL REFERENCES serials(id) ON DELETE CASCADE
+    ,status             TEXT NOT NULL
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE SET NULL
+    ,reference_type     TEXT
+    ,reference_id       BIGINT
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX serial_events_serial_id_idx ON serial_events (serial_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS serial_events;
+DROP TABLE IF EXISTS serials;
+ALTER TABLE products DROP COLUMN IF EXISTS serialized;
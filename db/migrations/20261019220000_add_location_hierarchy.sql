@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE locations ADD COLUMN parent_id BIGINT REFERENCES locations(id) ON DELETE RESTRICT;
+ALTER TABLE locations ADD COLUMN kind TEXT NOT NULL DEFAULT 'warehouse';
+ALTER TABLE locations ADD COLUMN pick_sequence INTEGER NOT NULL DEFAULT 0 CHECK (pick_sequence >= 0);
+
+CREATE INDEX locations_parent_id_idx ON locations (parent_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS locations_parent_id_idx;
+ALTER TABLE locations DROP COLUMN IF EXISTS pick_sequence;
+ALTER TABLE locations DROP COLUMN IF EXISTS kind;
+ALTER TABLE locations DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN kit BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS kit_components (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,kit_id             BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,component_id       BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty                INTEGER NOT NULL CHECK (qty > 0)
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,UNIQUE(kit_id, component_id)
+);
+
+CREATE INDEX kit_components_component_id_idx ON kit_components (component_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS kit_components;
+ALTER TABLE products DROP COLUMN IF EXISTS kit;
@@ -0,0 +1,43 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS bom_lines (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,component_id       BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty                INTEGER NOT NULL CHECK (qty > 0)
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,UNIQUE(product_id, component_id)
+);
+
+CREATE TABLE IF NOT EXISTS work_orders (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty                INTEGER NOT NULL CHECK (qty > 0)
+    ,qty_completed      INTEGER NOT NULL DEFAULT 0 CHECK (qty_completed >= 0)
+    ,qty_scrapped       INTEGER NOT NULL DEFAULT 0 CHECK (qty_scrapped >= 0)
+    ,status             TEXT NOT NULL
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE SET NULL
+    ,notes              TEXT
+    ,completed_at       TIMESTAMP WITH TIME ZONE
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE
+    ,CHECK (qty_completed + qty_scrapped <= qty)
+);
+
+CREATE INDEX work_orders_product_id_idx ON work_orders (product_id);
+
+CREATE TABLE IF NOT EXISTS work_order_components (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,work_order_id      BIGINT NOT NULL REFERENCES work_orders(id) ON DELETE CASCADE
+    ,component_id       BIGINT NOT NULL REFERENCES products(id) ON DELETE RESTRICT
+    ,qty_per            INTEGER NOT NULL CHECK (qty_per > 0)
+    ,qty_reserved       INTEGER NOT NULL DEFAULT 0 CHECK (qty_reserved >= 0)
+    ,qty_consumed       INTEGER NOT NULL DEFAULT 0 CHECK (qty_consumed >= 0)
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX work_order_components_work_order_id_idx ON work_order_components (work_order_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS work_order_components;
+DROP TABLE IF EXISTS work_orders;
+DROP TABLE IF EXISTS bom_lines;
@@ -0,0 +1,57 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN category TEXT;
+ALTER TABLE products ADD COLUMN cost_method TEXT NOT NULL DEFAULT 'fifo';
+ALTER TABLE products ADD COLUMN standard_cost BIGINT NOT NULL DEFAULT 0 CHECK (standard_cost >= 0);
+
+CREATE INDEX products_category_idx ON products (category);
+
+CREATE TABLE IF NOT EXISTS cost_layers (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,qty                INTEGER NOT NULL CHECK (qty > 0)
+    ,remaining          INTEGER NOT NULL CHECK (remaining >= 0)
+    ,unit_cost          BIGINT NOT NULL CHECK (unit_cost >= 0)
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,CHECK (remaining <= qty)
+);
+
+CREATE INDEX cost_layers_open_idx ON cost_layers (product_id, id) WHERE remaining > 0;
+
+CREATE TABLE IF NOT EXISTS valuation_entries (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE SET NULL
+    ,qty                INTEGER NOT NULL
+    ,value              BIGINT NOT NULL
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX valuation_entries_product_id_created_at_idx ON valuation_entries (product_id, created_at);
+
+-- stock already on hand opens at the preferred supplier's cost, or nothing when there isn't one
+INSERT INTO cost_layers (product_id, qty, remaining, unit_cost)
+SELECT p.id, p.qty, p.qty, COALESCE(ps.unit_cost, 0)
+FROM products p
+LEFT JOIN product_suppliers ps ON ps.product_id = p.id AND ps.preferred
+WHERE p.qty > 0;
+
+INSERT INTO valuation_entries (product_id, location_id, qty, value)
+SELECT s.product_id, s.location_id, s.qty, s.qty * COALESCE(ps.unit_cost, 0)
+FROM stock_levels s
+LEFT JOIN product_suppliers ps ON ps.product_id = s.product_id AND ps.preferred
+WHERE s.qty > 0;
+
+INSERT INTO valuation_entries (product_id, location_id, qty, value)
+SELECT p.id, NULL, p.qty - COALESCE(s.qty, 0), (p.qty - COALESCE(s.qty, 0)) * COALESCE(ps.unit_cost, 0)
+FROM products p
+LEFT JOIN (SELECT product_id, SUM(qty) AS qty FROM stock_levels GROUP BY product_id) s ON s.product_id = p.id
+LEFT JOIN product_suppliers ps ON ps.product_id = p.id AND ps.preferred
+WHERE p.qty - COALESCE(s.qty, 0) > 0;
+
+-- +goose Down
+DROP TABLE IF EXISTS valuation_entries;
+DROP TABLE IF EXISTS cost_layers;
+DROP INDEX IF EXISTS products_category_idx;
+ALTER TABLE products DROP COLUMN IF EXISTS standard_cost;
+ALTER TABLE products DROP COLUMN IF EXISTS cost_method;
+ALTER TABLE products DROP COLUMN IF EXISTS category;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS stock_snapshots (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,taken_at           TIMESTAMP WITH TIME ZONE NOT NULL
+    ,lines              INTEGER NOT NULL DEFAULT 0
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX stock_snapshots_taken_at_idx ON stock_snapshots (taken_at);
+
+CREATE TABLE IF NOT EXISTS stock_snapshot_lines (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,snapshot_id        BIGINT NOT NULL REFERENCES stock_snapshots(id) ON DELETE CASCADE
+    ,product_id         BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE
+    ,location_id        BIGINT REFERENCES locations(id) ON DELETE SET NULL
+    ,qty                INTEGER NOT NULL
+);
+
+CREATE INDEX stock_snapshot_lines_snapshot_id_product_id_idx ON stock_snapshot_lines (snapshot_id, product_id);
+CREATE INDEX valuation_entries_created_at_idx ON valuation_entries (created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS valuation_entries_created_at_idx;
+DROP TABLE IF EXISTS stock_snapshot_lines;
+DROP TABLE IF EXISTS stock_snapshots;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE valuation_entries ADD COLUMN move BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX valuation_entries_used_idx ON valuation_entries (product_id, created_at) WHERE qty < 0 AND NOT move;
+
+-- +goose Down
+DROP INDEX IF EXISTS valuation_entries_used_idx;
+ALTER TABLE valuation_entries DROP COLUMN IF EXISTS move;
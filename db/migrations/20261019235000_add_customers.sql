@@ -0,0 +1,58 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS customers (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,name           TEXT NOT NULL
+    ,code           TEXT NOT NULL
+    ,email          TEXT
+    ,phone          TEXT
+    ,customer_group TEXT
+    ,tax_exempt     BOOLEAN NOT NULL DEFAULT FALSE
+    ,tax_exempt_id  TEXT
+    ,notes          TEXT
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(code)
+);
+
+CREATE INDEX customers_customer_group_idx ON customers (customer_group);
+
+CREATE TABLE IF NOT EXISTS customer_contacts (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,customer_id    BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE
+    ,name           TEXT NOT NULL
+    ,role           TEXT
+    ,email          TEXT
+    ,phone          TEXT
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX customer_contacts_customer_id_idx ON customer_contacts (customer_id);
+
+CREATE TABLE IF NOT EXISTS customer_addresses (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,customer_id    BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE
+    ,kind           TEXT NOT NULL CHECK (kind IN ('shipping', 'billing'))
+    ,name           TEXT
+    ,line1          TEXT NOT NULL
+    ,line2          TEXT
+    ,city           TEXT NOT NULL
+    ,region         TEXT
+    ,postal_code    TEXT
+    ,country        TEXT NOT NULL
+    ,is_default     BOOLEAN NOT NULL DEFAULT FALSE
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX customer_addresses_customer_id_idx ON customer_addresses (customer_id);
+CREATE UNIQUE INDEX customer_addresses_default_idx ON customer_addresses (customer_id, kind) WHERE is_default;
+
+ALTER TABLE sales_orders ADD COLUMN customer_id BIGINT REFERENCES customers(id) ON DELETE SET NULL;
+
+CREATE INDEX sales_orders_customer_id_idx ON sales_orders (customer_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS sales_orders_customer_id_idx;
+ALTER TABLE sales_orders DROP COLUMN IF EXISTS customer_id;
+DROP TABLE IF EXISTS customer_addresses;
+DROP TABLE IF EXISTS customer_contacts;
+DROP TABLE IF EXISTS customers;
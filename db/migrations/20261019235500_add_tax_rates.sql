@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS tax_rates (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,name           TEXT NOT NULL
+    ,country        TEXT NOT NULL
+    ,region         TEXT
+    ,tax_category   TEXT
+    ,rate           DOUBLE PRECISION NOT NULL CHECK (rate >= 0)
+    ,rounding       TEXT NOT NULL DEFAULT 'half_up' CHECK (rounding IN ('half_up', 'half_even', 'up', 'down'))
+    ,per_line       BOOLEAN NOT NULL DEFAULT FALSE
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX tax_rates_country_idx ON tax_rates (country);
+
+ALTER TABLE products ADD COLUMN tax_category TEXT;
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN IF EXISTS tax_category;
+DROP TABLE IF EXISTS tax_rates;
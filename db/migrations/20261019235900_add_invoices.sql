@@ -0,0 +1,85 @@
+-- +goose Up
+ALTER TABLE customers ADD COLUMN payment_terms_days INTEGER NOT NULL DEFAULT 30 CHECK (payment_terms_days >= 0);
+
+-- the last invoice number handed out each year, the row is locked until the invoice commits so
+-- numbers are never skipped
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+    year            INTEGER NOT NULL PRIMARY KEY
+    ,last_number    BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id                  BIGSERIAL NOT NULL PRIMARY KEY
+    ,number             TEXT NOT NULL
+    ,year               INTEGER NOT NULL
+    ,sequence           BIGINT NOT NULL
+    ,sales_order_id     BIGINT NOT NULL REFERENCES sales_orders(id)
+    ,shipment_id        BIGINT NOT NULL REFERENCES shipments(id)
+    ,customer_id        BIGINT REFERENCES customers(id) ON DELETE SET NULL
+    ,customer_name      TEXT NOT NULL
+    ,bill_to            TEXT
+    ,status             TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'paid'))
+    ,subtotal           BIGINT NOT NULL
+    ,tax                BIGINT NOT NULL
+    ,total              BIGINT NOT NULL
+    ,amount_paid        BIGINT NOT NULL DEFAULT 0
+    ,terms_days         INTEGER NOT NULL
+    ,issued_at          TIMESTAMP WITH TIME ZONE NOT NULL
+    ,due_at             TIMESTAMP WITH TIME ZONE NOT NULL
+    ,paid_at            TIMESTAMP WITH TIME ZONE
+    ,created_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+    ,updated_at         TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    ,UNIQUE(number)
+    ,UNIQUE(year, sequence)
+    ,UNIQUE(shipment_id)
+);
+
+CREATE INDEX invoices_sales_order_id_idx ON invoices (sales_order_id);
+CREATE INDEX invoices_customer_id_idx ON invoices (customer_id);
+
+CREATE TABLE IF NOT EXISTS invoice_lines (
+    id                      BIGSERIAL NOT NULL PRIMARY KEY
+    ,invoice_id             BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE
+    ,sales_order_line_id    BIGINT NOT NULL
+    ,product_id             BIGINT NOT NULL
+    ,sku                    TEXT NOT NULL
+    ,name                   TEXT NOT NULL
+    ,qty                    BIGINT NOT NULL
+    ,unit_price             BIGINT NOT NULL
+    ,amount                 BIGINT NOT NULL
+    ,tax                    BIGINT NOT NULL
+);
+
+CREATE INDEX invoice_lines_invoice_id_idx ON invoice_lines (invoice_id);
+
+CREATE TABLE IF NOT EXISTS invoice_taxes (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,invoice_id     BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE
+    ,tax_rate_id    BIGINT NOT NULL
+    ,name           TEXT NOT NULL
+    ,rate           DOUBLE PRECISION NOT NULL
+    ,taxable        BIGINT NOT NULL
+    ,tax            BIGINT NOT NULL
+);
+
+CREATE INDEX invoice_taxes_invoice_id_idx ON invoice_taxes (invoice_id);
+
+CREATE TABLE IF NOT EXISTS invoice_payments (
+    id              BIGSERIAL NOT NULL PRIMARY KEY
+    ,invoice_id     BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE
+    ,amount         BIGINT NOT NULL CHECK (amount > 0)
+    ,method         TEXT NOT NULL
+    ,reference      TEXT
+    ,paid_at        TIMESTAMP WITH TIME ZONE NOT NULL
+    ,created_at     TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX invoice_payments_invoice_id_idx ON invoice_payments (invoice_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS invoice_payments;
+DROP TABLE IF EXISTS invoice_taxes;
+DROP TABLE IF EXISTS invoice_lines;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_sequences;
+ALTER TABLE customers DROP COLUMN IF EXISTS payment_terms_days;
@@ -3,6 +3,7 @@ module github.com/happilymarrieddad/product-inventory-management-system
 go 1.22.0
 
 require (
+	github.com/boombuler/barcode v1.1.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/go-playground/validator/v10 v10.20.0
 	github.com/google/uuid v1.3.0
@@ -10,6 +11,7 @@ require (
 	github.com/gorilla/mux v1.8.1
 	github.com/inconshreveable/log15 v2.16.0+incompatible
 	github.com/jackc/pgx/v5 v5.5.5
+	github.com/jung-kurt/gofpdf v1.16.2
 	github.com/onsi/ginkgo/v2 v2.17.3
 	github.com/onsi/gomega v1.33.1
 	go.uber.org/mock v0.4.0
@@ -0,0 +1,63 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/config"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("alerts")
+
+type AlertType string
+
+const (
+	AlertTypeLowStock AlertType = "low_stock"
+)
+
+// Alert - what gets handed to every notifier
+type Alert struct {
+	Type        AlertType         `json:"type"`
+	Message     string            `json:"message"`
+	Change      types.StockChange `json:"change"`
+	TriggeredAt time.Time         `json:"triggeredAt"`
+}
+
+// Notifier - somewhere alerts get sent
+//
+//go:generate mockgen -source=./alerts.go -destination=./mocks/Notifier.go -package=mock_alerts Notifier
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// NewFromConfig - builds an evaluator with the notifiers turned on in the config
+func NewFromConfig(cfg config.AlertsConfig) *Evaluator {
+	notifiers := []Notifier{}
+
+	if cfg.Log {
+		notifiers = append(notifiers, NewLogNotifier(logger))
+	}
+
+	for _, url := range cfg.Webhooks {
+		notifiers = append(notifiers, NewWebhookNotifier(url, nil))
+	}
+
+	if len(cfg.Email.Host) > 0 && len(cfg.Email.To) > 0 {
+		notifiers = append(notifiers, NewEmailNotifier(cfg.Email.From, cfg.Email.To, NewSMTPSender(cfg.Email)))
+	}
+
+	return NewEvaluator(notifiers...)
+}
+
+func lowStockMessage(change types.StockChange) string {
+	where := ""
+	if change.LocationID != nil {
+		where = fmt.Sprintf(" at location %d", *change.LocationID)
+	}
+
+	return fmt.Sprintf("%s (%s) is low%s: %d on hand, reorder point %d, reorder qty %d",
+		change.Name, change.Sku, where, change.After, change.ReorderPoint, change.ReorderQty)
+}
@@ -0,0 +1,71 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/config"
+)
+
+// Sender - hands a finished message to a mail server
+type Sender interface {
+	Send(from string, to []string, msg []byte) error
+}
+
+type smtpSender struct {
+	addr string
+	auth smtp.Auth
+}
+
+// NewSMTPSender - sends through the configured SMTP server, auth is only used when a
+// username is set so a local stand-in like MailHog works without credentials
+func NewSMTPSender(cfg config.EmailConfig) Sender {
+	var auth smtp.Auth
+	if len(cfg.Username) > 0 {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	return &smtpSender{fmt.Sprintf("%s:%d", cfg.Host, cfg.Port), auth}
+}
+
+func (s *smtpSender) Send(from string, to []string, msg []byte) error {
+	return smtp.SendMail(s.addr, s.auth, from, to, msg)
+}
+
+type emailNotifier struct {
+	from   string
+	to     []string
+	sender Sender
+}
+
+func NewEmailNotifier(from string, to []string, sender Sender) Notifier {
+	return &emailNotifier{from, to, sender}
+}
+
+func (n *emailNotifier) Notify(ctx context.Context, alert Alert) error {
+	// net/smtp has no context support so the best we can do is not start late
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("Low stock: %s", alert.Change.Sku)
+
+	msg := new(bytes.Buffer)
+	fmt.Fprintf(msg, "From: %s\r\n", n.from)
+	fmt.Fprintf(msg, "To: %s\r\n", strings.Join(n.to, ", "))
+	fmt.Fprintf(msg, "Subject: %s\r\n", headerSafe(subject))
+	fmt.Fprintf(msg, "Date: %s\r\n", alert.TriggeredAt.Format(time.RFC1123Z))
+	fmt.Fprintf(msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(msg, "%s\r\n", alert.Message)
+
+	return n.sender.Send(n.from, n.to, msg.Bytes())
+}
+
+// headerSafe - keeps product data from injecting extra headers
+func headerSafe(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
@@ -0,0 +1,74 @@
+package alerts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// DefaultTimeout - how long the notifiers get for a single alert
+const DefaultTimeout = 10 * time.Second
+
+// Evaluator - watches stock changes and raises an alert when one crosses below
+// its reorder point. It satisfies repos.StockObserver.
+type Evaluator struct {
+	notifiers []Notifier
+	timeout   time.Duration
+	wg        sync.WaitGroup
+}
+
+func NewEvaluator(notifiers ...Notifier) *Evaluator {
+	return &Evaluator{notifiers: notifiers, timeout: DefaultTimeout}
+}
+
+// Evaluate - returns the alert a change should raise, if any. Only the change that
+// crosses the threshold raises one so a product sitting low doesn't alert on every sale.
+func (e *Evaluator) Evaluate(change types.StockChange) (Alert, bool) {
+	if !change.CrossedReorderPoint() {
+		return Alert{}, false
+	}
+
+	return Alert{
+		Type:        AlertTypeLowStock,
+		Message:     lowStockMessage(change),
+		Change:      change,
+		TriggeredAt: time.Now(),
+	}, true
+}
+
+// StockChanged - dispatches any alert in the background so a slow notifier never
+// holds up the request that moved the stock
+func (e *Evaluator) StockChanged(ctx context.Context, change types.StockChange) {
+	alert, ok := e.Evaluate(change)
+	if !ok || len(e.notifiers) == 0 {
+		return
+	}
+
+	// the request that made the change is likely done by the time we send
+	ctx = context.WithoutCancel(ctx)
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.dispatch(ctx, alert)
+	}()
+}
+
+// Wait - blocks until every alert in flight has been sent
+func (e *Evaluator) Wait() {
+	e.wg.Wait()
+}
+
+func (e *Evaluator) dispatch(ctx context.Context, alert Alert) {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	for _, n := range e.notifiers {
+		if err := n.Notify(ctx, alert); err != nil {
+			logger.Error("unable to send alert", log15.Ctx{"err": err, "type": alert.Type, "productId": alert.Change.ProductID})
+		}
+	}
+}
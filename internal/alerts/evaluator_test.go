@@ -0,0 +1,95 @@
+package alerts_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/alerts"
+	mock_alerts "github.com/happilymarrieddad/product-inventory-management-system/internal/alerts/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("ALERTS: Evaluator", func() {
+	var (
+		ctrl     *gomock.Controller
+		notifier *mock_alerts.MockNotifier
+		e        *alerts.Evaluator
+		change   types.StockChange
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		notifier = mock_alerts.NewMockNotifier(ctrl)
+		e = alerts.NewEvaluator(notifier)
+
+		change = types.StockChange{
+			ProductID: 1, Sku: "sku-1", Name: "Widget", Before: 12, After: 10, ReorderPoint: 10, ReorderQty: 50,
+		}
+	})
+
+	Context("Evaluate", func() {
+		It("should raise an alert when the qty crosses the reorder point", func() {
+			alert, ok := e.Evaluate(change)
+			Expect(ok).To(BeTrue())
+			Expect(alert.Type).To(Equal(alerts.AlertTypeLowStock))
+			Expect(alert.Message).To(ContainSubstring("sku-1"))
+		})
+
+		It("should mention the location for location level changes", func() {
+			change.LocationID = utils.Ref(int64(3))
+			alert, ok := e.Evaluate(change)
+			Expect(ok).To(BeTrue())
+			Expect(alert.Message).To(ContainSubstring("location 3"))
+		})
+
+		It("should not alert when already below the reorder point", func() {
+			change.Before = 9
+			change.After = 8
+			_, ok := e.Evaluate(change)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should not alert when the qty goes up", func() {
+			change.Before = 5
+			change.After = 20
+			_, ok := e.Evaluate(change)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should not alert without a reorder point", func() {
+			change.ReorderPoint = 0
+			change.After = 0
+			_, ok := e.Evaluate(change)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("StockChanged", func() {
+		It("should send the alert to every notifier", func() {
+			other := mock_alerts.NewMockNotifier(ctrl)
+			e = alerts.NewEvaluator(notifier, other)
+
+			notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(errors.New("down"))
+			other.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, alert alerts.Alert) error {
+				Expect(alert.Change).To(Equal(change))
+				return nil
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			e.StockChanged(ctx, change)
+			// the request finishing must not stop the alert going out
+			cancel()
+			e.Wait()
+		})
+
+		It("should not notify when nothing crossed", func() {
+			change.After = 11
+			e.StockChanged(context.Background(), change)
+			e.Wait()
+		})
+	})
+})
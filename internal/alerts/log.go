@@ -0,0 +1,27 @@
+package alerts
+
+import (
+	"context"
+
+	"github.com/inconshreveable/log15"
+)
+
+type logNotifier struct {
+	logger log15.Logger
+}
+
+// NewLogNotifier - writes alerts to the log at warn level
+func NewLogNotifier(logger log15.Logger) Notifier {
+	return &logNotifier{logger}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, alert Alert) error {
+	n.logger.Warn(alert.Message, log15.Ctx{
+		"type":       alert.Type,
+		"productId":  alert.Change.ProductID,
+		"sku":        alert.Change.Sku,
+		"locationId": alert.Change.LocationID,
+		"qty":        alert.Change.After,
+	})
+	return nil
+}
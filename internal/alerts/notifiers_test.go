@@ -0,0 +1,88 @@
+package alerts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/alerts"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeSender struct {
+	from string
+	to   []string
+	msg  string
+}
+
+func (s *fakeSender) Send(from string, to []string, msg []byte) error {
+	s.from = from
+	s.to = to
+	s.msg = string(msg)
+	return nil
+}
+
+var _ = Describe("ALERTS: Notifiers", func() {
+	var alert alerts.Alert
+
+	BeforeEach(func() {
+		alert = alerts.Alert{
+			Type:        alerts.AlertTypeLowStock,
+			Message:     "Widget (sku-1) is low",
+			Change:      types.StockChange{ProductID: 1, Sku: "sku-1\r\nBcc: someone@example.com", After: 2, ReorderPoint: 5},
+			TriggeredAt: time.Now(),
+		}
+	})
+
+	Context("Webhook", func() {
+		It("should post the alert as json", func() {
+			var got alerts.Alert
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				Expect(json.NewDecoder(r.Body).Decode(&got)).To(Succeed())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer srv.Close()
+
+			Expect(alerts.NewWebhookNotifier(srv.URL, srv.Client()).Notify(context.Background(), alert)).To(Succeed())
+			Expect(got.Change.ProductID).To(BeNumerically("==", 1))
+		})
+
+		It("should fail on a non 2xx response", func() {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer srv.Close()
+
+			Expect(alerts.NewWebhookNotifier(srv.URL, srv.Client()).Notify(context.Background(), alert)).NotTo(Succeed())
+		})
+	})
+
+	Context("Email", func() {
+		It("should send a plain text message", func() {
+			sender := &fakeSender{}
+			n := alerts.NewEmailNotifier("inventory@localhost", []string{"buyer@localhost"}, sender)
+
+			Expect(n.Notify(context.Background(), alert)).To(Succeed())
+			Expect(sender.from).To(Equal("inventory@localhost"))
+			Expect(sender.to).To(Equal([]string{"buyer@localhost"}))
+			Expect(sender.msg).To(ContainSubstring("Widget (sku-1) is low"))
+			Expect(sender.msg).NotTo(ContainSubstring("\r\nBcc:"))
+		})
+
+		It("should not send once the context is done", func() {
+			sender := &fakeSender{}
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			Expect(alerts.NewEmailNotifier("a@localhost", []string{"b@localhost"}, sender).Notify(ctx, alert)).NotTo(Succeed())
+			Expect(sender.msg).To(BeEmpty())
+		})
+	})
+})
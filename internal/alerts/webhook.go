@@ -0,0 +1,47 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier - POSTs alerts as JSON to url, http.DefaultClient is used when client is nil
+func NewWebhookNotifier(url string, client *http.Client) Notifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &webhookNotifier{url, client}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	bts, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(bts))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook %s responded with %d", n.url, res.StatusCode)
+	}
+
+	return nil
+}
@@ -12,16 +12,19 @@ import (
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
 	v1 "github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/storage"
 	"github.com/inconshreveable/log15"
 )
 
 var logger = log15.New("api")
 
-func StartServer(port int, gr repos.GlobalRepo) {
+func StartServer(port int, gr repos.GlobalRepo, store storage.Storage) {
 	r := mux.NewRouter().StrictSlash(true)
 
 	// Inject access to the database
 	r.Use(middleware.InjectGlobalRepo(gr))
+	// Inject access to file storage
+	r.Use(middleware.InjectStorage(store))
 
 	// Add V1 routes
 	v1.SetRoutes(r.PathPrefix("/v1").Subrouter())
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/storage"
+)
+
+const ContextStorageKey contextKey = "mw:Storage"
+
+func SetStorageOnContext(s storage.Storage, r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), ContextStorageKey, s))
+}
+
+func InjectStorage(s storage.Storage) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), ContextStorageKey, s)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func RetrieveStorage(ctx context.Context) (storage.Storage, bool) {
+	ctxVal := ctx.Value(ContextStorageKey)
+	val, exists := ctxVal.(storage.Storage)
+	return val, exists
+}
@@ -0,0 +1,66 @@
+package attachments
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/inconshreveable/log15"
+)
+
+func Destroy(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	store, exists := middleware.RetrieveStorage(r.Context())
+	if !exists {
+		logger.Debug("unable to get storage from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	attachment, exists, err := gr.Attachments().Get(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to get attachment", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to destroy attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get attachment", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to destroy attachment id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	// Use access to the database to destroy the object
+	if err := gr.Attachments().Destroy(r.Context(), id); err != nil {
+		logger.Debug("unable to destroy attachment", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to destroy attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// The row is gone so a file we fail to remove is only wasted space
+	if err := store.Delete(r.Context(), attachment.StorageKey); err != nil {
+		logger.Debug("unable to delete attachment file", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+	}
+	if attachment.ThumbnailKey != nil {
+		if err := store.Delete(r.Context(), *attachment.ThumbnailKey); err != nil {
+			logger.Debug("unable to delete thumbnail file", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
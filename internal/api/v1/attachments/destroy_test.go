@@ -0,0 +1,102 @@
+package attachments_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/attachments"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	mock_storage "github.com/happilymarrieddad/product-inventory-management-system/internal/storage/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/attachments", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockAttachments *mock_repos.MockAttachments
+		mockStorage     *mock_storage.MockStorage
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockAttachments = mock_repos.NewMockAttachments(ctrl)
+		mockStorage = mock_storage.NewMockStorage(ctrl)
+
+		mockGr.EXPECT().Attachments().Return(mockAttachments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newRequest := func() *http.Request {
+		req := mux.SetURLVars(httptest.NewRequest("DELETE", "/v1/attachments/1", nil), map[string]string{"id": "1"})
+		return middleware.SetStorageOnContext(mockStorage, middleware.SetGlobalRepoOnContext(mockGr, req))
+	}
+
+	Context("/v1/attachments/{id} DELETE - destroy", func() {
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("DELETE", "/v1/attachments/1", nil)
+			w := httptest.NewRecorder()
+
+			attachments.Destroy(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found for an unknown attachment", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			attachments.Destroy(w, newRequest())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should keep the files when the record can not be removed", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, StorageKey: "products/1/a",
+			}, true, nil).Times(1)
+			mockAttachments.EXPECT().Destroy(gomock.Any(), int64(1)).
+				Return(types.NewInternalServerError("BOGUS:Attachments.destroy")).Times(1)
+
+			attachments.Destroy(w, newRequest())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should remove the record, the file and the thumbnail", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, StorageKey: "products/1/a", ThumbnailKey: utils.Ref("products/1/a-thumb"),
+			}, true, nil).Times(1)
+			mockAttachments.EXPECT().Destroy(gomock.Any(), int64(1)).Return(nil).Times(1)
+			mockStorage.EXPECT().Delete(gomock.Any(), "products/1/a").Return(nil).Times(1)
+			// a storage failure after the row is gone is only logged
+			mockStorage.EXPECT().Delete(gomock.Any(), "products/1/a-thumb").Return(errors.New("disk gone")).Times(1)
+
+			attachments.Destroy(w, newRequest())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
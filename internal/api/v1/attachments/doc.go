@@ -0,0 +1,18 @@
+package attachments
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/attachments")
+
+// Uploading and listing happen under /v1/products/{id}/attachments
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("/{id:[0-9]+}", Get).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/download", Download).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/thumbnail", Thumbnail).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}", Destroy).Methods(http.MethodDelete)
+}
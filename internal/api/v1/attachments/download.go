@@ -0,0 +1,95 @@
+package attachments
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Download - streams the original file back
+func Download(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, false)
+}
+
+// Thumbnail - streams the thumbnail back, only images have one
+func Thumbnail(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, true)
+}
+
+func serve(w http.ResponseWriter, r *http.Request, thumbnail bool) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	store, exists := middleware.RetrieveStorage(r.Context())
+	if !exists {
+		logger.Debug("unable to get storage from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	attachment, exists, err := gr.Attachments().Get(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to get attachment", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists || (thumbnail && attachment.ThumbnailKey == nil) {
+		logger.Debug("unable to get attachment", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get attachment id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	key, contentType, fileName := attachment.StorageKey, attachment.ContentType, attachment.FileName
+	if thumbnail {
+		key, contentType, fileName = *attachment.ThumbnailKey, "image/jpeg", "thumbnail.jpg"
+	}
+
+	rc, err := store.Get(r.Context(), key)
+	if err != nil {
+		logger.Debug("unable to read attachment from storage", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to get attachment id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to get attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+
+	// Only let the browser render things that can't run script, everything else downloads
+	disposition := "attachment"
+	if strings.HasPrefix(contentType, "image/") || contentType == "application/pdf" {
+		disposition = "inline"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": fileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if !thumbnail {
+		w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	}
+
+	if _, err := io.Copy(w, rc); err != nil {
+		logger.Debug("unable to write attachment", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+	}
+}
@@ -0,0 +1,144 @@
+package attachments_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/attachments"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	mock_storage "github.com/happilymarrieddad/product-inventory-management-system/internal/storage/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/attachments", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockAttachments *mock_repos.MockAttachments
+		mockStorage     *mock_storage.MockStorage
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockAttachments = mock_repos.NewMockAttachments(ctrl)
+		mockStorage = mock_storage.NewMockStorage(ctrl)
+
+		mockGr.EXPECT().Attachments().Return(mockAttachments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newRequest := func(path string) *http.Request {
+		req := mux.SetURLVars(httptest.NewRequest("GET", path, nil), map[string]string{"id": "1"})
+		return middleware.SetStorageOnContext(mockStorage, middleware.SetGlobalRepoOnContext(mockGr, req))
+	}
+
+	Context("/v1/attachments/{id}/download GET - download", func() {
+		It("should return an error when the storage is not on the context", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/attachments/1/download", nil))
+			w := httptest.NewRecorder()
+
+			attachments.Download(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found when the file is missing from storage", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, StorageKey: "products/1/a",
+			}, true, nil).Times(1)
+			mockStorage.EXPECT().Get(gomock.Any(), "products/1/a").
+				Return(nil, types.NewNotFoundError("file not found")).Times(1)
+
+			attachments.Download(w, newRequest("/v1/attachments/1/download"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should stream an image inline", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, FileName: "photo.png", ContentType: "image/png", Size: 4, StorageKey: "products/1/a",
+			}, true, nil).Times(1)
+			mockStorage.EXPECT().Get(gomock.Any(), "products/1/a").
+				Return(io.NopCloser(bytes.NewBufferString("data")), nil).Times(1)
+
+			attachments.Download(w, newRequest("/v1/attachments/1/download"))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("image/png"))
+			Expect(resp.Header.Get("Content-Disposition")).To(Equal(`inline; filename=photo.png`))
+			Expect(resp.Header.Get("X-Content-Type-Options")).To(Equal("nosniff"))
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+			Expect(string(bts)).To(Equal("data"))
+		})
+
+		It("should force everything else to download", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, FileName: "notes.txt", ContentType: "text/plain; charset=utf-8", Size: 4, StorageKey: "products/1/a",
+			}, true, nil).Times(1)
+			mockStorage.EXPECT().Get(gomock.Any(), "products/1/a").
+				Return(io.NopCloser(bytes.NewBufferString("data")), nil).Times(1)
+
+			attachments.Download(w, newRequest("/v1/attachments/1/download"))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Disposition")).To(HavePrefix("attachment"))
+		})
+	})
+
+	Context("/v1/attachments/{id}/thumbnail GET - thumbnail", func() {
+		It("should return not found when there is no thumbnail", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, ContentType: "application/pdf", StorageKey: "products/1/a",
+			}, true, nil).Times(1)
+
+			attachments.Thumbnail(w, newRequest("/v1/attachments/1/thumbnail"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should stream the thumbnail", func() {
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Attachment{
+				ID: 1, ContentType: "image/png", StorageKey: "products/1/a", ThumbnailKey: utils.Ref("products/1/a-thumb"),
+			}, true, nil).Times(1)
+			mockStorage.EXPECT().Get(gomock.Any(), "products/1/a-thumb").
+				Return(io.NopCloser(bytes.NewBufferString("thumb")), nil).Times(1)
+
+			attachments.Thumbnail(w, newRequest("/v1/attachments/1/thumbnail"))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("image/jpeg"))
+		})
+	})
+})
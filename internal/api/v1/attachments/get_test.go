@@ -0,0 +1,103 @@
+package attachments_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/attachments"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/attachments", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockAttachments *mock_repos.MockAttachments
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockAttachments = mock_repos.NewMockAttachments(ctrl)
+
+		mockGr.EXPECT().Attachments().Return(mockAttachments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/attachments/{id} GET - get", func() {
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("GET", "/v1/attachments/1", nil)
+			w := httptest.NewRecorder()
+
+			attachments.Get(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return a url parsing error", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/attachments/abc", nil),
+					map[string]string{"id": "abc"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			attachments.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown attachment", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/attachments/1", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			attachments.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully get an attachment without leaking the storage key", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/attachments/1", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockAttachments.EXPECT().Get(gomock.Any(), int64(1)).Return((&types.Attachment{
+				ID: 1, FileName: "spec.pdf", StorageKey: "products/1/secret",
+			}).SetURLs(), true, nil).Times(1)
+
+			attachments.Get(w, req)
+
+			resp := w.Result()
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(bts)).To(ContainSubstring("spec.pdf"))
+			Expect(string(bts)).NotTo(ContainSubstring("secret"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package barcodes_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBarcodes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Barcodes Suite")
+}
@@ -0,0 +1,140 @@
+package barcodes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/barcodes"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/barcodes", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockBarcodes *mock_repos.MockBarcodes
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockBarcodes = mock_repos.NewMockBarcodes(ctrl)
+
+		mockGr.EXPECT().Barcodes().Return(mockBarcodes).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/barcodes POST - create", func() {
+		var (
+			body       []byte
+			newBarcode types.NewBarcode
+		)
+		BeforeEach(func() {
+			newBarcode = types.NewBarcode{
+				ProductID: 1, Code: "036000291452", Type: types.BarcodeTypeUPCA,
+			}
+
+			var err error
+			body, err = json.Marshal(newBarcode)
+			Expect(err).To(BeNil())
+		})
+
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("POST", "/v1/barcodes", nil)
+			w := httptest.NewRecorder()
+
+			barcodes.Create(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("POST", "/v1/barcodes", nil),
+			)
+			w := httptest.NewRecorder()
+
+			barcodes.Create(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			err := types.NewBadRequestError("BOGUS:Barcodes.create")
+
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("POST", "/v1/barcodes", bytes.NewBuffer(body)),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Create(gomock.Any(), newBarcode).Return(nil, err).Times(1)
+
+			barcodes.Create(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create barcode"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found when the product does not exist", func() {
+			err := types.NewNotFoundError("product not found by id")
+
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("POST", "/v1/barcodes", bytes.NewBuffer(body)),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Create(gomock.Any(), newBarcode).Return(nil, err).Times(1)
+
+			barcodes.Create(w, req)
+
+			resp := w.Result()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a barcode", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("POST", "/v1/barcodes", bytes.NewBuffer(body)),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Create(gomock.Any(), newBarcode).Return(&types.Barcode{
+				ID: 1, ProductID: 1, Code: "036000291452", Gtin: "00036000291452", Type: types.BarcodeTypeUPCA, PackSize: 1,
+			}, nil).Times(1)
+
+			barcodes.Create(w, req)
+
+			resp := w.Result()
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(bts)).To(ContainSubstring("00036000291452"))
+		})
+	})
+})
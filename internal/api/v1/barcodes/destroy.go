@@ -0,0 +1,41 @@
+package barcodes
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+func Destroy(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	code := mux.Vars(r)["code"]
+	if !types.IsValidGTIN(code) {
+		logger.Debug("invalid barcode in url parameters", log15.Ctx{"vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "invalid barcode id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// Use access to the database to destroy the object
+	if err := gr.Barcodes().Destroy(r.Context(), code); err != nil {
+		logger.Debug("unable to destroy barcode", log15.Ctx{"err": err, "code": code, "requestId": requestID})
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to destroy barcode id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to destroy barcode id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
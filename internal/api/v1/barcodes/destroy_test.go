@@ -0,0 +1,87 @@
+package barcodes_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/barcodes"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/barcodes", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockBarcodes *mock_repos.MockBarcodes
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockBarcodes = mock_repos.NewMockBarcodes(ctrl)
+
+		mockGr.EXPECT().Barcodes().Return(mockBarcodes).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/barcodes/{code} DELETE - destroy", func() {
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("DELETE", "/v1/barcodes/036000291452", nil)
+			w := httptest.NewRecorder()
+
+			barcodes.Destroy(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found when the barcode does not exist", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("DELETE", "/v1/barcodes/036000291452", nil),
+					map[string]string{"code": "036000291452"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Destroy(gomock.Any(), "036000291452").
+				Return(types.NewNotFoundError("barcode not found")).Times(1)
+
+			barcodes.Destroy(w, req)
+
+			resp := w.Result()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully destroy a barcode", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("DELETE", "/v1/barcodes/036000291452", nil),
+					map[string]string{"code": "036000291452"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Destroy(gomock.Any(), "036000291452").Return(nil).Times(1)
+
+			barcodes.Destroy(w, req)
+
+			resp := w.Result()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
@@ -0,0 +1,17 @@
+package barcodes
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/barcodes")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("", Find).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{code:[0-9]+}", Scan).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{code:[0-9]+}", Destroy).Methods(http.MethodDelete)
+	subrouter.HandleFunc("", Create).Methods(http.MethodPost)
+}
@@ -0,0 +1,103 @@
+package barcodes_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/barcodes"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/barcodes", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockBarcodes *mock_repos.MockBarcodes
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockBarcodes = mock_repos.NewMockBarcodes(ctrl)
+
+		mockGr.EXPECT().Barcodes().Return(mockBarcodes).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/barcodes GET - find", func() {
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("GET", "/v1/barcodes", nil)
+			w := httptest.NewRecorder()
+
+			barcodes.Find(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should sanitize the err from the repo when an internal error", func() {
+			err := types.NewInternalServerError("BOGUS:Barcodes.find")
+
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/barcodes", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Find(gomock.Any(), gomock.AssignableToTypeOf(&repos.BarcodesFind{})).
+				Return(nil, int64(0), err).Times(1)
+
+			barcodes.Find(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to find barcode"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should successfully find the barcodes", func() {
+			params := url.Values{}
+			params.Add("limit", "25")
+			params.Add("productId", "1")
+			params.Add("code", "036000291452")
+
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/barcodes", nil),
+			)
+			req.URL.RawQuery = params.Encode()
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Find(gomock.Any(), &repos.BarcodesFind{
+				Limit: 25, ProductIDs: []int64{1}, Codes: []string{"036000291452"},
+			}).Return([]*types.Barcode{
+				{ID: 1, ProductID: 1, Code: "036000291452", Type: types.BarcodeTypeUPCA, PackSize: 1},
+			}, int64(1), nil).Times(1)
+
+			barcodes.Find(w, req)
+
+			resp := w.Result()
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(bts)).To(ContainSubstring("036000291452"))
+			Expect(string(bts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package barcodes
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Scan - resolves a scanned barcode to the product and the number of units the scan represents
+func Scan(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	code := mux.Vars(r)["code"]
+	if !types.IsValidGTIN(code) {
+		logger.Debug("invalid barcode in url parameters", log15.Ctx{"vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "invalid barcode id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// Use access to the database to find the barcode and then what it points to
+	barcode, exists, err := gr.Barcodes().Get(r.Context(), code)
+	if err != nil {
+		logger.Debug("unable to get barcode", log15.Ctx{"err": err, "code": code, "requestId": requestID})
+		http.Error(w, "unable to get barcode id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get barcode", log15.Ctx{"err": err, "code": code, "requestId": requestID})
+		http.Error(w, "unable to get barcode id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	product, exists, err := gr.Products().Get(r.Context(), barcode.ProductID)
+	if err != nil {
+		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": barcode.ProductID, "requestId": requestID})
+		http.Error(w, "unable to get product id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		// the foreign key cascades so this only happens if the product was removed mid request
+		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": barcode.ProductID, "requestId": requestID})
+		http.Error(w, "unable to get product id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(types.BarcodeScan{
+		Barcode: barcode, Product: product, PackSize: barcode.PackSize,
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal barcode id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,136 @@
+package barcodes_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/barcodes"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/barcodes", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockBarcodes *mock_repos.MockBarcodes
+		mockProducts *mock_repos.MockProducts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockBarcodes = mock_repos.NewMockBarcodes(ctrl)
+		mockProducts = mock_repos.NewMockProducts(ctrl)
+
+		mockGr.EXPECT().Barcodes().Return(mockBarcodes).AnyTimes()
+		mockGr.EXPECT().Products().Return(mockProducts).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/barcodes/{code} GET - scan", func() {
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("GET", "/v1/barcodes/036000291452", nil)
+			w := httptest.NewRecorder()
+
+			barcodes.Scan(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a code with a bad check digit", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/barcodes/036000291453", nil),
+					map[string]string{"code": "036000291453"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			barcodes.Scan(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("invalid barcode"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown barcode", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/barcodes/036000291452", nil),
+					map[string]string{"code": "036000291452"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Get(gomock.Any(), "036000291452").Return(nil, false, nil).Times(1)
+
+			barcodes.Scan(w, req)
+
+			resp := w.Result()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should sanitize the err from the repo when an internal error", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/barcodes/036000291452", nil),
+					map[string]string{"code": "036000291452"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Get(gomock.Any(), "036000291452").
+				Return(nil, false, types.NewInternalServerError("BOGUS:Barcodes.get")).Times(1)
+
+			barcodes.Scan(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should resolve the barcode to the product and pack size", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/barcodes/10036000291459", nil),
+					map[string]string{"code": "10036000291459"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockBarcodes.EXPECT().Get(gomock.Any(), "10036000291459").Return(&types.Barcode{
+				ID: 2, ProductID: 1, Code: "10036000291459", Type: types.BarcodeTypeGTIN14, PackSize: 12,
+			}, true, nil).Times(1)
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product",
+			}, true, nil).Times(1)
+
+			barcodes.Scan(w, req)
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			scan := new(types.BarcodeScan)
+			Expect(json.NewDecoder(resp.Body).Decode(scan)).To(Succeed())
+			Expect(scan.PackSize).To(BeNumerically("==", 12))
+			Expect(scan.Product.Name).To(Equal("some product"))
+		})
+	})
+})
@@ -0,0 +1,74 @@
+package countsessions_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/countsessions", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockSessions *mock_repos.MockCountSessions
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSessions = mock_repos.NewMockCountSessions(ctrl)
+
+		mockGr.EXPECT().CountSessions().Return(mockSessions).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/count-sessions/{id}/cancel POST - cancel", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/count-sessions/1/cancel", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a conflict once the count session has been posted", func() {
+			mockSessions.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewBadRequestError("count session can not go from posted to cancelled")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should return not found", func() {
+			mockSessions.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewNotFoundError("count session not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the count session", func() {
+			mockSessions.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(&types.CountSession{ID: 1, Status: types.CountSessionStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package countsessions_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/countsessions", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockSessions *mock_repos.MockCountSessions
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSessions = mock_repos.NewMockCountSessions(ctrl)
+
+		mockGr.EXPECT().CountSessions().Return(mockSessions).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+	Context("/v1/count-sessions/{id}/count POST - count", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/count-sessions/1/count", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			countsessions.Count(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request once counting is finished", func() {
+			mockSessions.EXPECT().Count(gomock.Any(), &types.CountCountSession{
+				ID: 1, Entries: []types.CountEntry{{LocationID: 2, ProductID: 3, Qty: 9}},
+			}).Return(nil, types.NewBadRequestError("count session is not open for counting")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Count(w, newReq(`{"id":5,"entries":[{"locationId":2,"productId":3,"qty":9}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown barcode", func() {
+			mockSessions.EXPECT().Count(gomock.Any(), &types.CountCountSession{
+				ID: 1, Accumulate: true, Entries: []types.CountEntry{{LocationID: 2, Barcode: "036000291452", Qty: 1}},
+			}).Return(nil, types.NewNotFoundError("barcode not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Count(w, newReq(`{"accumulate":true,"entries":[{"locationId":2,"barcode":"036000291452","qty":1}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should record the count and hide what a blind session expects", func() {
+			mockSessions.EXPECT().Count(gomock.Any(), &types.CountCountSession{
+				ID: 1, Entries: []types.CountEntry{{LocationID: 2, ProductID: 3, Qty: 6}},
+			}).Return(&types.CountSession{
+				ID: 1, Blind: true, Status: types.CountSessionStatusOpen,
+				Lines: []*types.CountLine{{
+					ID: 1, ProductID: 3, LocationID: 2,
+					ExpectedQty: utils.Ref[int64](7), CountedQty: utils.Ref[int64](6), Variance: utils.Ref[int64](-1),
+				}},
+			}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Count(w, newReq(`{"entries":[{"locationId":2,"productId":3,"qty":6}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"countedQty":6`))
+			Expect(w.Body.String()).To(ContainSubstring(`"expectedQty":null`))
+			Expect(w.Body.String()).To(ContainSubstring(`"variance":null`))
+		})
+	})
+})
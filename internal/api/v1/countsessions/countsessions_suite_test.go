@@ -0,0 +1,13 @@
+package countsessions_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCountSessions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CountSessions Suite")
+}
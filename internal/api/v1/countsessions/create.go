@@ -0,0 +1,61 @@
+package countsessions
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Create - opens a session, snapshotting the expected quantities of what's being counted
+func Create(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Get the new count session from the body of the request
+	body := new(types.NewCountSession)
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		logger.Debug("unable to read body", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to read body id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// Use access to the database to create the new object
+	session, err := gr.CountSessions().Create(r.Context(), *body)
+	if err != nil {
+		logger.Debug("unable to create count session", log15.Ctx{"err": err, "requestId": requestID})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to create count session id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to create count session id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to create count session id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// blind sessions don't show what's expected while they're being counted
+	session.Redact()
+
+	// Marshal back the response
+	bts, err := json.Marshal(session)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal count session id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write(bts)
+}
@@ -0,0 +1,106 @@
+package countsessions_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/countsessions", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockSessions *mock_repos.MockCountSessions
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSessions = mock_repos.NewMockCountSessions(ctrl)
+
+		mockGr.EXPECT().CountSessions().Return(mockSessions).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/count-sessions POST - create", func() {
+		body := []byte(`{"name":"Q3","locationIds":[1,2],"productIds":[3]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			countsessions.Create(w, httptest.NewRequest("POST", "/v1/count-sessions", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/count-sessions", nil))
+			w := httptest.NewRecorder()
+			countsessions.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/count-sessions", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSessions.EXPECT().Create(gomock.Any(), types.NewCountSession{Name: utils.Ref("Q3"), LocationIDs: []int64{1, 2}, ProductIDs: []int64{3}}).
+				Return(nil, types.NewBadRequestError("BOGUS:CountSessions.create")).Times(1)
+
+			countsessions.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create count session"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown location", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/count-sessions", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSessions.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("location not found by id")).Times(1)
+
+			countsessions.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a count session", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/count-sessions", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSessions.EXPECT().Create(gomock.Any(), types.NewCountSession{Name: utils.Ref("Q3"), LocationIDs: []int64{1, 2}, ProductIDs: []int64{3}}).
+				Return(&types.CountSession{ID: 1, Name: utils.Ref("Q3"), Status: types.CountSessionStatusOpen}, nil).Times(1)
+
+			countsessions.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"open"`))
+		})
+	})
+})
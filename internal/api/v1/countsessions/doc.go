@@ -0,0 +1,21 @@
+package countsessions
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/count-sessions")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("", Find).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}", Get).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/count", Count).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/submit", Submit).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/reopen", Reopen).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/post", Post).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/cancel", Cancel).Methods(http.MethodPost)
+	subrouter.HandleFunc("", Create).Methods(http.MethodPost)
+}
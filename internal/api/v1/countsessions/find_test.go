@@ -0,0 +1,63 @@
+package countsessions_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/countsessions", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockSessions *mock_repos.MockCountSessions
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSessions = mock_repos.NewMockCountSessions(ctrl)
+
+		mockGr.EXPECT().CountSessions().Return(mockSessions).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/count-sessions GET - find", func() {
+		It("should pass the query on to the repo and hide what blind sessions expect", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/count-sessions?limit=5&offset=10&id=1&status=open&status=review", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockSessions.EXPECT().Find(gomock.Any(), &repos.CountSessionsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1},
+				Statuses: []types.CountSessionStatus{types.CountSessionStatusOpen, types.CountSessionStatusReview},
+			}).Return([]*types.CountSession{{
+				ID: 1, Blind: true, Status: types.CountSessionStatusOpen,
+				Lines: []*types.CountLine{{ID: 1, ProductID: 3, LocationID: 2, ExpectedQty: utils.Ref[int64](7)}},
+			}}, int64(1), nil).Times(1)
+
+			countsessions.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+			Expect(string(resBts)).To(ContainSubstring(`"expectedQty":null`))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package countsessions_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/countsessions", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockSessions *mock_repos.MockCountSessions
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSessions = mock_repos.NewMockCountSessions(ctrl)
+
+		mockGr.EXPECT().CountSessions().Return(mockSessions).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+	Context("/v1/count-sessions/{id}/post POST - post", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/count-sessions/1/post", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			countsessions.Post(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when the session isn't in review", func() {
+			mockSessions.EXPECT().Post(gomock.Any(), &types.PostCountSession{ID: 1}).
+				Return(nil, types.NewBadRequestError("count session can not go from open to posted")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Post(w, newReq(`{"id":5}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for a line that isn't on the session", func() {
+			mockSessions.EXPECT().Post(gomock.Any(), &types.PostCountSession{ID: 1, LineIDs: []int64{9}}).
+				Return(nil, types.NewNotFoundError("count line not found on this count session")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Post(w, newReq(`{"lineIds":[9]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should post the approved variances", func() {
+			mockSessions.EXPECT().Post(gomock.Any(), &types.PostCountSession{ID: 1, LineIDs: []int64{2}}).
+				Return(&types.CountSession{ID: 1, Status: types.CountSessionStatusPosted}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Post(w, newReq(`{"lineIds":[2]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"posted"`))
+		})
+	})
+})
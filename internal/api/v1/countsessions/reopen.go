@@ -0,0 +1,61 @@
+package countsessions
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Reopen - sends a session in review back for a recount
+func Reopen(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	session, err := gr.CountSessions().Reopen(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to reopen count session", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to reopen count session id: "+requestID, http.StatusNotFound)
+			return
+		}
+		// not allowed from the current status
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to reopen count session id: "+requestID, http.StatusConflict)
+			return
+		}
+		http.Error(w, "unable to reopen count session id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// blind sessions don't show what's expected while they're being counted
+	session.Redact()
+
+	// Marshal back the response
+	bts, err := json.Marshal(session)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal count session id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,68 @@
+package countsessions
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Submit - finishes counting and puts the variances up for review
+func Submit(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// Get the submit options from the body of the request
+	body := new(types.SubmitCountSession)
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		logger.Debug("unable to read body", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to read body id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// ensure the id is what was used in the URL
+	body.ID = id
+
+	session, err := gr.CountSessions().Submit(r.Context(), body)
+	if err != nil {
+		logger.Debug("unable to submit count session", log15.Ctx{"err": err, "id": id, "requestId": requestID, "req": body})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to submit count session id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to submit count session id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to submit count session id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(session)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal count session id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,82 @@
+package countsessions_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/countsessions", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockSessions *mock_repos.MockCountSessions
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSessions = mock_repos.NewMockCountSessions(ctrl)
+
+		mockGr.EXPECT().CountSessions().Return(mockSessions).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+	Context("/v1/count-sessions/{id}/submit POST - submit", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/count-sessions/1/submit", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			countsessions.Submit(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request while lines are uncounted", func() {
+			mockSessions.EXPECT().Submit(gomock.Any(), &types.SubmitCountSession{ID: 1}).
+				Return(nil, types.NewBadRequestError("every line has to be counted before review")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Submit(w, newReq(`{"id":5}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockSessions.EXPECT().Submit(gomock.Any(), &types.SubmitCountSession{ID: 1}).
+				Return(nil, types.NewNotFoundError("count session not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Submit(w, newReq(`{}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should put the session up for review", func() {
+			mockSessions.EXPECT().Submit(gomock.Any(), &types.SubmitCountSession{ID: 1, ZeroUncounted: true}).
+				Return(&types.CountSession{ID: 1, Status: types.CountSessionStatusReview}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			countsessions.Submit(w, newReq(`{"zeroUncounted":true}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"review"`))
+		})
+	})
+})
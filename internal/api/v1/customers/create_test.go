@@ -0,0 +1,93 @@
+package customers_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/customers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/customers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockCustomers *mock_repos.MockCustomers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockCustomers = mock_repos.NewMockCustomers(ctrl)
+
+		mockGr.EXPECT().Customers().Return(mockCustomers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/customers POST - create", func() {
+		body := []byte(`{"name":"Acme Retail","code":"ACME-R"}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			customers.Create(w, httptest.NewRequest("POST", "/v1/customers", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/customers", nil))
+			w := httptest.NewRecorder()
+			customers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/customers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockCustomers.EXPECT().Create(gomock.Any(), types.NewCustomer{Name: "Acme Retail", Code: "ACME-R"}).
+				Return(nil, types.NewBadRequestError("BOGUS:Customers.create")).Times(1)
+
+			customers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create customer"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should successfully create a customer", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/customers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockCustomers.EXPECT().Create(gomock.Any(), types.NewCustomer{Name: "Acme Retail", Code: "ACME-R"}).
+				Return(&types.Customer{ID: 1, Name: "Acme Retail", Code: "ACME-R"}, nil).Times(1)
+
+			customers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring("ACME-R"))
+		})
+	})
+})
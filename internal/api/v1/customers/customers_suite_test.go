@@ -0,0 +1,13 @@
+package customers_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCustomers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Customers Suite")
+}
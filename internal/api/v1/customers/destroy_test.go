@@ -0,0 +1,61 @@
+package customers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/customers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/customers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockCustomers *mock_repos.MockCustomers
+		req           *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockCustomers = mock_repos.NewMockCustomers(ctrl)
+
+		mockGr.EXPECT().Customers().Return(mockCustomers).AnyTimes()
+
+		req = middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("DELETE", "/v1/customers/1", nil), map[string]string{"id": "1"},
+		))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/customers/{id} DELETE - destroy", func() {
+		It("should return not found", func() {
+			mockCustomers.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewNotFoundError("customer not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			customers.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully destroy a customer", func() {
+			mockCustomers.EXPECT().Destroy(gomock.Any(), int64(1)).Return(nil).Times(1)
+
+			w := httptest.NewRecorder()
+			customers.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
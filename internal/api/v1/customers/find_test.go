@@ -0,0 +1,59 @@
+package customers_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/customers"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/customers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockCustomers *mock_repos.MockCustomers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockCustomers = mock_repos.NewMockCustomers(ctrl)
+
+		mockGr.EXPECT().Customers().Return(mockCustomers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/customers GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/customers?limit=5&offset=10&id=1&code=ACME-R&group=wholesale&tax_exempt=true&q=acme", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockCustomers.EXPECT().Find(gomock.Any(), &repos.CustomersFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, Codes: []string{"ACME-R"},
+				Groups: []string{"wholesale"}, TaxExempt: utils.Ref(true), Search: "acme",
+			}).Return([]*types.Customer{{ID: 1, Code: "ACME-R"}}, int64(1), nil).Times(1)
+
+			customers.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
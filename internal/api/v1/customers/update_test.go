@@ -0,0 +1,76 @@
+package customers_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/customers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/customers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockCustomers *mock_repos.MockCustomers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockCustomers = mock_repos.NewMockCustomers(ctrl)
+
+		mockGr.EXPECT().Customers().Return(mockCustomers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/customers/{id} PUT - update", func() {
+		It("should return not found for an unknown customer", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/customers/1", bytes.NewBufferString(`{"name":"Other"}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockCustomers.EXPECT().Update(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("customer not found by id")).Times(1)
+
+			customers.Update(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the id from the url", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/customers/1", bytes.NewBufferString(`{"id":5,"name":"Other"}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockCustomers.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, diff *types.UpdateCustomer) (*types.Customer, error) {
+					Expect(diff.ID).To(BeNumerically("==", 1))
+					return &types.Customer{ID: 1, Name: *diff.Name, Code: "ACME-R"}, nil
+				}).Times(1)
+
+			customers.Update(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring("Other"))
+		})
+	})
+})
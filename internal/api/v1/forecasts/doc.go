@@ -0,0 +1,15 @@
+package forecasts
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/forecasts")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("/suggestions", Suggestions).Methods(http.MethodGet)
+	subrouter.HandleFunc("/drafts", Draft).Methods(http.MethodPost)
+}
@@ -0,0 +1,58 @@
+package forecasts
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Draft - turns the suggestions into draft purchase orders, one for each preferred supplier. It
+// takes the same query as Suggestions.
+func Draft(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	opts, err := parseFind(r.URL.Query())
+	if err != nil {
+		logger.Debug("invalid query", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, err.Error()+" id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	orders, err := gr.Forecasts().Draft(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to draft purchase orders", log15.Ctx{"err": err, "requestId": requestID})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to draft purchase orders id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "unable to draft purchase orders id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: orders, Count: int64(len(orders)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal purchase orders id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write(bts)
+}
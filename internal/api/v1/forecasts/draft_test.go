@@ -0,0 +1,98 @@
+package forecasts_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/forecasts"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/forecasts/drafts", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockForecasts *mock_repos.MockForecasts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockForecasts = mock_repos.NewMockForecasts(ctrl)
+
+		mockGr.EXPECT().Forecasts().Return(mockForecasts).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/forecasts/drafts POST", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			forecasts.Draft(w, httptest.NewRequest("POST", "/v1/forecasts/drafts", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad query", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/forecasts/drafts?review_days=-1", nil))
+			w := httptest.NewRecorder()
+			forecasts.Draft(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/forecasts/drafts", nil))
+			w := httptest.NewRecorder()
+
+			mockForecasts.EXPECT().Draft(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			forecasts.Draft(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return a bad request from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/forecasts/drafts", nil))
+			w := httptest.NewRecorder()
+
+			mockForecasts.EXPECT().Draft(gomock.Any(), gomock.Any()).Return(nil, types.NewBadRequestError("BOGUS")).Times(1)
+
+			forecasts.Draft(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should draft purchase orders for a supplier", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/forecasts/drafts?supplier_id=2", nil))
+			w := httptest.NewRecorder()
+
+			mockForecasts.EXPECT().Draft(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ForecastsFind) ([]*types.PurchaseOrder, error) {
+				Expect(opts.SupplierIDs).To(Equal([]int64{2}))
+				return []*types.PurchaseOrder{{ID: 5, SupplierID: 2, Status: types.PurchaseOrderStatusDraft}}, nil
+			}).Times(1)
+
+			forecasts.Draft(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"draft"`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package forecasts_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestForecasts(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Forecasts Suite")
+}
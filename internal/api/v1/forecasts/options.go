@@ -0,0 +1,68 @@
+package forecasts
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+)
+
+// parseFind - the products and forecast options from the query, anything left out takes the
+// forecast's defaults
+func parseFind(query url.Values) (*repos.ForecastsFind, error) {
+	opts := new(repos.ForecastsFind)
+
+	for name, ids := range map[string]*[]int64{"product_id": &opts.ProductIDs, "supplier_id": &opts.SupplierIDs} {
+		for _, idRaw := range query[name] {
+			id, err := strconv.ParseInt(idRaw, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid " + name)
+			}
+			*ids = append(*ids, id)
+		}
+	}
+
+	if raw := query.Get("method"); raw != "" {
+		switch method := types.ForecastMethod(raw); method {
+		case types.ForecastMethodMovingAverage, types.ForecastMethodExponential:
+			opts.Options.Method = method
+		default:
+			return nil, errors.New("invalid method")
+		}
+	}
+
+	for name, val := range map[string]*int{
+		"period_days":     &opts.Options.PeriodDays,
+		"periods":         &opts.Options.Periods,
+		"average_periods": &opts.Options.AveragePeriods,
+		"season":          &opts.Options.Season,
+		"review_days":     &opts.Options.ReviewDays,
+	} {
+		if raw := query.Get(name); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 1 {
+				return nil, errors.New("invalid " + name)
+			}
+			*val = n
+		}
+	}
+
+	for name, val := range map[string]*float64{
+		"alpha":         &opts.Options.Alpha,
+		"beta":          &opts.Options.Beta,
+		"gamma":         &opts.Options.Gamma,
+		"service_level": &opts.Options.ServiceLevel,
+	} {
+		if raw := query.Get(name); raw != "" {
+			f, err := strconv.ParseFloat(raw, 64)
+			if err != nil || f <= 0 || f > 1 || (name == "service_level" && (f < 0.5 || f == 1)) {
+				return nil, errors.New("invalid " + name)
+			}
+			*val = f
+		}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,53 @@
+package forecasts
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/inconshreveable/log15"
+)
+
+// Suggestions - what to order of every product at or below its forecast reorder point. Pass
+// product_id or supplier_id to narrow it down and method, period_days, periods, average_periods,
+// season, alpha, beta, gamma, service_level or review_days to change how it's forecast.
+func Suggestions(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	opts, err := parseFind(r.URL.Query())
+	if err != nil {
+		logger.Debug("invalid query", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, err.Error()+" id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	res, err := gr.Forecasts().Suggest(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to suggest purchases", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to suggest purchases id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: int64(len(res)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal suggested purchases id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
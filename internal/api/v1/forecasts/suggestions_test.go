@@ -0,0 +1,120 @@
+package forecasts_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/forecasts"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/forecasts/suggestions", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockForecasts *mock_repos.MockForecasts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockForecasts = mock_repos.NewMockForecasts(ctrl)
+
+		mockGr.EXPECT().Forecasts().Return(mockForecasts).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/forecasts/suggestions GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			forecasts.Suggestions(w, httptest.NewRequest("GET", "/v1/forecasts/suggestions", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad query", func() {
+			for _, query := range []string{
+				"product_id=x", "supplier_id=x", "method=guess", "period_days=0", "season=x", "alpha=1.5",
+				"service_level=0.3", "service_level=1",
+			} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/forecasts/suggestions?"+query, nil))
+				w := httptest.NewRecorder()
+				forecasts.Suggestions(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/forecasts/suggestions", nil))
+			w := httptest.NewRecorder()
+
+			mockForecasts.EXPECT().Suggest(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			forecasts.Suggestions(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should leave the defaults to the forecast", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/forecasts/suggestions", nil))
+			w := httptest.NewRecorder()
+
+			mockForecasts.EXPECT().Suggest(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ForecastsFind) ([]*types.SuggestedPurchase, error) {
+				Expect(*opts).To(Equal(repos.ForecastsFind{}))
+				return []*types.SuggestedPurchase{{ProductID: 1, Sku: "widget", SupplierID: utils.Ref(int64(2)), Qty: 40}}, nil
+			}).Times(1)
+
+			forecasts.Suggestions(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"qty":40`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+
+		It("should pass the query along", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET",
+				"/v1/forecasts/suggestions?product_id=1&product_id=2&supplier_id=3&method=moving_average&period_days=30"+
+					"&periods=24&average_periods=6&season=12&alpha=0.5&beta=0.2&gamma=0.1&service_level=0.99&review_days=7", nil))
+			w := httptest.NewRecorder()
+
+			mockForecasts.EXPECT().Suggest(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ForecastsFind) ([]*types.SuggestedPurchase, error) {
+				Expect(opts.ProductIDs).To(Equal([]int64{1, 2}))
+				Expect(opts.SupplierIDs).To(Equal([]int64{3}))
+				Expect(opts.Options.Method).To(Equal(types.ForecastMethodMovingAverage))
+				Expect(opts.Options.PeriodDays).To(Equal(30))
+				Expect(opts.Options.Periods).To(Equal(24))
+				Expect(opts.Options.AveragePeriods).To(Equal(6))
+				Expect(opts.Options.Season).To(Equal(12))
+				Expect(opts.Options.Alpha).To(Equal(0.5))
+				Expect(opts.Options.Beta).To(Equal(0.2))
+				Expect(opts.Options.Gamma).To(Equal(0.1))
+				Expect(opts.Options.ServiceLevel).To(Equal(0.99))
+				Expect(opts.Options.ReviewDays).To(Equal(7))
+				return []*types.SuggestedPurchase{}, nil
+			}).Times(1)
+
+			forecasts.Suggestions(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
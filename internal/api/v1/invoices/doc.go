@@ -0,0 +1,17 @@
+package invoices
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/invoices")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("", Find).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}", Get).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}.pdf", PDF).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/payments", Pay).Methods(http.MethodPost)
+}
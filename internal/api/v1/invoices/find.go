@@ -0,0 +1,125 @@
+package invoices
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+func Find(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	opts := new(repos.InvoicesFind)
+	qry := r.URL.Query()
+
+	limitRaw, exists := qry["limit"]
+	if exists {
+		limit, err := strconv.ParseInt(limitRaw[0], 10, 64)
+		if err == nil {
+			opts.Limit = int(limit)
+		}
+	}
+
+	offsetRaw, exists := qry["offset"]
+	if exists {
+		offset, err := strconv.ParseInt(offsetRaw[0], 10, 64)
+		if err == nil {
+			opts.Offset = int(offset)
+		}
+	}
+
+	idsRaw, exists := qry["id"]
+	if exists {
+		for _, idRaw := range idsRaw {
+			id, err := strconv.ParseInt(idRaw, 10, 64)
+			if err == nil {
+				opts.IDs = append(opts.IDs, id)
+			}
+		}
+	}
+
+	salesOrderIDsRaw, exists := qry["sales_order_id"]
+	if exists {
+		for _, idRaw := range salesOrderIDsRaw {
+			id, err := strconv.ParseInt(idRaw, 10, 64)
+			if err == nil {
+				opts.SalesOrderIDs = append(opts.SalesOrderIDs, id)
+			}
+		}
+	}
+
+	customerIDsRaw, exists := qry["customer_id"]
+	if exists {
+		for _, idRaw := range customerIDsRaw {
+			id, err := strconv.ParseInt(idRaw, 10, 64)
+			if err == nil {
+				opts.CustomerIDs = append(opts.CustomerIDs, id)
+			}
+		}
+	}
+
+	statusRaw, exists := qry["status"]
+	if exists {
+		for _, status := range statusRaw {
+			opts.Statuses = append(opts.Statuses, types.InvoiceStatus(status))
+		}
+	}
+
+	yearsRaw, exists := qry["year"]
+	if exists {
+		for _, yearRaw := range yearsRaw {
+			year, err := strconv.ParseInt(yearRaw, 10, 64)
+			if err == nil {
+				opts.Years = append(opts.Years, int(year))
+			}
+		}
+	}
+
+	overdueRaw, exists := qry["overdue"]
+	if exists {
+		overdue, err := strconv.ParseBool(overdueRaw[0])
+		if err == nil {
+			opts.Overdue = overdue
+		}
+	}
+
+	// Use access to the database to find the requested object(s)
+	res, count, err := gr.Invoices().Find(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to find invoices", log15.Ctx{"err": err, "requestId": requestID})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to find invoice id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "unable to find invoice id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: count,
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal invoices id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
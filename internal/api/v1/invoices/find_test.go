@@ -0,0 +1,58 @@
+package invoices_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/invoices"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/invoices", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockInvoices *mock_repos.MockInvoices
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockInvoices = mock_repos.NewMockInvoices(ctrl)
+
+		mockGr.EXPECT().Invoices().Return(mockInvoices).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/invoices GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest(
+				"GET", "/v1/invoices?limit=5&offset=10&id=1&sales_order_id=2&customer_id=3&status=open&year=2026&overdue=true", nil,
+			))
+			w := httptest.NewRecorder()
+
+			mockInvoices.EXPECT().Find(gomock.Any(), &repos.InvoicesFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, SalesOrderIDs: []int64{2}, CustomerIDs: []int64{3},
+				Statuses: []types.InvoiceStatus{types.InvoiceStatusOpen}, Years: []int{2026}, Overdue: true,
+			}).Return([]*types.Invoice{{ID: 1, Number: "INV-2026-000001"}}, int64(1), nil).Times(1)
+
+			invoices.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
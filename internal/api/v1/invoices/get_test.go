@@ -0,0 +1,95 @@
+package invoices_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/invoices"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/invoices", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockInvoices *mock_repos.MockInvoices
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockInvoices = mock_repos.NewMockInvoices(ctrl)
+
+		mockGr.EXPECT().Invoices().Return(mockInvoices).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/invoices/{id} GET - get", func() {
+		It("should return an error with a bad id", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/invoices/abc", nil), map[string]string{"id": "abc"},
+			))
+			w := httptest.NewRecorder()
+			invoices.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/invoices/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockInvoices.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, errors.New("BOGUS")).Times(1)
+
+			invoices.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/invoices/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockInvoices.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			invoices.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return the invoice", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/invoices/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockInvoices.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Invoice{ID: 1, Number: "INV-2026-000001", Status: types.InvoiceStatusOpen}, true, nil).Times(1)
+
+			invoices.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring("INV-2026-000001"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package invoices_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestInvoices(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Invoices Suite")
+}
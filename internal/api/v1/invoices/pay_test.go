@@ -0,0 +1,83 @@
+package invoices_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/invoices"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/invoices", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockInvoices *mock_repos.MockInvoices
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockInvoices = mock_repos.NewMockInvoices(ctrl)
+
+		mockGr.EXPECT().Invoices().Return(mockInvoices).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/invoices/{id}/payments POST - pay", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/invoices/1/payments", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			invoices.Pay(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when paying more than the balance", func() {
+			mockInvoices.EXPECT().Pay(gomock.Any(), types.NewInvoicePayment{InvoiceID: 1, Amount: 5000, Method: "card"}).
+				Return(nil, types.NewBadRequestError("payment is more than the invoice's balance")).Times(1)
+
+			w := httptest.NewRecorder()
+			invoices.Pay(w, newReq(`{"invoiceId":5,"amount":5000,"method":"card"}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockInvoices.EXPECT().Pay(gomock.Any(), types.NewInvoicePayment{InvoiceID: 1, Amount: 500, Method: "card"}).
+				Return(nil, types.NewNotFoundError("invoice not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			invoices.Pay(w, newReq(`{"amount":500,"method":"card"}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should record the payment", func() {
+			mockInvoices.EXPECT().Pay(gomock.Any(), types.NewInvoicePayment{InvoiceID: 1, Amount: 1000, Method: "card"}).
+				Return(&types.Invoice{ID: 1, Status: types.InvoiceStatusPaid, Total: 1000, AmountPaid: 1000}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			invoices.Pay(w, newReq(`{"amount":1000,"method":"card"}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusCreated))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"paid"`))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package invoices
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/billing"
+	"github.com/inconshreveable/log15"
+)
+
+// PDF - renders the invoice for printing or sending to the customer
+func PDF(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	invoice, exists, err := gr.Invoices().Get(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to get invoice", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get invoice id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get invoice", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get invoice id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := billing.RenderInvoice(buf, invoice); err != nil {
+		logger.Debug("unable to render invoice", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to render invoice id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"%s.pdf\"", invoice.Number))
+	w.Write(buf.Bytes())
+}
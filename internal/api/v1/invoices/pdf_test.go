@@ -0,0 +1,71 @@
+package invoices_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/invoices"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/invoices", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockInvoices *mock_repos.MockInvoices
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockInvoices = mock_repos.NewMockInvoices(ctrl)
+
+		mockGr.EXPECT().Invoices().Return(mockInvoices).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/invoices/{id}.pdf GET - pdf", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/invoices/1.pdf", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return not found for an unknown invoice", func() {
+			mockInvoices.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			invoices.PDF(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should render the invoice as a pdf", func() {
+			mockInvoices.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Invoice{
+				ID: 1, Number: "INV-2026-000001", SalesOrderID: 2, ShipmentID: 3, CustomerName: "Jane Doe",
+				Status: types.InvoiceStatusOpen, Subtotal: 1000, Total: 1000, IssuedAt: time.Now(), DueAt: time.Now(),
+				Lines: []*types.InvoiceLine{{ID: 1, Sku: "SKU-3", Name: "some product", Qty: 1, UnitPrice: 1000, Amount: 1000}},
+			}, true, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			invoices.PDF(w, newReq())
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/pdf"))
+			Expect(resp.Header.Get("Content-Disposition")).To(Equal(`inline; filename="INV-2026-000001.pdf"`))
+			Expect(w.Body.String()).To(HavePrefix("%PDF"))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package kits_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/kits"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/kits", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockKits *mock_repos.MockKits
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockKits = mock_repos.NewMockKits(ctrl)
+
+		mockGr.EXPECT().Kits().Return(mockKits).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/kits/{id}/assemble POST - assemble", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/kits/1/assemble", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			kits.Assemble(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when the components run short", func() {
+			mockKits.EXPECT().Assemble(gomock.Any(), &types.AssembleKit{ID: 1, Qty: 9}).
+				Return(nil, types.NewBadRequestError("not enough filter available to build starter")).Times(1)
+
+			w := httptest.NewRecorder()
+			kits.Assemble(w, newReq(`{"id":5,"qty":9}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockKits.EXPECT().Assemble(gomock.Any(), &types.AssembleKit{ID: 1, Qty: 1}).
+				Return(nil, types.NewNotFoundError("kit not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			kits.Assemble(w, newReq(`{"qty":1}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should build the kits", func() {
+			mockKits.EXPECT().Assemble(gomock.Any(), &types.AssembleKit{ID: 1, Qty: 2}).
+				Return(&types.Kit{Product: &types.Product{ID: 1, Qty: 2, Kit: true}, Available: 2}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			kits.Assemble(w, newReq(`{"qty":2}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"available":2`))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package kits_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/kits"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/kits", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockKits *mock_repos.MockKits
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockKits = mock_repos.NewMockKits(ctrl)
+
+		mockGr.EXPECT().Kits().Return(mockKits).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/kits POST - create", func() {
+		body := []byte(`{"name":"Starter Kit","sku":"starter","components":[{"componentId":2,"qty":1}]}`)
+		newKit := types.NewKit{
+			Name: "Starter Kit", Sku: "starter", Components: []types.NewKitComponent{{ComponentID: 2, Qty: 1}},
+		}
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			kits.Create(w, httptest.NewRequest("POST", "/v1/kits", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/kits", nil))
+			w := httptest.NewRecorder()
+			kits.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/kits", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Create(gomock.Any(), newKit).
+				Return(nil, types.NewBadRequestError("BOGUS:Kits.create")).Times(1)
+
+			kits.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create kit"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should successfully create a kit", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/kits", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Create(gomock.Any(), newKit).
+				Return(&types.Kit{Product: &types.Product{ID: 1, Sku: "starter"}}, nil).Times(1)
+
+			kits.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring("starter"))
+		})
+	})
+})
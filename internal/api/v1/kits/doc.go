@@ -0,0 +1,18 @@
+package kits
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/kits")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("", Find).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}", Get).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}", Update).Methods(http.MethodPut)
+	subrouter.HandleFunc("/{id:[0-9]+}/assemble", Assemble).Methods(http.MethodPost)
+	subrouter.HandleFunc("", Create).Methods(http.MethodPost)
+}
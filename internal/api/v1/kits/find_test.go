@@ -0,0 +1,57 @@
+package kits_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/kits"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/kits", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockKits *mock_repos.MockKits
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockKits = mock_repos.NewMockKits(ctrl)
+
+		mockGr.EXPECT().Kits().Return(mockKits).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/kits GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/kits?limit=5&offset=10&id=1&component_id=2", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Find(gomock.Any(), &repos.KitsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, ComponentIDs: []int64{2},
+			}).Return([]*types.Kit{{Product: &types.Product{ID: 1, Sku: "starter"}}}, int64(1), nil).Times(1)
+
+			kits.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
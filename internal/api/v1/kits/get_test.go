@@ -0,0 +1,95 @@
+package kits_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/kits"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/kits", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockKits *mock_repos.MockKits
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockKits = mock_repos.NewMockKits(ctrl)
+
+		mockGr.EXPECT().Kits().Return(mockKits).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/kits/{id} GET - get", func() {
+		It("should return an error with a bad id", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/kits/abc", nil), map[string]string{"id": "abc"},
+			))
+			w := httptest.NewRecorder()
+			kits.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/kits/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, errors.New("BOGUS")).Times(1)
+
+			kits.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/kits/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			kits.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return the kit", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/kits/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Kit{Product: &types.Product{ID: 1, Sku: "starter"}}, true, nil).Times(1)
+
+			kits.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring("starter"))
+		})
+	})
+})
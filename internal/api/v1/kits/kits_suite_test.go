@@ -0,0 +1,13 @@
+package kits_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKits(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kits Suite")
+}
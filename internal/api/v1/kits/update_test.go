@@ -0,0 +1,78 @@
+package kits_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/kits"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/kits", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockKits *mock_repos.MockKits
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockKits = mock_repos.NewMockKits(ctrl)
+
+		mockGr.EXPECT().Kits().Return(mockKits).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/kits/{id} PUT - update", func() {
+		It("should return not found for an unknown kit", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/kits/1", bytes.NewBufferString(`{"components":[{"componentId":2,"qty":3}]}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Update(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("kit not found by id")).Times(1)
+
+			kits.Update(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the id from the url", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/kits/1", bytes.NewBufferString(`{"id":5,"components":[{"componentId":2,"qty":3}]}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockKits.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, diff *types.UpdateKit) (*types.Kit, error) {
+					Expect(diff.ID).To(BeNumerically("==", 1))
+					return &types.Kit{Product: &types.Product{ID: 1}, Components: []*types.KitComponent{
+						{KitID: 1, ComponentID: diff.Components[0].ComponentID, Qty: diff.Components[0].Qty},
+					}}, nil
+				}).Times(1)
+
+			kits.Update(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"qty":3`))
+		})
+	})
+})
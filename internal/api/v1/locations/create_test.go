@@ -0,0 +1,93 @@
+package locations_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockLocations *mock_repos.MockLocations
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockLocations = mock_repos.NewMockLocations(ctrl)
+
+		mockGr.EXPECT().Locations().Return(mockLocations).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/locations POST - create", func() {
+		body := []byte(`{"name":"Main","code":"MAIN"}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			locations.Create(w, httptest.NewRequest("POST", "/v1/locations", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/locations", nil))
+			w := httptest.NewRecorder()
+			locations.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/locations", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockLocations.EXPECT().Create(gomock.Any(), types.NewLocation{Name: "Main", Code: "MAIN"}).
+				Return(nil, types.NewBadRequestError("BOGUS:Locations.create")).Times(1)
+
+			locations.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create location"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should successfully create a location", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/locations", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockLocations.EXPECT().Create(gomock.Any(), types.NewLocation{Name: "Main", Code: "MAIN"}).
+				Return(&types.Location{ID: 1, Name: "Main", Code: "MAIN"}, nil).Times(1)
+
+			locations.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring("MAIN"))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package locations_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockLocations *mock_repos.MockLocations
+		req           *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockLocations = mock_repos.NewMockLocations(ctrl)
+
+		mockGr.EXPECT().Locations().Return(mockLocations).AnyTimes()
+
+		req = middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("DELETE", "/v1/locations/1", nil), map[string]string{"id": "1"},
+		))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/locations/{id} DELETE - destroy", func() {
+		It("should return not found", func() {
+			mockLocations.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewNotFoundError("location not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return a conflict when the location still holds stock", func() {
+			mockLocations.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewBadRequestError("related object is missing or still in use")).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should successfully destroy a location", func() {
+			mockLocations.EXPECT().Destroy(gomock.Any(), int64(1)).Return(nil).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
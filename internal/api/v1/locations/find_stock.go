@@ -0,0 +1,93 @@
+package locations
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/inconshreveable/log15"
+)
+
+// FindStock - the stock levels held at a location, include_children=true adds the stock in
+// every location inside it and as_of (see utils.ParseAsOf) gives what was held at that time
+func FindStock(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	opts := &repos.StockLevelsFind{LocationIDs: []int64{id}}
+	qry := r.URL.Query()
+
+	includeChildrenRaw, exists := qry["include_children"]
+	if exists {
+		includeChildren, err := strconv.ParseBool(includeChildrenRaw[0])
+		if err == nil && includeChildren {
+			opts = &repos.StockLevelsFind{WithinLocationIDs: []int64{id}}
+		}
+	}
+
+	limitRaw, exists := qry["limit"]
+	if exists {
+		limit, err := strconv.ParseInt(limitRaw[0], 10, 64)
+		if err == nil {
+			opts.Limit = int(limit)
+		}
+	}
+
+	offsetRaw, exists := qry["offset"]
+	if exists {
+		offset, err := strconv.ParseInt(offsetRaw[0], 10, 64)
+		if err == nil {
+			opts.Offset = int(offset)
+		}
+	}
+
+	if raw := qry.Get("as_of"); raw != "" {
+		asOf, err := utils.ParseAsOf(raw)
+		if err != nil {
+			logger.Debug("invalid as of", log15.Ctx{"err": err, "asOf": raw, "requestId": requestID})
+			http.Error(w, "invalid as_of id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		opts.AsOf = &asOf
+	}
+
+	res, count, err := gr.StockLevels().Find(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to find stock levels", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to find stock levels id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: count,
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal stock levels id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,98 @@
+package locations_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockStockLevels *mock_repos.MockStockLevels
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockStockLevels = mock_repos.NewMockStockLevels(ctrl)
+
+		mockGr.EXPECT().StockLevels().Return(mockStockLevels).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/locations/{id}/stock GET - find stock", func() {
+		It("should only look at the location in the url", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/locations/3/stock?limit=10", nil), map[string]string{"id": "3"},
+			))
+			w := httptest.NewRecorder()
+
+			mockStockLevels.EXPECT().Find(gomock.Any(), &repos.StockLevelsFind{Limit: 10, LocationIDs: []int64{3}}).
+				Return([]*types.StockLevel{{ID: 1, ProductID: 2, LocationID: 3, Qty: 7}}, int64(1), nil).Times(1)
+
+			locations.FindStock(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"qty":7`))
+		})
+
+		It("should include the locations inside it when asked", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/locations/3/stock?include_children=true&limit=10", nil), map[string]string{"id": "3"},
+			))
+			w := httptest.NewRecorder()
+
+			mockStockLevels.EXPECT().Find(gomock.Any(), &repos.StockLevelsFind{Limit: 10, WithinLocationIDs: []int64{3}}).
+				Return([]*types.StockLevel{{ID: 1, ProductID: 2, LocationID: 8, Qty: 7}}, int64(1), nil).Times(1)
+
+			locations.FindStock(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should rebuild the stock as of a time", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/locations/3/stock?as_of=2026-09-30T17:00:00Z", nil), map[string]string{"id": "3"},
+			))
+			w := httptest.NewRecorder()
+
+			asOf := time.Date(2026, 9, 30, 17, 0, 0, 0, time.UTC)
+			mockStockLevels.EXPECT().Find(gomock.Any(), &repos.StockLevelsFind{LocationIDs: []int64{3}, AsOf: &asOf}).
+				Return([]*types.StockLevel{{ID: 1, ProductID: 2, LocationID: 3, Qty: 4}}, int64(1), nil).Times(1)
+
+			locations.FindStock(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should reject a bad as_of", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/locations/3/stock?as_of=last-month", nil), map[string]string{"id": "3"},
+			))
+			w := httptest.NewRecorder()
+
+			locations.FindStock(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package locations_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockLocations *mock_repos.MockLocations
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockLocations = mock_repos.NewMockLocations(ctrl)
+
+		mockGr.EXPECT().Locations().Return(mockLocations).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/locations GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/locations?limit=5&offset=10&id=1&code=MAIN&parent_id=2&kind=bin", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockLocations.EXPECT().Find(gomock.Any(), &repos.LocationsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, Codes: []string{"MAIN"},
+				ParentIDs: []int64{2}, Kinds: []types.LocationKind{types.LocationKindBin},
+			}).Return([]*types.Location{{ID: 1, Code: "MAIN"}}, int64(1), nil).Times(1)
+
+			locations.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package locations
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// MoveStock - moves qty of a product from this location to toLocationId, like putting received
+// goods away into a bin. Both stock levels come back, from first.
+func MoveStock(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	productID, err := strconv.ParseInt(mux.Vars(r)["productId"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get product id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get product id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	body := new(types.MoveStock)
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		logger.Debug("unable to read body", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to read body id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// ensure the ids are what was used in the URL
+	body.FromLocationID = id
+	body.ProductID = productID
+
+	levels, err := gr.Putaway().Move(r.Context(), *body)
+	if err != nil {
+		logger.Debug("unable to move stock", log15.Ctx{"err": err, "requestId": requestID, "req": body})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to move stock id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to move stock id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to move stock id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(levels)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal stock levels id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
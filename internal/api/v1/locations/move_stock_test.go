@@ -0,0 +1,80 @@
+package locations_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockPutaway *mock_repos.MockPutaway
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPutaway = mock_repos.NewMockPutaway(ctrl)
+
+		mockGr.EXPECT().Putaway().Return(mockPutaway).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(body string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("POST", "/v1/locations/3/stock/2/move", bytes.NewBufferString(body)),
+			map[string]string{"id": "3", "productId": "2"},
+		))
+	}
+
+	Context("/v1/locations/{id}/stock/{productId}/move POST - move stock", func() {
+		It("should return an error when an invalid body is passed in", func() {
+			w := httptest.NewRecorder()
+			locations.MoveStock(w, newReq(""))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return bad request when there isn't enough stock to move", func() {
+			mockPutaway.EXPECT().Move(gomock.Any(), gomock.Any()).Return(nil, types.NewBadRequestError("not enough stock at location")).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.MoveStock(w, newReq(`{"toLocationId":8,"qty":50}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should move from the location in the url", func() {
+			mockPutaway.EXPECT().Move(gomock.Any(), types.MoveStock{
+				ProductID: 2, FromLocationID: 3, ToLocationID: 8, Qty: 5,
+			}).Return([]*types.StockLevel{
+				{ID: 1, ProductID: 2, LocationID: 3, Qty: 1},
+				{ID: 2, ProductID: 2, LocationID: 8, Qty: 5},
+			}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.MoveStock(w, newReq(`{"productId":9,"fromLocationId":9,"toLocationId":8,"qty":5}`))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"locationId":8`))
+		})
+	})
+})
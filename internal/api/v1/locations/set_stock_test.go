@@ -0,0 +1,74 @@
+package locations_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockStockLevels *mock_repos.MockStockLevels
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockStockLevels = mock_repos.NewMockStockLevels(ctrl)
+
+		mockGr.EXPECT().StockLevels().Return(mockStockLevels).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(body string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("PUT", "/v1/locations/3/stock/2", bytes.NewBufferString(body)),
+			map[string]string{"id": "3", "productId": "2"},
+		))
+	}
+
+	Context("/v1/locations/{id}/stock/{productId} PUT - set stock", func() {
+		It("should return an error when an invalid body is passed in", func() {
+			w := httptest.NewRecorder()
+			locations.SetStock(w, newReq(""))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown product or location", func() {
+			mockStockLevels.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil, types.NewNotFoundError("product not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.SetStock(w, newReq(`{"qty":5}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the ids from the url", func() {
+			mockStockLevels.EXPECT().Set(gomock.Any(), types.SetStockLevel{
+				ProductID: 2, LocationID: 3, Qty: utils.Ref(int64(5)), ReorderPoint: utils.Ref(int64(2)),
+			}).Return(&types.StockLevel{ID: 1, ProductID: 2, LocationID: 3, Qty: 5, ReorderPoint: 2}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			locations.SetStock(w, newReq(`{"productId":9,"locationId":9,"qty":5,"reorderPoint":2}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
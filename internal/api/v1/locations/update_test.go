@@ -0,0 +1,76 @@
+package locations_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/locations", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockLocations *mock_repos.MockLocations
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockLocations = mock_repos.NewMockLocations(ctrl)
+
+		mockGr.EXPECT().Locations().Return(mockLocations).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/locations/{id} PUT - update", func() {
+		It("should return not found for an unknown location", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/locations/1", bytes.NewBufferString(`{"name":"Other"}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockLocations.EXPECT().Update(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("location not found by id")).Times(1)
+
+			locations.Update(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the id from the url", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/locations/1", bytes.NewBufferString(`{"id":5,"name":"Other"}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockLocations.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, diff *types.UpdateLocation) (*types.Location, error) {
+					Expect(diff.ID).To(BeNumerically("==", 1))
+					return &types.Location{ID: 1, Name: *diff.Name, Code: "MAIN"}, nil
+				}).Times(1)
+
+			locations.Update(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring("Other"))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package lots_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/lots"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/lots", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockLots *mock_repos.MockLots
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockLots = mock_repos.NewMockLots(ctrl)
+
+		mockGr.EXPECT().Lots().Return(mockLots).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/lots GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/lots?limit=5&offset=10&id=1&product_id=2&lot_number=L-100&in_stock=true", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockLots.EXPECT().Find(gomock.Any(), &repos.LotsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, ProductIDs: []int64{2}, LotNumbers: []string{"L-100"}, InStock: true,
+			}).Return([]*types.Lot{{ID: 1, ProductID: 2, LotNumber: "L-100", Qty: 4}}, int64(1), nil).Times(1)
+
+			lots.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
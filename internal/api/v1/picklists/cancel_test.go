@@ -0,0 +1,74 @@
+package picklists_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/picklists"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/pick-lists", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockPickLists *mock_repos.MockPickLists
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPickLists = mock_repos.NewMockPickLists(ctrl)
+
+		mockGr.EXPECT().PickLists().Return(mockPickLists).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/pick-lists/{id}/cancel POST - cancel", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/pick-lists/1/cancel", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a conflict when the list has already shipped", func() {
+			mockPickLists.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewBadRequestError("pick list can not go from shipped to cancelled")).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should return not found", func() {
+			mockPickLists.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewNotFoundError("pick list not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the list", func() {
+			mockPickLists.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(&types.PickList{ID: 1, Status: types.PickListStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package picklists_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/picklists"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/pick-lists", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockPickLists *mock_repos.MockPickLists
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPickLists = mock_repos.NewMockPickLists(ctrl)
+
+		mockGr.EXPECT().PickLists().Return(mockPickLists).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/pick-lists POST - create", func() {
+		body := []byte(`{"salesOrderId":2}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			picklists.Create(w, httptest.NewRequest("POST", "/v1/pick-lists", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/pick-lists", nil))
+			w := httptest.NewRecorder()
+			picklists.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/pick-lists", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockPickLists.EXPECT().Create(gomock.Any(), types.NewPickList{SalesOrderID: 2}).
+				Return(nil, types.NewBadRequestError("BOGUS:PickLists.create")).Times(1)
+
+			picklists.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create pick list"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown sales order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/pick-lists", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockPickLists.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("sales order not found by id")).Times(1)
+
+			picklists.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a pick list", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/pick-lists", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockPickLists.EXPECT().Create(gomock.Any(), types.NewPickList{SalesOrderID: 2}).
+				Return(&types.PickList{ID: 1, SalesOrderID: 2, Status: types.PickListStatusOpen}, nil).Times(1)
+
+			picklists.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"open"`))
+		})
+	})
+})
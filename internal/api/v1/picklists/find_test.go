@@ -0,0 +1,58 @@
+package picklists_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/picklists"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/pick-lists", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockPickLists *mock_repos.MockPickLists
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPickLists = mock_repos.NewMockPickLists(ctrl)
+
+		mockGr.EXPECT().PickLists().Return(mockPickLists).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/pick-lists GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/pick-lists?limit=5&offset=10&id=1&sales_order_id=2&status=open&status=picked", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockPickLists.EXPECT().Find(gomock.Any(), &repos.PickListsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, SalesOrderIDs: []int64{2},
+				Statuses: []types.PickListStatus{types.PickListStatusOpen, types.PickListStatusPicked},
+			}).Return([]*types.PickList{{ID: 1, SalesOrderID: 2, Status: types.PickListStatusOpen}}, int64(1), nil).Times(1)
+
+			picklists.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
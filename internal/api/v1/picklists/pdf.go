@@ -0,0 +1,131 @@
+package picklists
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/fulfillment"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// PDF - renders the pick list for printing, lines are grouped by location
+func PDF(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	list, exists, err := gr.PickLists().Get(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to get pick list", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get pick list id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get pick list", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get pick list id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	order, _, err := gr.SalesOrders().Get(r.Context(), list.SalesOrderID)
+	if err != nil {
+		logger.Debug("unable to get sales order", log15.Ctx{"err": err, "id": list.SalesOrderID, "requestId": requestID})
+		http.Error(w, "unable to get sales order id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	doc := fulfillment.PickListDocument{
+		PickList:  list,
+		Order:     order,
+		Products:  map[int64]*types.Product{},
+		Locations: map[int64]*types.Location{},
+		Lots:      map[int64]*types.Lot{},
+	}
+
+	productIDs := []int64{}
+	locationIDs := []int64{}
+	lotIDs := []int64{}
+	seen := map[string]bool{}
+	for _, line := range list.Lines {
+		if key := fmt.Sprintf("p%d", line.ProductID); !seen[key] {
+			seen[key] = true
+			productIDs = append(productIDs, line.ProductID)
+		}
+		if line.LocationID != nil {
+			if key := fmt.Sprintf("l%d", *line.LocationID); !seen[key] {
+				seen[key] = true
+				locationIDs = append(locationIDs, *line.LocationID)
+			}
+		}
+		if line.LotID != nil {
+			if key := fmt.Sprintf("t%d", *line.LotID); !seen[key] {
+				seen[key] = true
+				lotIDs = append(lotIDs, *line.LotID)
+			}
+		}
+	}
+
+	if len(productIDs) > 0 {
+		products, _, err := gr.Products().Find(r.Context(), &repos.ProductsFind{IDs: productIDs})
+		if err != nil {
+			logger.Debug("unable to find products", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+			http.Error(w, "unable to find products id: "+requestID, http.StatusInternalServerError)
+			return
+		}
+		for _, product := range products {
+			doc.Products[product.ID] = product
+		}
+	}
+
+	if len(locationIDs) > 0 {
+		locations, _, err := gr.Locations().Find(r.Context(), &repos.LocationsFind{IDs: locationIDs})
+		if err != nil {
+			logger.Debug("unable to find locations", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+			http.Error(w, "unable to find locations id: "+requestID, http.StatusInternalServerError)
+			return
+		}
+		for _, location := range locations {
+			doc.Locations[location.ID] = location
+		}
+	}
+
+	if len(lotIDs) > 0 {
+		lots, _, err := gr.Lots().Find(r.Context(), &repos.LotsFind{IDs: lotIDs})
+		if err != nil {
+			logger.Debug("unable to find lots", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+			http.Error(w, "unable to find lots id: "+requestID, http.StatusInternalServerError)
+			return
+		}
+		for _, lot := range lots {
+			doc.Lots[lot.ID] = lot
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := fulfillment.RenderPickList(buf, doc); err != nil {
+		logger.Debug("unable to render pick list", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to render pick list id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"pick-list-%d.pdf\"", list.ID))
+	w.Write(buf.Bytes())
+}
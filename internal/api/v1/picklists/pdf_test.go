@@ -0,0 +1,89 @@
+package picklists_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/picklists"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/pick-lists", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockPickLists   *mock_repos.MockPickLists
+		mockSalesOrders *mock_repos.MockSalesOrders
+		mockProducts    *mock_repos.MockProducts
+		mockLocations   *mock_repos.MockLocations
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPickLists = mock_repos.NewMockPickLists(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+		mockProducts = mock_repos.NewMockProducts(ctrl)
+		mockLocations = mock_repos.NewMockLocations(ctrl)
+
+		mockGr.EXPECT().PickLists().Return(mockPickLists).AnyTimes()
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+		mockGr.EXPECT().Products().Return(mockProducts).AnyTimes()
+		mockGr.EXPECT().Locations().Return(mockLocations).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/pick-lists/{id}/pdf GET - pdf", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/pick-lists/1/pdf", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return not found for an unknown pick list", func() {
+			mockPickLists.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.PDF(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should render the pick list as a pdf", func() {
+			mockPickLists.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.PickList{
+				ID: 1, SalesOrderID: 2, Status: types.PickListStatusOpen,
+				Lines: []*types.PickListLine{
+					{ID: 1, ProductID: 3, LocationID: utils.Ref[int64](4), Qty: 2},
+					{ID: 2, ProductID: 3, Qty: 1},
+				},
+			}, true, nil).Times(1)
+			mockSalesOrders.EXPECT().Get(gomock.Any(), int64(2)).
+				Return(&types.SalesOrder{ID: 2, CustomerName: "Jane Doe"}, true, nil).Times(1)
+			mockProducts.EXPECT().Find(gomock.Any(), &repos.ProductsFind{IDs: []int64{3}}).
+				Return([]*types.Product{{ID: 3, Name: "some product", Sku: "SKU-3"}}, int64(1), nil).Times(1)
+			mockLocations.EXPECT().Find(gomock.Any(), &repos.LocationsFind{IDs: []int64{4}}).
+				Return([]*types.Location{{ID: 4, Code: "A-01", Name: "Aisle 1"}}, int64(1), nil).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.PDF(w, newReq())
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/pdf"))
+			Expect(resp.Header.Get("Content-Disposition")).To(Equal(`inline; filename="pick-list-1.pdf"`))
+			Expect(w.Body.String()).To(HavePrefix("%PDF"))
+		})
+	})
+})
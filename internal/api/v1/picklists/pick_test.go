@@ -0,0 +1,84 @@
+package picklists_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/picklists"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/pick-lists", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockPickLists *mock_repos.MockPickLists
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPickLists = mock_repos.NewMockPickLists(ctrl)
+
+		mockGr.EXPECT().PickLists().Return(mockPickLists).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/pick-lists/{id}/pick POST - pick", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/pick-lists/1/pick", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			picklists.Pick(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when more is picked than was asked for", func() {
+			mockPickLists.EXPECT().Pick(gomock.Any(), &types.PickPickList{
+				ID: 1, Lines: []types.PickedLine{{PickListLineID: 2, QtyPicked: 9}},
+			}).Return(nil, types.NewBadRequestError("can not pick more than is on the line")).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.Pick(w, newReq(`{"id":5,"lines":[{"pickListLineId":2,"qtyPicked":9}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockPickLists.EXPECT().Pick(gomock.Any(), &types.PickPickList{ID: 1}).
+				Return(nil, types.NewNotFoundError("pick list not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.Pick(w, newReq(`{}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should pick the list", func() {
+			mockPickLists.EXPECT().Pick(gomock.Any(), &types.PickPickList{ID: 1}).
+				Return(&types.PickList{ID: 1, Status: types.PickListStatusPicked}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			picklists.Pick(w, newReq(`{}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"picked"`))
+		})
+	})
+})
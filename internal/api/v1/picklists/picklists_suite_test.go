@@ -0,0 +1,13 @@
+package picklists_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPickLists(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PickLists Suite")
+}
@@ -12,6 +12,15 @@ var logger = log15.New("/v1/products")
 func SetRoutes(subrouter *mux.Router) {
 	subrouter.HandleFunc("", Find).Methods(http.MethodGet)
 	subrouter.HandleFunc("/{id:[0-9]+}", Get).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/label", Label).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/attachments", FindAttachments).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/attachments", UploadAttachment).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/suppliers", FindSuppliers).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/suppliers", LinkSupplier).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/suppliers/{supplierId:[0-9]+}", UpdateSupplier).Methods(http.MethodPut)
+	subrouter.HandleFunc("/{id:[0-9]+}/suppliers/{supplierId:[0-9]+}", UnlinkSupplier).Methods(http.MethodDelete)
+	subrouter.HandleFunc("/{id:[0-9]+}/bom", GetBom).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/bom", SetBom).Methods(http.MethodPut)
 	subrouter.HandleFunc("/{id:[0-9]+}", Update).Methods(http.MethodPut)
 	subrouter.HandleFunc("/{id:[0-9]+}", Destroy).Methods(http.MethodDelete)
 	subrouter.HandleFunc("", Create).Methods(http.MethodPost)
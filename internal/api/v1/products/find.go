@@ -8,10 +8,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
 	"github.com/happilymarrieddad/product-inventory-management-system/types"
 	"github.com/inconshreveable/log15"
 )
 
+// Find - as_of (see utils.ParseAsOf) gives each product's qty as it was at that time
 func Find(w http.ResponseWriter, r *http.Request) {
 	requestID := uuid.New().String()
 	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
@@ -60,6 +62,16 @@ func Find(w http.ResponseWriter, r *http.Request) {
 		opts.Skus = append(opts.Skus, skuRaw...)
 	}
 
+	if raw := qry.Get("as_of"); raw != "" {
+		asOf, err := utils.ParseAsOf(raw)
+		if err != nil {
+			logger.Debug("invalid as of", log15.Ctx{"err": err, "asOf": raw, "requestId": requestID})
+			http.Error(w, "invalid as_of id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		opts.AsOf = &asOf
+	}
+
 	// Use access to the database to find the requested object(s)
 	res, count, err := gr.Products().Find(r.Context(), opts)
 	if err != nil {
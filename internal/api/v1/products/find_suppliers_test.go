@@ -0,0 +1,98 @@
+package products_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl                 *gomock.Controller
+		mockGr               *mock_repos.MockGlobalRepo
+		mockProductSuppliers *mock_repos.MockProductSuppliers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockProductSuppliers = mock_repos.NewMockProductSuppliers(ctrl)
+
+		mockGr.EXPECT().ProductSuppliers().Return(mockProductSuppliers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/products/{id}/attachments GET - find suppliers", func() {
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("GET", "/v1/products/1/suppliers", nil)
+			w := httptest.NewRecorder()
+
+			products.FindSuppliers(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should sanitize the err from the repo when an internal error", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/products/1/suppliers", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockProductSuppliers.EXPECT().Find(gomock.Any(), &repos.ProductSuppliersFind{ProductIDs: []int64{1}}).
+				Return(nil, int64(0), types.NewInternalServerError("BOGUS:ProductSuppliers.find")).Times(1)
+
+			products.FindSuppliers(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should successfully find the suppliers for the product", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/products/1/suppliers?limit=5", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockProductSuppliers.EXPECT().Find(gomock.Any(), &repos.ProductSuppliersFind{Limit: 5, ProductIDs: []int64{1}}).
+				Return([]*types.ProductSupplier{
+					{ID: 1, ProductID: 1, SupplierID: 1, SupplierSku: utils.Ref("ACME-100"), UnitCost: 250, Preferred: true},
+				}, int64(1), nil).Times(1)
+
+			products.FindSuppliers(w, req)
+
+			resp := w.Result()
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(bts)).To(ContainSubstring("ACME-100"))
+		})
+	})
+})
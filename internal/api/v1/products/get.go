@@ -8,9 +8,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
 	"github.com/inconshreveable/log15"
 )
 
+// Get - as_of (see utils.ParseAsOf) gives the product's qty as it was at that time, it's not
+// found if it didn't exist yet
 func Get(w http.ResponseWriter, r *http.Request) {
 	requestID := uuid.New().String()
 	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
@@ -28,7 +33,23 @@ func Get(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Use access to the database to find the requested object
-	product, exists, err := gr.Products().Get(r.Context(), id)
+	var product *types.Product
+	if raw := r.URL.Query().Get("as_of"); raw != "" {
+		asOf, parseErr := utils.ParseAsOf(raw)
+		if parseErr != nil {
+			logger.Debug("invalid as of", log15.Ctx{"err": parseErr, "asOf": raw, "requestId": requestID})
+			http.Error(w, "invalid as_of id: "+requestID, http.StatusBadRequest)
+			return
+		}
+
+		var products []*types.Product
+		products, _, err = gr.Products().Find(r.Context(), &repos.ProductsFind{IDs: []int64{id}, AsOf: &asOf})
+		if exists = len(products) > 0; exists {
+			product = products[0]
+		}
+	} else {
+		product, exists, err = gr.Products().Get(r.Context(), id)
+	}
 	if err != nil {
 		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": id, "requestId": requestID})
 		http.Error(w, "unable to get product id: "+requestID, http.StatusInternalServerError)
@@ -0,0 +1,75 @@
+package products_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockBoms *mock_repos.MockBoms
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockBoms = mock_repos.NewMockBoms(ctrl)
+
+		mockGr.EXPECT().Boms().Return(mockBoms).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/products/{id}/bom GET - get bill of materials", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/products/1/bom", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should sanitize the err from the repo", func() {
+			mockBoms.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, errors.New("BOGUS")).Times(1)
+
+			w := httptest.NewRecorder()
+			products.GetBom(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+			Expect(w.Body.String()).NotTo(ContainSubstring("BOGUS"))
+		})
+
+		It("should return not found for an unknown product", func() {
+			mockBoms.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, types.NewNotFoundError("product not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			products.GetBom(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return the lines", func() {
+			mockBoms.EXPECT().Get(gomock.Any(), int64(1)).
+				Return([]*types.BomLine{{ID: 1, ProductID: 1, ComponentID: 2, Qty: 3}}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			products.GetBom(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
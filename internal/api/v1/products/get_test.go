@@ -4,10 +4,12 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
 	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
 	"github.com/happilymarrieddad/product-inventory-management-system/types"
 	. "github.com/onsi/ginkgo/v2"
@@ -129,5 +131,55 @@ var _ = Describe("HTTP: /v1/products", func() {
 			Expect(resp.StatusCode).To(Equal(http.StatusOK))
 			Expect(string(bts)).To(ContainSubstring("some product"))
 		})
+
+		It("should reject a bad as_of", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/products/1?as_of=yesterday", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			products.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should get a product as of a date", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/products/1?as_of=2026-09-30", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Find(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ProductsFind) ([]*types.Product, int64, error) {
+				Expect(opts.IDs).To(Equal([]int64{1}))
+				Expect(*opts.AsOf).To(Equal(time.Date(2026, 9, 30, 23, 59, 59, 999999999, time.UTC)))
+				return []*types.Product{{ID: 1, Name: "some product", Qty: 12}}, int64(1), nil
+			}).Times(1)
+
+			products.Get(w, req)
+
+			resp := w.Result()
+			bts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(bts)).To(ContainSubstring(`"qty":12`))
+		})
+
+		It("should not find a product that didn't exist yet", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, mux.SetURLVars(httptest.NewRequest("GET", "/v1/products/1?as_of=2020-01-01", nil),
+					map[string]string{"id": "1"},
+				),
+			)
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Find(gomock.Any(), gomock.Any()).Return([]*types.Product{}, int64(0), nil).Times(1)
+
+			products.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
 	})
 })
@@ -0,0 +1,121 @@
+package products
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/labels"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Label - renders a shelf label for the product
+//
+//	format=png|pdf|zpl (default png)
+//	symbology=code128|ean13|qr (default code128)
+//	barcode=<code> encode one of the product's registered barcodes instead of the SKU
+func Label(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	qry := r.URL.Query()
+
+	format := labels.FormatPNG
+	if f := qry.Get("format"); f != "" {
+		format = labels.Format(f)
+	}
+
+	symbology := labels.SymbologyCode128
+	if s := qry.Get("symbology"); s != "" {
+		symbology = labels.Symbology(s)
+	}
+
+	// Use access to the database to find the requested object
+	product, exists, err := gr.Products().Get(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get product id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get product id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	data := product.Sku
+	if code := qry.Get("barcode"); code != "" {
+		barcode, exists, err := gr.Barcodes().Get(r.Context(), code)
+		if err != nil {
+			logger.Debug("unable to get barcode", log15.Ctx{"err": err, "code": code, "requestId": requestID})
+			http.Error(w, "unable to get barcode id: "+requestID, http.StatusInternalServerError)
+			return
+		}
+		if !exists || barcode.ProductID != product.ID {
+			logger.Debug("barcode does not belong to product", log15.Ctx{"code": code, "id": id, "requestId": requestID})
+			http.Error(w, "unable to get barcode id: "+requestID, http.StatusNotFound)
+			return
+		}
+		data = barcode.Code
+	} else if symbology == labels.SymbologyEAN13 {
+		// a SKU is rarely a valid EAN so fall back to the first retail barcode registered
+		barcodes, _, err := gr.Barcodes().Find(r.Context(), &repos.BarcodesFind{ProductIDs: []int64{product.ID}})
+		if err != nil {
+			logger.Debug("unable to find barcodes", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+			http.Error(w, "unable to find barcode id: "+requestID, http.StatusInternalServerError)
+			return
+		}
+
+		data = ""
+		for _, barcode := range barcodes {
+			if barcode.Type == types.BarcodeTypeEAN13 {
+				data = barcode.Code
+				break
+			}
+			if barcode.Type == types.BarcodeTypeUPCA && data == "" {
+				// a UPC-A is an EAN-13 with a leading zero
+				data = "0" + barcode.Code
+			}
+		}
+		if data == "" {
+			logger.Debug("product has no ean13 compatible barcode", log15.Ctx{"id": id, "requestId": requestID})
+			http.Error(w, "product has no ean13 barcode id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := labels.Render(buf, format, labels.Label{
+		Name: product.Name, Sku: product.Sku, Data: data, Symbology: symbology,
+	}); err != nil {
+		logger.Debug("unable to render label", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to render label id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "unable to render label id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"label-%d.%s\"", product.ID, format))
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,161 @@
+package products_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockProducts *mock_repos.MockProducts
+		mockBarcodes *mock_repos.MockBarcodes
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockProducts = mock_repos.NewMockProducts(ctrl)
+		mockBarcodes = mock_repos.NewMockBarcodes(ctrl)
+
+		mockGr.EXPECT().Products().Return(mockProducts).AnyTimes()
+		mockGr.EXPECT().Barcodes().Return(mockBarcodes).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/products/{id}/label GET - label", func() {
+		newRequest := func(query string) *http.Request {
+			req := httptest.NewRequest("GET", "/v1/products/1/label?"+query, nil)
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(req, map[string]string{"id": "1"}))
+		}
+
+		It("should return an error when the repo is not on the context", func() {
+			req := httptest.NewRequest("GET", "/v1/products/1/label", nil)
+			w := httptest.NewRecorder()
+
+			products.Label(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found for an unknown product", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			products.Label(w, newRequest(""))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should render the sku as a code128 png by default", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product", Sku: "SKU-1",
+			}, true, nil).Times(1)
+
+			products.Label(w, newRequest(""))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("image/png"))
+		})
+
+		It("should reject an unknown format", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product", Sku: "SKU-1",
+			}, true, nil).Times(1)
+
+			products.Label(w, newRequest("format=bogus"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should not print a barcode that belongs to another product", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product", Sku: "SKU-1",
+			}, true, nil).Times(1)
+			mockBarcodes.EXPECT().Get(gomock.Any(), "4006381333931").Return(&types.Barcode{
+				ProductID: 2, Code: "4006381333931", Type: types.BarcodeTypeEAN13,
+			}, true, nil).Times(1)
+
+			products.Label(w, newRequest("barcode=4006381333931"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the product's upc for an ean13 zpl label", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product", Sku: "SKU-1",
+			}, true, nil).Times(1)
+			mockBarcodes.EXPECT().Find(gomock.Any(), &repos.BarcodesFind{ProductIDs: []int64{1}}).Return([]*types.Barcode{
+				{ProductID: 1, Code: "036000291452", Type: types.BarcodeTypeUPCA},
+			}, int64(1), nil).Times(1)
+
+			products.Label(w, newRequest("format=zpl&symbology=ean13"))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+			Expect(string(bts)).To(ContainSubstring("^FD003600029145^FS"))
+			Expect(string(bts)).To(ContainSubstring("some product"))
+		})
+
+		It("should reject an ean13 label when the product has no retail barcode", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product", Sku: "SKU-1",
+			}, true, nil).Times(1)
+			mockBarcodes.EXPECT().Find(gomock.Any(), &repos.BarcodesFind{ProductIDs: []int64{1}}).
+				Return([]*types.Barcode{}, int64(0), nil).Times(1)
+
+			products.Label(w, newRequest("symbology=ean13"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should render a pdf", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{
+				ID: 1, Name: "some product", Sku: "SKU-1",
+			}, true, nil).Times(1)
+
+			products.Label(w, newRequest("format=pdf&symbology=qr"))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/pdf"))
+		})
+	})
+})
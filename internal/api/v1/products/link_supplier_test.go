@@ -0,0 +1,80 @@
+package products_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl                 *gomock.Controller
+		mockGr               *mock_repos.MockGlobalRepo
+		mockProductSuppliers *mock_repos.MockProductSuppliers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockProductSuppliers = mock_repos.NewMockProductSuppliers(ctrl)
+
+		mockGr.EXPECT().ProductSuppliers().Return(mockProductSuppliers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(body string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("POST", "/v1/products/1/suppliers", bytes.NewBufferString(body)),
+			map[string]string{"id": "1"},
+		))
+	}
+
+	Context("/v1/products/{id}/suppliers POST - link supplier", func() {
+		It("should return an error when an invalid body is passed in", func() {
+			w := httptest.NewRecorder()
+			products.LinkSupplier(w, newReq(""))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown supplier", func() {
+			mockProductSuppliers.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("supplier not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			products.LinkSupplier(w, newReq(`{"supplierId":9}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should link the supplier to the product in the url", func() {
+			mockProductSuppliers.EXPECT().Create(gomock.Any(), types.NewProductSupplier{
+				ProductID: 1, SupplierID: 2, UnitCost: 250, MinOrderQty: 10, LeadTimeDays: 14, Preferred: true,
+			}).Return(&types.ProductSupplier{
+				ID: 1, ProductID: 1, SupplierID: 2, UnitCost: 250, MinOrderQty: 10, LeadTimeDays: 14, Preferred: true,
+			}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			products.LinkSupplier(w, newReq(`{"productId":7,"supplierId":2,"unitCost":250,"minOrderQty":10,"leadTimeDays":14,"preferred":true}`))
+
+			resp := w.Result()
+			bts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(bts)).To(ContainSubstring(`"leadTimeDays":14`))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package products_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockGr   *mock_repos.MockGlobalRepo
+		mockBoms *mock_repos.MockBoms
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockBoms = mock_repos.NewMockBoms(ctrl)
+
+		mockGr.EXPECT().Boms().Return(mockBoms).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/products/{id}/bom PUT - set bill of materials", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/products/1/bom", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			products.SetBom(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when the product is made of itself", func() {
+			mockBoms.EXPECT().Set(gomock.Any(), &types.SetBom{ProductID: 1, Lines: []types.NewBomLine{{ComponentID: 1, Qty: 1}}}).
+				Return(nil, types.NewBadRequestError("a product can not be a component of itself")).Times(1)
+
+			w := httptest.NewRecorder()
+			products.SetBom(w, newReq(`{"productId":5,"lines":[{"componentId":1,"qty":1}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should replace the lines", func() {
+			mockBoms.EXPECT().Set(gomock.Any(), &types.SetBom{ProductID: 1, Lines: []types.NewBomLine{{ComponentID: 2, Qty: 3}}}).
+				Return([]*types.BomLine{{ID: 1, ProductID: 1, ComponentID: 2, Qty: 3}}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			products.SetBom(w, newReq(`{"lines":[{"componentId":2,"qty":3}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"componentId":2`))
+		})
+	})
+})
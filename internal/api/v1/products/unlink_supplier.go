@@ -0,0 +1,48 @@
+package products
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+func UnlinkSupplier(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	supplierID, err := strconv.ParseInt(mux.Vars(r)["supplierId"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get supplier id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get supplier id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	if err := gr.ProductSuppliers().Destroy(r.Context(), id, supplierID); err != nil {
+		logger.Debug("unable to unlink supplier", log15.Ctx{"err": err, "id": id, "supplierId": supplierID, "requestId": requestID})
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to unlink supplier id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to unlink supplier id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package products_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl                 *gomock.Controller
+		mockGr               *mock_repos.MockGlobalRepo
+		mockProductSuppliers *mock_repos.MockProductSuppliers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockProductSuppliers = mock_repos.NewMockProductSuppliers(ctrl)
+
+		mockGr.EXPECT().ProductSuppliers().Return(mockProductSuppliers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/products/{id}/suppliers/{supplierId} DELETE - unlink supplier", func() {
+		var req *http.Request
+
+		BeforeEach(func() {
+			req = middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("DELETE", "/v1/products/1/suppliers/2", nil),
+				map[string]string{"id": "1", "supplierId": "2"},
+			))
+		})
+
+		It("should return not found when the supplier is not linked", func() {
+			mockProductSuppliers.EXPECT().Destroy(gomock.Any(), int64(1), int64(2)).
+				Return(types.NewNotFoundError("product supplier not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			products.UnlinkSupplier(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should unlink the supplier", func() {
+			mockProductSuppliers.EXPECT().Destroy(gomock.Any(), int64(1), int64(2)).Return(nil).Times(1)
+
+			w := httptest.NewRecorder()
+			products.UnlinkSupplier(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package products_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl                 *gomock.Controller
+		mockGr               *mock_repos.MockGlobalRepo
+		mockProductSuppliers *mock_repos.MockProductSuppliers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockProductSuppliers = mock_repos.NewMockProductSuppliers(ctrl)
+
+		mockGr.EXPECT().ProductSuppliers().Return(mockProductSuppliers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(body string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("PUT", "/v1/products/1/suppliers/2", bytes.NewBufferString(body)),
+			map[string]string{"id": "1", "supplierId": "2"},
+		))
+	}
+
+	Context("/v1/products/{id}/suppliers/{supplierId} PUT - update supplier", func() {
+		It("should return not found when the supplier is not linked", func() {
+			mockProductSuppliers.EXPECT().Update(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("product supplier not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			products.UpdateSupplier(w, newReq(`{"unitCost":300}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the ids from the url", func() {
+			mockProductSuppliers.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error) {
+					Expect(diff.ProductID).To(BeNumerically("==", 1))
+					Expect(diff.SupplierID).To(BeNumerically("==", 2))
+					Expect(*diff.UnitCost).To(BeNumerically("==", 300))
+					return &types.ProductSupplier{ID: 1, ProductID: 1, SupplierID: 2, UnitCost: 300}, nil
+				}).Times(1)
+
+			w := httptest.NewRecorder()
+			products.UpdateSupplier(w, newReq(`{"productId":5,"unitCost":300}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
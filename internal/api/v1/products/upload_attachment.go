@@ -0,0 +1,202 @@
+package products
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/storage"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/thumbnails"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// allowedAttachmentTypes - what we will keep based on the sniffed content, not
+// whatever the client claimed. docx/xlsx spec sheets sniff as zip.
+var allowedAttachmentTypes = map[string]bool{
+	"image/jpeg":                true,
+	"image/png":                 true,
+	"image/gif":                 true,
+	"image/webp":                true,
+	"application/pdf":           true,
+	"application/zip":           true,
+	"text/plain; charset=utf-8": true,
+}
+
+// UploadAttachment - stores the multipart "file" field against the product
+func UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	store, exists := middleware.RetrieveStorage(r.Context())
+	if !exists {
+		logger.Debug("unable to get storage from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	_, exists, err = gr.Products().Get(r.Context(), id)
+	if err != nil {
+		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get product id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get product", log15.Ctx{"err": err, "id": id, "requestId": requestID})
+		http.Error(w, "unable to get product id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	// Stream the file part straight through to storage instead of buffering the whole form
+	part, err := filePart(r)
+	if err != nil {
+		logger.Debug("unable to read body", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to read body id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(part, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		logger.Debug("unable to read body", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to read body id: "+requestID, http.StatusBadRequest)
+		return
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if !allowedAttachmentTypes[contentType] {
+		logger.Debug("unsupported attachment type", log15.Ctx{"contentType": contentType, "requestId": requestID})
+		http.Error(w, "unsupported file type id: "+requestID, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	key := fmt.Sprintf("products/%d/%s", id, uuid.New().String())
+	size, err := store.Put(r.Context(), key, io.MultiReader(bytes.NewReader(head), part))
+	if err != nil {
+		logger.Debug("unable to store attachment", log15.Ctx{"err": err, "requestId": requestID})
+		if errors.Is(err, storage.ErrTooLarge) {
+			http.Error(w, "file too large id: "+requestID, http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "unable to store attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	newAttachment := types.NewAttachment{
+		ProductID:   id,
+		FileName:    attachmentFileName(part),
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  key,
+	}
+
+	// A missing thumbnail is not worth failing the upload over
+	if thumbnails.Supported(contentType) {
+		if thumbKey, err := storeThumbnail(r, store, key); err != nil {
+			logger.Debug("unable to create thumbnail", log15.Ctx{"err": err, "key": key, "requestId": requestID})
+		} else {
+			newAttachment.ThumbnailKey = &thumbKey
+		}
+	}
+
+	// Use access to the database to create the new object
+	attachment, err := gr.Attachments().Create(r.Context(), newAttachment)
+	if err != nil {
+		logger.Debug("unable to create attachment", log15.Ctx{"err": err, "requestId": requestID})
+		store.Delete(r.Context(), key)
+		if newAttachment.ThumbnailKey != nil {
+			store.Delete(r.Context(), *newAttachment.ThumbnailKey)
+		}
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to create attachment id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "unable to create attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(attachment)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal attachment id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write(bts)
+}
+
+// filePart - finds the "file" field of a multipart request
+func filePart(r *http.Request) (*multipart.Part, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			if err == io.EOF {
+				return nil, errors.New("missing file field")
+			}
+			return nil, err
+		}
+
+		if part.FormName() == "file" {
+			return part, nil
+		}
+	}
+}
+
+func attachmentFileName(part *multipart.Part) string {
+	name := filepath.Base(part.FileName())
+	if name == "." || name == "/" || name == "" {
+		return "attachment"
+	}
+	return name
+}
+
+// storeThumbnail - reads the stored original back and saves a thumbnail next to it
+func storeThumbnail(r *http.Request, store storage.Storage, key string) (string, error) {
+	rc, err := store.Get(r.Context(), key)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	bts, err := thumbnails.Generate(rc, thumbnails.DefaultSize)
+	if err != nil {
+		return "", err
+	}
+
+	thumbKey := key + "-thumb"
+	if _, err := store.Put(r.Context(), thumbKey, bytes.NewReader(bts)); err != nil {
+		return "", err
+	}
+
+	return thumbKey, nil
+}
@@ -0,0 +1,206 @@
+package products_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/storage"
+	mock_storage "github.com/happilymarrieddad/product-inventory-management-system/internal/storage/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/products", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockProducts    *mock_repos.MockProducts
+		mockAttachments *mock_repos.MockAttachments
+		mockStorage     *mock_storage.MockStorage
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockProducts = mock_repos.NewMockProducts(ctrl)
+		mockAttachments = mock_repos.NewMockAttachments(ctrl)
+		mockStorage = mock_storage.NewMockStorage(ctrl)
+
+		mockGr.EXPECT().Products().Return(mockProducts).AnyTimes()
+		mockGr.EXPECT().Attachments().Return(mockAttachments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/products/{id}/attachments POST - upload", func() {
+		newRequest := func(fileName string, content []byte) *http.Request {
+			body := new(bytes.Buffer)
+			mw := multipart.NewWriter(body)
+			fw, err := mw.CreateFormFile("file", fileName)
+			Expect(err).To(BeNil())
+			_, err = fw.Write(content)
+			Expect(err).To(BeNil())
+			Expect(mw.Close()).To(Succeed())
+
+			req := httptest.NewRequest("POST", "/v1/products/1/attachments", body)
+			req.Header.Set("Content-Type", mw.FormDataContentType())
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			return middleware.SetStorageOnContext(mockStorage, middleware.SetGlobalRepoOnContext(mockGr, req))
+		}
+
+		pngBytes := func() []byte {
+			buf := new(bytes.Buffer)
+			Expect(png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 10, 10)))).To(Succeed())
+			return buf.Bytes()
+		}
+
+		It("should return an error when the storage is not on the context", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/products/1/attachments", nil))
+			w := httptest.NewRecorder()
+
+			products.UploadAttachment(w, req)
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found for an unknown product", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			products.UploadAttachment(w, newRequest("a.png", pngBytes()))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should reject a request without a file", func() {
+			req := httptest.NewRequest("POST", "/v1/products/1/attachments", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			req = middleware.SetStorageOnContext(mockStorage, middleware.SetGlobalRepoOnContext(mockGr, req))
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{ID: 1}, true, nil).Times(1)
+
+			products.UploadAttachment(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sniff the content and reject types that are not allowed", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{ID: 1}, true, nil).Times(1)
+
+			// named like an image but it's html
+			products.UploadAttachment(w, newRequest("a.png", []byte("<html><script>alert(1)</script></html>")))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusUnsupportedMediaType))
+		})
+
+		It("should reject files over the size limit", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{ID: 1}, true, nil).Times(1)
+			mockStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), storage.ErrTooLarge).Times(1)
+
+			products.UploadAttachment(w, newRequest("spec.pdf", []byte("%PDF-1.4 ...")))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusRequestEntityTooLarge))
+		})
+
+		It("should store a pdf without a thumbnail", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{ID: 1}, true, nil).Times(1)
+			mockStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, _ string, r io.Reader) (int64, error) {
+					bts, err := io.ReadAll(r)
+					Expect(err).To(BeNil())
+					Expect(string(bts)).To(Equal("%PDF-1.4 ..."))
+					return int64(len(bts)), nil
+				}).Times(1)
+			mockAttachments.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, na types.NewAttachment) (*types.Attachment, error) {
+					Expect(na.FileName).To(Equal("spec.pdf"))
+					Expect(na.ContentType).To(Equal("application/pdf"))
+					Expect(na.Size).To(BeNumerically("==", 12))
+					Expect(na.ThumbnailKey).To(BeNil())
+					return (&types.Attachment{ID: 5, FileName: na.FileName}).SetURLs(), nil
+				}).Times(1)
+
+			products.UploadAttachment(w, newRequest("spec.pdf", []byte("%PDF-1.4 ...")))
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+
+			bts, err := io.ReadAll(resp.Body)
+			Expect(err).To(BeNil())
+			Expect(string(bts)).To(ContainSubstring("/v1/attachments/5/download"))
+		})
+
+		It("should create a thumbnail for images", func() {
+			w := httptest.NewRecorder()
+			stored := map[string][]byte{}
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{ID: 1}, true, nil).Times(1)
+			mockStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, key string, r io.Reader) (int64, error) {
+					bts, err := io.ReadAll(r)
+					Expect(err).To(BeNil())
+					stored[key] = bts
+					return int64(len(bts)), nil
+				}).Times(2)
+			mockStorage.EXPECT().Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, key string) (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewReader(stored[key])), nil
+				}).Times(1)
+			mockAttachments.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, na types.NewAttachment) (*types.Attachment, error) {
+					Expect(na.ContentType).To(Equal("image/png"))
+					Expect(na.ThumbnailKey).NotTo(BeNil())
+					Expect(stored).To(HaveKey(*na.ThumbnailKey))
+					return (&types.Attachment{ID: 5, ThumbnailKey: na.ThumbnailKey}).SetURLs(), nil
+				}).Times(1)
+
+			products.UploadAttachment(w, newRequest("photo.png", pngBytes()))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusCreated))
+		})
+
+		It("should clean up the stored file when the record can not be created", func() {
+			w := httptest.NewRecorder()
+
+			mockProducts.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.Product{ID: 1}, true, nil).Times(1)
+			mockStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(12), nil).Times(1)
+			mockAttachments.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewInternalServerError("BOGUS:Attachments.create")).Times(1)
+			mockStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+			products.UploadAttachment(w, newRequest("spec.pdf", []byte("%PDF-1.4 ...")))
+
+			resp := w.Result()
+
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
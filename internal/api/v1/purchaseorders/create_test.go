@@ -0,0 +1,105 @@
+package purchaseorders_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/purchaseorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/purchase-orders", func() {
+	var (
+		ctrl               *gomock.Controller
+		mockGr             *mock_repos.MockGlobalRepo
+		mockPurchaseOrders *mock_repos.MockPurchaseOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPurchaseOrders = mock_repos.NewMockPurchaseOrders(ctrl)
+
+		mockGr.EXPECT().PurchaseOrders().Return(mockPurchaseOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/purchase-orders POST - create", func() {
+		body := []byte(`{"supplierId":1,"lines":[{"productId":2,"qty":10}]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			purchaseorders.Create(w, httptest.NewRequest("POST", "/v1/purchase-orders", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/purchase-orders", nil))
+			w := httptest.NewRecorder()
+			purchaseorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/purchase-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockPurchaseOrders.EXPECT().Create(gomock.Any(), types.NewPurchaseOrder{SupplierID: 1, Lines: []types.NewPurchaseOrderLine{{ProductID: 2, Qty: 10}}}).
+				Return(nil, types.NewBadRequestError("BOGUS:PurchaseOrders.create")).Times(1)
+
+			purchaseorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create purchase order"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown supplier or product", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/purchase-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockPurchaseOrders.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("supplier not found by id")).Times(1)
+
+			purchaseorders.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a purchase order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/purchase-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockPurchaseOrders.EXPECT().Create(gomock.Any(), types.NewPurchaseOrder{SupplierID: 1, Lines: []types.NewPurchaseOrderLine{{ProductID: 2, Qty: 10}}}).
+				Return(&types.PurchaseOrder{ID: 1, SupplierID: 1, Status: types.PurchaseOrderStatusDraft}, nil).Times(1)
+
+			purchaseorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"draft"`))
+		})
+	})
+})
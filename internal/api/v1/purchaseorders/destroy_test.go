@@ -0,0 +1,70 @@
+package purchaseorders_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/purchaseorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/purchase-orders", func() {
+	var (
+		ctrl               *gomock.Controller
+		mockGr             *mock_repos.MockGlobalRepo
+		mockPurchaseOrders *mock_repos.MockPurchaseOrders
+		req                *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPurchaseOrders = mock_repos.NewMockPurchaseOrders(ctrl)
+
+		mockGr.EXPECT().PurchaseOrders().Return(mockPurchaseOrders).AnyTimes()
+
+		req = middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("DELETE", "/v1/purchase-orders/1", nil), map[string]string{"id": "1"},
+		))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/purchase-orders/{id} DELETE - destroy", func() {
+		It("should return not found", func() {
+			mockPurchaseOrders.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewNotFoundError("purchase order not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return a conflict when the purchase order is not a draft", func() {
+			mockPurchaseOrders.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewBadRequestError("only draft purchase orders can be deleted")).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should successfully destroy a purchase order", func() {
+			mockPurchaseOrders.EXPECT().Destroy(gomock.Any(), int64(1)).Return(nil).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package purchaseorders_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/purchaseorders"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/purchase-orders", func() {
+	var (
+		ctrl               *gomock.Controller
+		mockGr             *mock_repos.MockGlobalRepo
+		mockPurchaseOrders *mock_repos.MockPurchaseOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPurchaseOrders = mock_repos.NewMockPurchaseOrders(ctrl)
+
+		mockGr.EXPECT().PurchaseOrders().Return(mockPurchaseOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/purchase-orders GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/purchase-orders?limit=5&offset=10&id=1&supplier_id=2&status=submitted&status=partially_received", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockPurchaseOrders.EXPECT().Find(gomock.Any(), &repos.PurchaseOrdersFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, SupplierIDs: []int64{2},
+				Statuses: []types.PurchaseOrderStatus{types.PurchaseOrderStatusSubmitted, types.PurchaseOrderStatusPartiallyReceived},
+			}).Return([]*types.PurchaseOrder{{ID: 1, SupplierID: 2, Status: types.PurchaseOrderStatusSubmitted}}, int64(1), nil).Times(1)
+
+			purchaseorders.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
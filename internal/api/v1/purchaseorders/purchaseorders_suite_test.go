@@ -0,0 +1,13 @@
+package purchaseorders_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPurchaseOrders(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PurchaseOrders Suite")
+}
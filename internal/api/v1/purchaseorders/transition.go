@@ -0,0 +1,67 @@
+package purchaseorders
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Submit - sends a draft to the supplier, its lines count as on order from here on
+func Submit(w http.ResponseWriter, r *http.Request) {
+	transition(w, r, types.PurchaseOrderStatusSubmitted)
+}
+
+// Cancel - cancels a draft or open purchase order, anything not yet received comes off order
+func Cancel(w http.ResponseWriter, r *http.Request) {
+	transition(w, r, types.PurchaseOrderStatusCancelled)
+}
+
+func transition(w http.ResponseWriter, r *http.Request, to types.PurchaseOrderStatus) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	order, err := gr.PurchaseOrders().Transition(r.Context(), id, to)
+	if err != nil {
+		logger.Debug("unable to transition purchase order", log15.Ctx{"err": err, "id": id, "to": to, "requestId": requestID})
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to "+string(to)+" purchase order id: "+requestID, http.StatusNotFound)
+			return
+		}
+		// not allowed from the current status
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to "+string(to)+" purchase order id: "+requestID, http.StatusConflict)
+			return
+		}
+		http.Error(w, "unable to "+string(to)+" purchase order id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(order)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal purchase order id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,86 @@
+package purchaseorders_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/purchaseorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/purchase-orders", func() {
+	var (
+		ctrl               *gomock.Controller
+		mockGr             *mock_repos.MockGlobalRepo
+		mockPurchaseOrders *mock_repos.MockPurchaseOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPurchaseOrders = mock_repos.NewMockPurchaseOrders(ctrl)
+
+		mockGr.EXPECT().PurchaseOrders().Return(mockPurchaseOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(action string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("POST", "/v1/purchase-orders/1/"+action, nil), map[string]string{"id": "1"},
+		))
+	}
+
+	Context("/v1/purchase-orders/{id}/submit POST - submit", func() {
+		It("should return a conflict when the order can't be submitted", func() {
+			mockPurchaseOrders.EXPECT().Transition(gomock.Any(), int64(1), types.PurchaseOrderStatusSubmitted).
+				Return(nil, types.NewBadRequestError("purchase order can not go from received to submitted")).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Submit(w, newReq("submit"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should submit the order", func() {
+			mockPurchaseOrders.EXPECT().Transition(gomock.Any(), int64(1), types.PurchaseOrderStatusSubmitted).
+				Return(&types.PurchaseOrder{ID: 1, Status: types.PurchaseOrderStatusSubmitted}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Submit(w, newReq("submit"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("/v1/purchase-orders/{id}/cancel POST - cancel", func() {
+		It("should return not found", func() {
+			mockPurchaseOrders.EXPECT().Transition(gomock.Any(), int64(1), types.PurchaseOrderStatusCancelled).
+				Return(nil, types.NewNotFoundError("purchase order not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Cancel(w, newReq("cancel"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the order", func() {
+			mockPurchaseOrders.EXPECT().Transition(gomock.Any(), int64(1), types.PurchaseOrderStatusCancelled).
+				Return(&types.PurchaseOrder{ID: 1, Status: types.PurchaseOrderStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			purchaseorders.Cancel(w, newReq("cancel"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package purchaseorders_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/purchaseorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/purchase-orders", func() {
+	var (
+		ctrl               *gomock.Controller
+		mockGr             *mock_repos.MockGlobalRepo
+		mockPurchaseOrders *mock_repos.MockPurchaseOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPurchaseOrders = mock_repos.NewMockPurchaseOrders(ctrl)
+
+		mockGr.EXPECT().PurchaseOrders().Return(mockPurchaseOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/purchase-orders/{id} PUT - update", func() {
+		It("should return not found for an unknown purchase order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/purchase-orders/1", bytes.NewBufferString(`{"notes":"Other"}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockPurchaseOrders.EXPECT().Update(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("purchase order not found by id")).Times(1)
+
+			purchaseorders.Update(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should use the id from the url", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("PUT", "/v1/purchase-orders/1", bytes.NewBufferString(`{"id":5,"notes":"Other"}`)),
+				map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockPurchaseOrders.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error) {
+					Expect(diff.ID).To(BeNumerically("==", 1))
+					return &types.PurchaseOrder{ID: 1, Notes: diff.Notes}, nil
+				}).Times(1)
+
+			purchaseorders.Update(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring("Other"))
+		})
+	})
+})
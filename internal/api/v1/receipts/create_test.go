@@ -0,0 +1,109 @@
+package receipts_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/receipts"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/receipts", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockReceipts *mock_repos.MockReceipts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReceipts = mock_repos.NewMockReceipts(ctrl)
+
+		mockGr.EXPECT().Receipts().Return(mockReceipts).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/receipts POST - create", func() {
+		newReceipt := types.NewReceipt{
+			PurchaseOrderID: 7,
+			Lines:           []types.NewReceiptLine{{PurchaseOrderLineID: 3, QtyReceived: 10, QtyDamaged: 1}},
+		}
+		body := []byte(`{"purchaseOrderId":7,"lines":[{"purchaseOrderLineId":3,"qtyReceived":10,"qtyDamaged":1}]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			receipts.Create(w, httptest.NewRequest("POST", "/v1/receipts", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/receipts", nil))
+			w := httptest.NewRecorder()
+			receipts.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/receipts", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockReceipts.EXPECT().Create(gomock.Any(), newReceipt).
+				Return(nil, types.NewBadRequestError("BOGUS:Receipts.create")).Times(1)
+
+			receipts.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create receipt"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown purchase order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/receipts", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockReceipts.EXPECT().Create(gomock.Any(), newReceipt).
+				Return(nil, types.NewNotFoundError("purchase order not found by id")).Times(1)
+
+			receipts.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a receipt", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/receipts", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockReceipts.EXPECT().Create(gomock.Any(), newReceipt).
+				Return(&types.Receipt{ID: 1, PurchaseOrderID: 7}, nil).Times(1)
+
+			receipts.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"purchaseOrderId":7`))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package receipts_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/receipts"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/receipts", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockReceipts *mock_repos.MockReceipts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReceipts = mock_repos.NewMockReceipts(ctrl)
+
+		mockGr.EXPECT().Receipts().Return(mockReceipts).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/receipts GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/receipts?limit=5&offset=10&id=1&purchase_order_id=7", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockReceipts.EXPECT().Find(gomock.Any(), &repos.ReceiptsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, PurchaseOrderIDs: []int64{7},
+			}).Return([]*types.Receipt{{ID: 1, PurchaseOrderID: 7}}, int64(1), nil).Times(1)
+
+			receipts.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
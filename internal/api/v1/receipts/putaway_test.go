@@ -0,0 +1,90 @@
+package receipts_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/receipts"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/receipts/{id}/putaway", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockPutaway *mock_repos.MockPutaway
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockPutaway = mock_repos.NewMockPutaway(ctrl)
+
+		mockGr.EXPECT().Putaway().Return(mockPutaway).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(id string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("GET", "/v1/receipts/"+id+"/putaway", nil), map[string]string{"id": id},
+		))
+	}
+
+	Context("/v1/receipts/{id}/putaway GET - putaway", func() {
+		It("should return an error with a bad id", func() {
+			w := httptest.NewRecorder()
+			receipts.Putaway(w, newReq("abc"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown receipt", func() {
+			mockPutaway.EXPECT().Suggest(gomock.Any(), int64(1)).Return(nil, types.NewNotFoundError("receipt not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			receipts.Putaway(w, newReq("1"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			mockPutaway.EXPECT().Suggest(gomock.Any(), int64(1)).Return(nil, errors.New("BOGUS")).Times(1)
+
+			w := httptest.NewRecorder()
+			receipts.Putaway(w, newReq("1"))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the suggestions", func() {
+			mockPutaway.EXPECT().Suggest(gomock.Any(), int64(1)).Return([]*types.PutawaySuggestion{
+				{ReceiptLineID: 1, ProductID: 2, Qty: 10, LocationID: utils.Ref[int64](8), LocationCode: utils.Ref("A-01-03"), Reason: "consolidate"},
+			}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			receipts.Putaway(w, newReq("1"))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"locationCode":"A-01-03"`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package reports
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// ABC - every product ranked A, B or C by the value of what was used over a window ending now, or
+// its qty with by=velocity. The window is 365 days unless it's passed as days like 90d or a
+// duration, a and b are the shares of everything used A and B products make up, 0.8 and 0.95 by
+// default. format=csv downloads it as CSV.
+func ABC(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	asCSV, err := wantsCSV(r)
+	if err != nil {
+		logger.Debug("invalid format", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "invalid format id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	window := 365 * 24 * time.Hour
+	if raw := r.URL.Query().Get("window"); raw != "" {
+		if window, err = parseWithin(raw); err != nil {
+			logger.Debug("invalid window", log15.Ctx{"err": err, "window": raw, "requestId": requestID})
+			http.Error(w, "invalid window id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	opts := &repos.ABCFind{Since: time.Now().Add(-window), By: types.ABCByValue, A: 0.8, B: 0.95}
+	if raw := r.URL.Query().Get("by"); raw != "" {
+		switch by := types.ABCBy(raw); by {
+		case types.ABCByValue, types.ABCByVelocity:
+			opts.By = by
+		default:
+			logger.Debug("invalid by", log15.Ctx{"by": raw, "requestId": requestID})
+			http.Error(w, "invalid by id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	for name, share := range map[string]*float64{"a": &opts.A, "b": &opts.B} {
+		if raw := r.URL.Query().Get(name); raw != "" {
+			if *share, err = strconv.ParseFloat(raw, 64); err != nil || *share <= 0 || *share > 1 {
+				logger.Debug("invalid share", log15.Ctx{"err": err, name: raw, "requestId": requestID})
+				http.Error(w, "invalid "+name+" id: "+requestID, http.StatusBadRequest)
+				return
+			}
+		}
+	}
+	if opts.A > opts.B {
+		logger.Debug("a is over b", log15.Ctx{"a": opts.A, "b": opts.B, "requestId": requestID})
+		http.Error(w, "a can not be over b id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	res, err := gr.Reports().ABC(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to run abc report", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to run abc report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	if asCSV {
+		rows := make([][]string, len(res))
+		for i, obj := range res {
+			rows[i] = []string{
+				strconv.FormatInt(obj.ProductID, 10), obj.Sku, obj.Name, stringOrEmpty(obj.Category),
+				strconv.FormatInt(obj.UsedQty, 10), strconv.FormatInt(obj.UsedValue, 10),
+				strconv.FormatFloat(obj.Share, 'f', -1, 64), strconv.FormatFloat(obj.CumulativeShare, 'f', -1, 64),
+				string(obj.Class),
+			}
+		}
+
+		header := []string{"product_id", "sku", "name", "category", "used_qty", "used_value", "share", "cumulative_share", "class"}
+		if err := writeCSV(w, "abc", header, rows); err != nil {
+			logger.Debug("unable to write csv", log15.Ctx{"err": err, "requestId": requestID})
+		}
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: int64(len(res)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal abc report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
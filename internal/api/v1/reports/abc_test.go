@@ -0,0 +1,120 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/abc", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/abc GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.ABC(w, httptest.NewRequest("GET", "/v1/reports/abc", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject bad params", func() {
+			for _, query := range []string{"window=soon", "window=-1d", "by=price", "a=0", "b=1.5", "a=x", "a=0.9&b=0.8", "format=xml"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/abc?"+query, nil))
+				w := httptest.NewRecorder()
+				reports.ABC(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/abc", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().ABC(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.ABC(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should default to a year by value", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/abc", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().ABC(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ABCFind) ([]*types.ABCLine, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-365*24*time.Hour), time.Minute))
+				Expect(opts.By).To(Equal(types.ABCByValue))
+				Expect(opts.A).To(Equal(0.8))
+				Expect(opts.B).To(Equal(0.95))
+				return []*types.ABCLine{{ProductID: 1, Sku: "widget", UsedValue: 900, Share: 0.9, CumulativeShare: 0.9, Class: types.ABCClassA}}, nil
+			}).Times(1)
+
+			reports.ABC(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"class":"A"`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+
+		It("should rank by velocity with its own shares as CSV", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/abc?window=90d&by=velocity&a=0.7&b=0.9&format=csv", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().ABC(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ABCFind) ([]*types.ABCLine, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-90*24*time.Hour), time.Minute))
+				Expect(opts.By).To(Equal(types.ABCByVelocity))
+				Expect(opts.A).To(Equal(0.7))
+				Expect(opts.B).To(Equal(0.9))
+				return []*types.ABCLine{
+					{ProductID: 1, Sku: "widget", Name: "Widget, large", UsedQty: 75, Share: 0.75, CumulativeShare: 0.75, Class: types.ABCClassA},
+					{ProductID: 2, Sku: "bolt", Name: "Bolt", UsedQty: 25, Share: 0.25, CumulativeShare: 1, Class: types.ABCClassC},
+				}, nil
+			}).Times(1)
+
+			reports.ABC(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("text/csv"))
+			Expect(resp.Header.Get("Content-Disposition")).To(ContainSubstring(`filename="abc.csv"`))
+			Expect(string(resBts)).To(Equal("product_id,sku,name,category,used_qty,used_value,share,cumulative_share,class\n" +
+				"1,widget,\"Widget, large\",,75,0,0.75,0.75,A\n" +
+				"2,bolt,Bolt,,25,0,0.25,1,C\n"))
+		})
+	})
+})
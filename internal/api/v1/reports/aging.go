@@ -0,0 +1,105 @@
+package reports
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/inconshreveable/log15"
+)
+
+// Aging - stock on hand by product and location bucketed into 0-30, 31-90, 91-180 and over 180
+// days since it was received. Pass product_id or location_id to narrow it down, format=csv
+// downloads it as CSV.
+func Aging(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	asCSV, err := wantsCSV(r)
+	if err != nil {
+		logger.Debug("invalid format", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "invalid format id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	opts := new(repos.AgingFind)
+	for _, idRaw := range r.URL.Query()["product_id"] {
+		id, err := strconv.ParseInt(idRaw, 10, 64)
+		if err != nil {
+			logger.Debug("invalid product id", log15.Ctx{"err": err, "requestId": requestID})
+			http.Error(w, "invalid product_id id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		opts.ProductIDs = append(opts.ProductIDs, id)
+	}
+
+	for _, idRaw := range r.URL.Query()["location_id"] {
+		id, err := strconv.ParseInt(idRaw, 10, 64)
+		if err != nil {
+			logger.Debug("invalid location id", log15.Ctx{"err": err, "requestId": requestID})
+			http.Error(w, "invalid location_id id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		opts.LocationIDs = append(opts.LocationIDs, id)
+	}
+
+	res, err := gr.Reports().Aging(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to run aging report", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to run aging report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	if asCSV {
+		rows := make([][]string, len(res))
+		for i, obj := range res {
+			locationID, oldestReceivedAt := "", ""
+			if obj.LocationID != nil {
+				locationID = strconv.FormatInt(*obj.LocationID, 10)
+			}
+			if obj.OldestReceivedAt != nil {
+				oldestReceivedAt = obj.OldestReceivedAt.Format(time.RFC3339)
+			}
+
+			rows[i] = []string{
+				strconv.FormatInt(obj.ProductID, 10), obj.Sku, obj.Name, locationID, stringOrEmpty(obj.LocationCode),
+				strconv.FormatInt(obj.Qty, 10), strconv.FormatInt(obj.UpTo30, 10), strconv.FormatInt(obj.UpTo90, 10),
+				strconv.FormatInt(obj.UpTo180, 10), strconv.FormatInt(obj.Over180, 10), oldestReceivedAt,
+			}
+		}
+
+		header := []string{
+			"product_id", "sku", "name", "location_id", "location_code", "qty",
+			"0_30_days", "31_90_days", "91_180_days", "over_180_days", "oldest_received_at",
+		}
+		if err := writeCSV(w, "aging", header, rows); err != nil {
+			logger.Debug("unable to write csv", log15.Ctx{"err": err, "requestId": requestID})
+		}
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: int64(len(res)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal aging report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,117 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/aging", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/aging GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.Aging(w, httptest.NewRequest("GET", "/v1/reports/aging", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject bad params", func() {
+			for _, query := range []string{"product_id=x", "location_id=x", "format=xml"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/aging?"+query, nil))
+				w := httptest.NewRecorder()
+				reports.Aging(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/aging", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Aging(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.Aging(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the buckets by product and location", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/aging?product_id=1&location_id=2", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Aging(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.AgingFind) ([]*types.AgingLine, error) {
+				Expect(opts.ProductIDs).To(Equal([]int64{1}))
+				Expect(opts.LocationIDs).To(Equal([]int64{2}))
+				return []*types.AgingLine{{ProductID: 1, LocationID: utils.Ref(int64(2)), Qty: 10, UpTo30: 4, Over180: 6}}, nil
+			}).Times(1)
+
+			reports.Aging(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"upTo30":4`))
+			Expect(string(resBts)).To(ContainSubstring(`"over180":6`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+
+		It("should download as CSV", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/aging?format=csv", nil))
+			w := httptest.NewRecorder()
+
+			receivedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+			mockReports.EXPECT().Aging(gomock.Any(), gomock.Any()).Return([]*types.AgingLine{
+				{ProductID: 1, Sku: "widget", Name: "Widget", Qty: 3, UpTo30: 3},
+				{
+					ProductID: 1, Sku: "widget", Name: "Widget", LocationID: utils.Ref(int64(2)), LocationCode: utils.Ref("MAIN"),
+					Qty: 10, UpTo30: 4, UpTo90: 1, UpTo180: 2, Over180: 3, OldestReceivedAt: &receivedAt,
+				},
+			}, nil).Times(1)
+
+			reports.Aging(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Disposition")).To(ContainSubstring(`filename="aging.csv"`))
+			Expect(string(resBts)).To(Equal("product_id,sku,name,location_id,location_code,qty,0_30_days,31_90_days,91_180_days,over_180_days,oldest_received_at\n" +
+				"1,widget,Widget,,,3,3,0,0,0,\n" +
+				"1,widget,Widget,2,MAIN,10,4,1,2,3,2026-03-01T09:00:00Z\n"))
+		})
+	})
+})
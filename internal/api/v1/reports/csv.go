@@ -0,0 +1,79 @@
+package reports
+
+import (
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+)
+
+// wantsCSV - reports are JSON unless format=csv is passed
+func wantsCSV(r *http.Request) (bool, error) {
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		return false, nil
+	case "csv":
+		return true, nil
+	default:
+		return false, errors.New("format must be json or csv")
+	}
+}
+
+// writeCSV - sends the rows under a header as a CSV download named after the report
+func writeCSV(w http.ResponseWriter, name string, header []string, rows [][]string) error {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+`.csv"`)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// usageCSV - the header and rows for the reports on product usage
+func usageCSV(objs []*types.ProductUsage) ([]string, [][]string) {
+	header := []string{
+		"product_id", "sku", "name", "category", "qty", "value", "opening_qty", "opening_value",
+		"used_qty", "used_value", "last_used_at", "turnover", "days_of_supply",
+	}
+
+	rows := make([][]string, len(objs))
+	for i, obj := range objs {
+		lastUsedAt := ""
+		if obj.LastUsedAt != nil {
+			lastUsedAt = obj.LastUsedAt.Format(time.RFC3339)
+		}
+
+		rows[i] = []string{
+			strconv.FormatInt(obj.ProductID, 10), obj.Sku, obj.Name, stringOrEmpty(obj.Category),
+			strconv.FormatInt(obj.Qty, 10), strconv.FormatInt(obj.Value, 10),
+			strconv.FormatInt(obj.OpeningQty, 10), strconv.FormatInt(obj.OpeningValue, 10),
+			strconv.FormatInt(obj.UsedQty, 10), strconv.FormatInt(obj.UsedValue, 10),
+			lastUsedAt, floatOrEmpty(obj.Turnover), floatOrEmpty(obj.DaysOfSupply),
+		}
+	}
+
+	return header, rows
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func floatOrEmpty(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
@@ -0,0 +1,108 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/dead-stock", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/dead-stock GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.DeadStock(w, httptest.NewRequest("GET", "/v1/reports/dead-stock", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad window or format", func() {
+			for _, query := range []string{"window=soon", "format=pdf"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/dead-stock?"+query, nil))
+				w := httptest.NewRecorder()
+				reports.DeadStock(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/dead-stock", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().DeadStock(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.DeadStock(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should default to 180 days", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/dead-stock", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().DeadStock(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-180*24*time.Hour), time.Minute))
+				return []*types.ProductUsage{{ProductID: 1, Sku: "widget", Qty: 10, Value: 1000}}, nil
+			}).Times(1)
+
+			reports.DeadStock(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"lastUsedAt":null`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+
+		It("should download a window as CSV", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/dead-stock?window=365d&format=csv", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().DeadStock(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-365*24*time.Hour), time.Minute))
+				return []*types.ProductUsage{}, nil
+			}).Times(1)
+
+			reports.DeadStock(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Disposition")).To(ContainSubstring(`filename="dead-stock.csv"`))
+			Expect(string(resBts)).To(HavePrefix("product_id,sku,name"))
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package reports
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/reports")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("/low-stock", LowStock).Methods(http.MethodGet)
+	subrouter.HandleFunc("/expiring", Expiring).Methods(http.MethodGet)
+	subrouter.HandleFunc("/valuation", Valuation).Methods(http.MethodGet)
+	subrouter.HandleFunc("/abc", ABC).Methods(http.MethodGet)
+	subrouter.HandleFunc("/turnover", Turnover).Methods(http.MethodGet)
+	subrouter.HandleFunc("/dead-stock", DeadStock).Methods(http.MethodGet)
+	subrouter.HandleFunc("/slow-moving", SlowMoving).Methods(http.MethodGet)
+	subrouter.HandleFunc("/aging", Aging).Methods(http.MethodGet)
+}
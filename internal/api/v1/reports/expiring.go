@@ -0,0 +1,94 @@
+package reports
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/inconshreveable/log15"
+)
+
+// Expiring - lots with stock on hand expiring within a window, 30 days unless within is
+// passed as days like 30d or a duration like 72h. Lots that have already expired are included.
+func Expiring(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	within := 30 * 24 * time.Hour
+	if raw := r.URL.Query().Get("within"); raw != "" {
+		var err error
+		within, err = parseWithin(raw)
+		if err != nil {
+			logger.Debug("invalid within", log15.Ctx{"err": err, "within": raw, "requestId": requestID})
+			http.Error(w, "invalid within id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	opts := &repos.ExpiringFind{Before: time.Now().Add(within)}
+	for _, idRaw := range r.URL.Query()["product_id"] {
+		id, err := strconv.ParseInt(idRaw, 10, 64)
+		if err != nil {
+			logger.Debug("invalid product id", log15.Ctx{"err": err, "requestId": requestID})
+			http.Error(w, "invalid product_id id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		opts.ProductIDs = append(opts.ProductIDs, id)
+	}
+
+	res, err := gr.Reports().Expiring(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to run expiring report", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to run expiring report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: int64(len(res)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal expiring report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
+
+// parseWithin - time.ParseDuration doesn't know about days so they're handled here
+func parseWithin(raw string) (time.Duration, error) {
+	var within time.Duration
+	if days, ok := strings.CutSuffix(raw, "d"); ok {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		within = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if within, err = time.ParseDuration(raw); err != nil {
+			return 0, err
+		}
+	}
+
+	if within < 0 {
+		return 0, errors.New("within can not be negative")
+	}
+	return within, nil
+}
@@ -0,0 +1,107 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/expiring", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/expiring GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.Expiring(w, httptest.NewRequest("GET", "/v1/reports/expiring", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad within", func() {
+			for _, within := range []string{"abc", "xd", "-3d"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/expiring?within="+within, nil))
+				w := httptest.NewRecorder()
+				reports.Expiring(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), within)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/expiring", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Expiring(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.Expiring(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should default to 30 days", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/expiring", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Expiring(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ExpiringFind) ([]*types.ExpiringLot, error) {
+				Expect(opts.Before).To(BeTemporally("~", time.Now().Add(30*24*time.Hour), time.Minute))
+				return []*types.ExpiringLot{}, nil
+			}).Times(1)
+
+			reports.Expiring(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should return the expiring lots", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/expiring?within=7d&product_id=4", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Expiring(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ExpiringFind) ([]*types.ExpiringLot, error) {
+				Expect(opts.Before).To(BeTemporally("~", time.Now().Add(7*24*time.Hour), time.Minute))
+				Expect(opts.ProductIDs).To(Equal([]int64{4}))
+				return []*types.ExpiringLot{
+					{LotID: 1, LotNumber: "L-1", ProductID: 4, Sku: "sku-4", Qty: 12, DaysLeft: 5},
+				}, nil
+			}).Times(1)
+
+			reports.Expiring(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"daysLeft":5`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
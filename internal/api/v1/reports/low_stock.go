@@ -0,0 +1,58 @@
+package reports
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/inconshreveable/log15"
+)
+
+// LowStock - everything at or below its reorder point, pass location_id to only
+// see specific locations
+func LowStock(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	opts := new(repos.LowStockFind)
+	for _, idRaw := range r.URL.Query()["location_id"] {
+		id, err := strconv.ParseInt(idRaw, 10, 64)
+		if err != nil {
+			logger.Debug("invalid location id", log15.Ctx{"err": err, "requestId": requestID})
+			http.Error(w, "invalid location_id id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		opts.LocationIDs = append(opts.LocationIDs, id)
+	}
+
+	res, err := gr.Reports().LowStock(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to run low stock report", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to run low stock report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: int64(len(res)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal low stock report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
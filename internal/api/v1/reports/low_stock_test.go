@@ -0,0 +1,86 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/low-stock GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.LowStock(w, httptest.NewRequest("GET", "/v1/reports/low-stock", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad location id", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/low-stock?location_id=abc", nil))
+			w := httptest.NewRecorder()
+			reports.LowStock(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/low-stock", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().LowStock(gomock.Any(), &repos.LowStockFind{}).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.LowStock(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the low stock items", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/low-stock?location_id=3", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().LowStock(gomock.Any(), &repos.LowStockFind{LocationIDs: []int64{3}}).Return([]*types.LowStockItem{
+				{ProductID: 1, Sku: "sku-1", Qty: 2, ReorderPoint: 5, ReorderQty: 20, Shortfall: 3},
+			}, nil).Times(1)
+
+			reports.LowStock(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"shortfall":3`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
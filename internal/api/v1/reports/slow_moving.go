@@ -0,0 +1,82 @@
+package reports
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/inconshreveable/log15"
+)
+
+// SlowMoving - products used over a window ending now, 90 days unless window is passed as days
+// like 30d or a duration, with more days of supply on hand than days_of_supply (180 by default).
+// format=csv downloads it as CSV.
+func SlowMoving(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	asCSV, err := wantsCSV(r)
+	if err != nil {
+		logger.Debug("invalid format", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "invalid format id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	window := 90 * 24 * time.Hour
+	if raw := r.URL.Query().Get("window"); raw != "" {
+		if window, err = parseWithin(raw); err != nil {
+			logger.Debug("invalid window", log15.Ctx{"err": err, "window": raw, "requestId": requestID})
+			http.Error(w, "invalid window id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	opts := &repos.SlowMovingFind{Since: time.Now().Add(-window), DaysOfSupply: 180}
+	if raw := r.URL.Query().Get("days_of_supply"); raw != "" {
+		if opts.DaysOfSupply, err = strconv.ParseFloat(raw, 64); err != nil || opts.DaysOfSupply <= 0 {
+			logger.Debug("invalid days of supply", log15.Ctx{"err": err, "daysOfSupply": raw, "requestId": requestID})
+			http.Error(w, "invalid days_of_supply id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	res, err := gr.Reports().SlowMoving(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to run slow moving report", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to run slow moving report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	if asCSV {
+		header, rows := usageCSV(res)
+		if err := writeCSV(w, "slow-moving", header, rows); err != nil {
+			logger.Debug("unable to write csv", log15.Ctx{"err": err, "requestId": requestID})
+		}
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: res, Count: int64(len(res)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal slow moving report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
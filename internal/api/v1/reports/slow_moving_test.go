@@ -0,0 +1,108 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/slow-moving", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/slow-moving GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.SlowMoving(w, httptest.NewRequest("GET", "/v1/reports/slow-moving", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject bad params", func() {
+			for _, query := range []string{"window=soon", "days_of_supply=lots", "days_of_supply=0", "format=xml"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/slow-moving?"+query, nil))
+				w := httptest.NewRecorder()
+				reports.SlowMoving(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/slow-moving", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().SlowMoving(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.SlowMoving(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should default to 90 days and 180 days of supply", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/slow-moving", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().SlowMoving(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.SlowMovingFind) ([]*types.ProductUsage, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-90*24*time.Hour), time.Minute))
+				Expect(opts.DaysOfSupply).To(Equal(180.0))
+				return []*types.ProductUsage{{ProductID: 1, Sku: "widget", DaysOfSupply: utils.Ref(900.0)}}, nil
+			}).Times(1)
+
+			reports.SlowMoving(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"daysOfSupply":900`))
+		})
+
+		It("should take a window and days of supply", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/slow-moving?window=30d&days_of_supply=60&format=csv", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().SlowMoving(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.SlowMovingFind) ([]*types.ProductUsage, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+				Expect(opts.DaysOfSupply).To(Equal(60.0))
+				return []*types.ProductUsage{}, nil
+			}).Times(1)
+
+			reports.SlowMoving(w, req)
+
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Disposition")).To(ContainSubstring(`filename="slow-moving.csv"`))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/turnover", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/turnover GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.Turnover(w, httptest.NewRequest("GET", "/v1/reports/turnover", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad window or format", func() {
+			for _, query := range []string{"window=soon", "format=xml"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/turnover?"+query, nil))
+				w := httptest.NewRecorder()
+				reports.Turnover(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/turnover", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Turnover(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.Turnover(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should default to a year", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/turnover", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Turnover(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-365*24*time.Hour), time.Minute))
+				return []*types.ProductUsage{{ProductID: 1, Sku: "widget", Turnover: utils.Ref(4.5)}}, nil
+			}).Times(1)
+
+			reports.Turnover(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"turnover":4.5`))
+			Expect(string(resBts)).To(ContainSubstring(`"daysOfSupply":null`))
+		})
+
+		It("should download a window as CSV", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/turnover?window=30d&format=csv", nil))
+			w := httptest.NewRecorder()
+
+			usedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+			mockReports.EXPECT().Turnover(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+				Expect(opts.Since).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+				return []*types.ProductUsage{{
+					ProductID: 1, Sku: "widget", Name: "Widget", Category: utils.Ref("widgets"), Qty: 10, Value: 1000,
+					OpeningQty: 20, OpeningValue: 2000, UsedQty: 10, UsedValue: 1000, LastUsedAt: &usedAt,
+					Turnover: utils.Ref(0.67), DaysOfSupply: utils.Ref(30.0),
+				}}, nil
+			}).Times(1)
+
+			reports.Turnover(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("text/csv"))
+			Expect(string(resBts)).To(Equal("product_id,sku,name,category,qty,value,opening_qty,opening_value,used_qty,used_value,last_used_at,turnover,days_of_supply\n" +
+				"1,widget,Widget,widgets,10,1000,20,2000,10,1000,2026-10-01T12:00:00Z,0.67,30\n"))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package reports
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Valuation - what stock on hand was worth as of a point in time, now unless as_of is passed
+// as a date like 2026-09-30 (the end of that day) or an RFC 3339 time. It's grouped by product
+// unless group_by is category or location.
+func Valuation(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	opts := &repos.ValuationFind{AsOf: time.Now(), GroupBy: types.ValuationGroupByProduct}
+	if raw := r.URL.Query().Get("as_of"); raw != "" {
+		var err error
+		opts.AsOf, err = utils.ParseAsOf(raw)
+		if err != nil {
+			logger.Debug("invalid as of", log15.Ctx{"err": err, "asOf": raw, "requestId": requestID})
+			http.Error(w, "invalid as_of id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if raw := r.URL.Query().Get("group_by"); raw != "" {
+		switch groupBy := types.ValuationGroupBy(raw); groupBy {
+		case types.ValuationGroupByProduct, types.ValuationGroupByCategory, types.ValuationGroupByLocation:
+			opts.GroupBy = groupBy
+		default:
+			logger.Debug("invalid group by", log15.Ctx{"groupBy": raw, "requestId": requestID})
+			http.Error(w, "invalid group_by id: "+requestID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	res, err := gr.Reports().Valuation(r.Context(), opts)
+	if err != nil {
+		logger.Debug("unable to run valuation report", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to run valuation report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	var total int64
+	for _, line := range res {
+		total += line.Value
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+		Total int64       `json:"total"`
+	}{
+		Data: res, Count: int64(len(res)), Total: total,
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal valuation report id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
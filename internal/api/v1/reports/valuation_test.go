@@ -0,0 +1,126 @@
+package reports_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/reports/valuation", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReports *mock_repos.MockReports
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReports = mock_repos.NewMockReports(ctrl)
+
+		mockGr.EXPECT().Reports().Return(mockReports).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/reports/valuation GET", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			reports.Valuation(w, httptest.NewRequest("GET", "/v1/reports/valuation", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject a bad as_of or group_by", func() {
+			for _, query := range []string{"as_of=yesterday", "as_of=2026-13-01", "group_by=supplier"} {
+				req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/valuation?"+query, nil))
+				w := httptest.NewRecorder()
+				reports.Valuation(w, req)
+
+				Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/valuation", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Valuation(gomock.Any(), gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			reports.Valuation(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should default to now by product", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/valuation", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Valuation(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ValuationFind) ([]*types.ValuationLine, error) {
+				Expect(opts.AsOf).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(opts.GroupBy).To(Equal(types.ValuationGroupByProduct))
+				return []*types.ValuationLine{}, nil
+			}).Times(1)
+
+			reports.Valuation(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should value stock as of the end of the day by category", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/valuation?as_of=2026-09-30&group_by=category", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Valuation(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ValuationFind) ([]*types.ValuationLine, error) {
+				Expect(opts.AsOf).To(Equal(time.Date(2026, 9, 30, 23, 59, 59, 999999999, time.UTC)))
+				Expect(opts.GroupBy).To(Equal(types.ValuationGroupByCategory))
+				return []*types.ValuationLine{
+					{Category: utils.Ref("widgets"), Qty: 10, Value: 2500},
+					{Qty: 4, Value: 400},
+				}, nil
+			}).Times(1)
+
+			reports.Valuation(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"category":"widgets"`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":2`))
+			Expect(string(resBts)).To(ContainSubstring(`"total":2900`))
+		})
+
+		It("should take an RFC 3339 as_of", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/reports/valuation?as_of=2026-09-30T12:00:00Z&group_by=location", nil))
+			w := httptest.NewRecorder()
+
+			mockReports.EXPECT().Valuation(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, opts *repos.ValuationFind) ([]*types.ValuationLine, error) {
+				Expect(opts.AsOf).To(Equal(time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC)))
+				Expect(opts.GroupBy).To(Equal(types.ValuationGroupByLocation))
+				return []*types.ValuationLine{}, nil
+			}).Times(1)
+
+			reports.Valuation(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
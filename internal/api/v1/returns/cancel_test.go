@@ -0,0 +1,74 @@
+package returns_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/returns"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/returns", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReturns *mock_repos.MockReturns
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReturns = mock_repos.NewMockReturns(ctrl)
+
+		mockGr.EXPECT().Returns().Return(mockReturns).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/returns/{id}/cancel POST - cancel", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/returns/1/cancel", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a conflict once goods have been received", func() {
+			mockReturns.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewBadRequestError("return can not go from received to cancelled")).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should return not found", func() {
+			mockReturns.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewNotFoundError("return not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the return", func() {
+			mockReturns.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(&types.Return{ID: 1, Status: types.ReturnStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
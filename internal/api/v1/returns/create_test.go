@@ -0,0 +1,105 @@
+package returns_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/returns"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/returns", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReturns *mock_repos.MockReturns
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReturns = mock_repos.NewMockReturns(ctrl)
+
+		mockGr.EXPECT().Returns().Return(mockReturns).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/returns POST - create", func() {
+		body := []byte(`{"salesOrderId":2,"lines":[{"salesOrderLineId":3,"qty":1}]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			returns.Create(w, httptest.NewRequest("POST", "/v1/returns", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/returns", nil))
+			w := httptest.NewRecorder()
+			returns.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/returns", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockReturns.EXPECT().Create(gomock.Any(), types.NewReturn{SalesOrderID: 2, Lines: []types.NewReturnLine{{SalesOrderLineID: 3, Qty: 1}}}).
+				Return(nil, types.NewBadRequestError("BOGUS:Returns.create")).Times(1)
+
+			returns.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create return"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown sales order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/returns", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockReturns.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("sales order not found by id")).Times(1)
+
+			returns.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a return", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/returns", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockReturns.EXPECT().Create(gomock.Any(), types.NewReturn{SalesOrderID: 2, Lines: []types.NewReturnLine{{SalesOrderLineID: 3, Qty: 1}}}).
+				Return(&types.Return{ID: 1, SalesOrderID: 2, Status: types.ReturnStatusAuthorized}, nil).Times(1)
+
+			returns.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"authorized"`))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package returns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Receive - records returned goods as they arrive with a restock, quarantine or scrap disposition per line
+func Receive(w http.ResponseWriter, r *http.Request) {
+	dispose(w, r, "receive", func(returns repos.Returns) func(context.Context, *types.DisposeReturn) (*types.Return, error) {
+		return returns.Receive
+	})
+}
+
+// Release - restocks or scraps units that were quarantined on receipt
+func Release(w http.ResponseWriter, r *http.Request) {
+	dispose(w, r, "release", func(returns repos.Returns) func(context.Context, *types.DisposeReturn) (*types.Return, error) {
+		return returns.Release
+	})
+}
+
+func dispose(
+	w http.ResponseWriter, r *http.Request, action string,
+	method func(repos.Returns) func(context.Context, *types.DisposeReturn) (*types.Return, error),
+) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// Get the dispositions from the body of the request
+	body := new(types.DisposeReturn)
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		logger.Debug("unable to read body", log15.Ctx{"err": err, "requestId": requestID})
+		http.Error(w, "unable to read body id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// ensure the id is what was used in the URL
+	body.ID = id
+
+	rt, err := method(gr.Returns())(r.Context(), body)
+	if err != nil {
+		logger.Debug("unable to "+action+" return", log15.Ctx{"err": err, "id": id, "requestId": requestID, "req": body})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to "+action+" return id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to "+action+" return id: "+requestID, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "unable to "+action+" return id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(rt)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal return id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,100 @@
+package returns_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/returns"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/returns", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReturns *mock_repos.MockReturns
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReturns = mock_repos.NewMockReturns(ctrl)
+
+		mockGr.EXPECT().Returns().Return(mockReturns).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(action, body string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("POST", "/v1/returns/1/"+action, bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+		))
+	}
+
+	Context("/v1/returns/{id}/receive POST - receive", func() {
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			returns.Receive(w, newReq("receive", `{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when more comes back than was authorized", func() {
+			mockReturns.EXPECT().Receive(gomock.Any(), &types.DisposeReturn{
+				ID: 1, Lines: []types.DisposeReturnLine{{ReturnLineID: 2, Qty: 9, Disposition: types.ReturnDispositionScrap}},
+			}).Return(nil, types.NewBadRequestError("can not receive more than was authorized")).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Receive(w, newReq("receive", `{"id":5,"lines":[{"returnLineId":2,"qty":9,"disposition":"scrap"}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should restock to a location", func() {
+			mockReturns.EXPECT().Receive(gomock.Any(), &types.DisposeReturn{
+				ID: 1, LocationID: utils.Ref[int64](3),
+				Lines: []types.DisposeReturnLine{{ReturnLineID: 2, Qty: 1, Disposition: types.ReturnDispositionRestock}},
+			}).Return(&types.Return{ID: 1, Status: types.ReturnStatusReceived}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Receive(w, newReq("receive", `{"locationId":3,"lines":[{"returnLineId":2,"qty":1,"disposition":"restock"}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"received"`))
+		})
+	})
+
+	Context("/v1/returns/{id}/release POST - release", func() {
+		It("should return not found", func() {
+			mockReturns.EXPECT().Release(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("return not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Release(w, newReq("release", `{"lines":[{"returnLineId":2,"qty":1,"disposition":"scrap"}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should release quarantined units", func() {
+			mockReturns.EXPECT().Release(gomock.Any(), &types.DisposeReturn{
+				ID: 1, Lines: []types.DisposeReturnLine{{ReturnLineID: 2, Qty: 1, Disposition: types.ReturnDispositionScrap}},
+			}).Return(&types.Return{ID: 1, Status: types.ReturnStatusReceived}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			returns.Release(w, newReq("release", `{"lines":[{"returnLineId":2,"qty":1,"disposition":"scrap"}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
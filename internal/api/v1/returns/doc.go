@@ -0,0 +1,19 @@
+package returns
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("/v1/returns")
+
+func SetRoutes(subrouter *mux.Router) {
+	subrouter.HandleFunc("", Find).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}", Get).Methods(http.MethodGet)
+	subrouter.HandleFunc("/{id:[0-9]+}/receive", Receive).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/release", Release).Methods(http.MethodPost)
+	subrouter.HandleFunc("/{id:[0-9]+}/cancel", Cancel).Methods(http.MethodPost)
+	subrouter.HandleFunc("", Create).Methods(http.MethodPost)
+}
@@ -0,0 +1,58 @@
+package returns_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/returns"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/returns", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockReturns *mock_repos.MockReturns
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockReturns = mock_repos.NewMockReturns(ctrl)
+
+		mockGr.EXPECT().Returns().Return(mockReturns).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/returns GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/returns?limit=5&offset=10&id=1&sales_order_id=2&status=authorized&status=partially_received", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockReturns.EXPECT().Find(gomock.Any(), &repos.ReturnsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, SalesOrderIDs: []int64{2},
+				Statuses: []types.ReturnStatus{types.ReturnStatusAuthorized, types.ReturnStatusPartiallyReceived},
+			}).Return([]*types.Return{{ID: 1, SalesOrderID: 2, Status: types.ReturnStatusAuthorized}}, int64(1), nil).Times(1)
+
+			returns.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package returns_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestReturns(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Returns Suite")
+}
@@ -0,0 +1,62 @@
+package salesorders
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Allocate - hands available stock to waiting backorders. Receiving does this on its own, this
+// is for stock that arrived some other way. Pass product_id to only allocate specific products.
+func Allocate(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	productIDs := []int64{}
+	for _, idRaw := range r.URL.Query()["product_id"] {
+		id, err := strconv.ParseInt(idRaw, 10, 64)
+		if err != nil {
+			logger.Debug("invalid product id", log15.Ctx{"err": err, "requestId": requestID})
+			http.Error(w, "invalid product_id id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		productIDs = append(productIDs, id)
+	}
+
+	lines, err := gr.SalesOrders().Allocate(r.Context(), productIDs...)
+	if err != nil {
+		logger.Debug("unable to allocate backorders", log15.Ctx{"err": err, "requestId": requestID})
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to allocate backorders id: "+requestID, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "unable to allocate backorders id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(struct {
+		Data  interface{} `json:"data"`
+		Count int64       `json:"count"`
+	}{
+		Data: lines, Count: int64(len(lines)),
+	})
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal sales order lines id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
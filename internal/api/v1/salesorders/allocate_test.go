@@ -0,0 +1,77 @@
+package salesorders_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/sales-orders", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockSalesOrders *mock_repos.MockSalesOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/sales-orders/allocate POST - allocate", func() {
+		It("should return an error with a bad product id", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders/allocate?product_id=abc", nil))
+			w := httptest.NewRecorder()
+			salesorders.Allocate(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders/allocate", nil))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Allocate(gomock.Any()).Return(nil, errors.New("BOGUS")).Times(1)
+
+			salesorders.Allocate(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the lines that were allocated", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders/allocate?product_id=2&product_id=3", nil))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Allocate(gomock.Any(), int64(2), int64(3)).
+				Return([]*types.SalesOrderLine{{ID: 4, ProductID: 2, Qty: 5, QtyAllocated: 5, Backorder: true}}, nil).Times(1)
+
+			salesorders.Allocate(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+			Expect(string(resBts)).To(ContainSubstring(`"qtyAllocated":5`))
+		})
+	})
+})
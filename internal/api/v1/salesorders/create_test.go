@@ -0,0 +1,105 @@
+package salesorders_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/sales-orders", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockSalesOrders *mock_repos.MockSalesOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/sales-orders POST - create", func() {
+		body := []byte(`{"customerName":"Jane Doe","lines":[{"productId":2,"qty":10,"unitPrice":1999}]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			salesorders.Create(w, httptest.NewRequest("POST", "/v1/sales-orders", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders", nil))
+			w := httptest.NewRecorder()
+			salesorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Create(gomock.Any(), types.NewSalesOrder{CustomerName: "Jane Doe", Lines: []types.NewSalesOrderLine{{ProductID: 2, Qty: 10, UnitPrice: 1999}}}).
+				Return(nil, types.NewBadRequestError("BOGUS:SalesOrders.create")).Times(1)
+
+			salesorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create sales order"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown product", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("product not found by id")).Times(1)
+
+			salesorders.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a sales order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/sales-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Create(gomock.Any(), types.NewSalesOrder{CustomerName: "Jane Doe", Lines: []types.NewSalesOrderLine{{ProductID: 2, Qty: 10, UnitPrice: 1999}}}).
+				Return(&types.SalesOrder{ID: 1, CustomerName: "Jane Doe", Status: types.SalesOrderStatusDraft}, nil).Times(1)
+
+			salesorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"draft"`))
+		})
+	})
+})
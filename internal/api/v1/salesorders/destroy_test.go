@@ -0,0 +1,70 @@
+package salesorders_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/sales-orders", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockSalesOrders *mock_repos.MockSalesOrders
+		req             *http.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+
+		req = middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("DELETE", "/v1/sales-orders/1", nil), map[string]string{"id": "1"},
+		))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/sales-orders/{id} DELETE - destroy", func() {
+		It("should return not found", func() {
+			mockSalesOrders.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewNotFoundError("sales order not found")).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return a conflict when the sales order is not a draft", func() {
+			mockSalesOrders.EXPECT().Destroy(gomock.Any(), int64(1)).Return(types.NewBadRequestError("only draft sales orders can be deleted")).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should successfully destroy a sales order", func() {
+			mockSalesOrders.EXPECT().Destroy(gomock.Any(), int64(1)).Return(nil).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Destroy(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+})
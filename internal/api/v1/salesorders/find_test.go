@@ -0,0 +1,58 @@
+package salesorders_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/sales-orders", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockSalesOrders *mock_repos.MockSalesOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/sales-orders GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/sales-orders?limit=5&offset=10&id=1&customer_id=3&backordered=true&status=draft&status=confirmed", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Find(gomock.Any(), &repos.SalesOrdersFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, CustomerIDs: []int64{3}, Backordered: true,
+				Statuses: []types.SalesOrderStatus{types.SalesOrderStatusDraft, types.SalesOrderStatusConfirmed},
+			}).Return([]*types.SalesOrder{{ID: 1, CustomerName: "Jane Doe", Status: types.SalesOrderStatusConfirmed}}, int64(1), nil).Times(1)
+
+			salesorders.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package salesorders_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/sales-orders", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockSalesOrders *mock_repos.MockSalesOrders
+		mockTaxRates    *mock_repos.MockTaxRates
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+
+		mockTaxRates = mock_repos.NewMockTaxRates(ctrl)
+
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+		mockGr.EXPECT().TaxRates().Return(mockTaxRates).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/sales-orders/{id} GET - get", func() {
+		It("should return an error with a bad id", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/sales-orders/abc", nil), map[string]string{"id": "abc"},
+			))
+			w := httptest.NewRecorder()
+			salesorders.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/sales-orders/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, errors.New("BOGUS")).Times(1)
+
+			salesorders.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/sales-orders/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Get(gomock.Any(), int64(1)).Return(nil, false, nil).Times(1)
+
+			salesorders.Get(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should sanitize the err from the tax calculation", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/sales-orders/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.SalesOrder{ID: 1, Status: types.SalesOrderStatusConfirmed}, true, nil).Times(1)
+			mockTaxRates.EXPECT().Calculate(gomock.Any(), int64(1)).Return(nil, errors.New("BOGUS")).Times(1)
+
+			salesorders.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the sales order with its tax", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("GET", "/v1/sales-orders/1", nil), map[string]string{"id": "1"},
+			))
+			w := httptest.NewRecorder()
+
+			mockSalesOrders.EXPECT().Get(gomock.Any(), int64(1)).Return(&types.SalesOrder{ID: 1, Status: types.SalesOrderStatusConfirmed}, true, nil).Times(1)
+			mockTaxRates.EXPECT().Calculate(gomock.Any(), int64(1)).Return(&types.TaxBreakdown{Subtotal: 1000, Tax: 83, Total: 1083}, nil).Times(1)
+
+			salesorders.Get(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring("confirmed"))
+			Expect(string(resBts)).To(ContainSubstring(`"total":1083`))
+		})
+	})
+})
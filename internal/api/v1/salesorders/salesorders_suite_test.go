@@ -0,0 +1,13 @@
+package salesorders_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSalesOrders(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SalesOrders Suite")
+}
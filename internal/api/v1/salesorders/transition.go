@@ -0,0 +1,67 @@
+package salesorders
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/inconshreveable/log15"
+)
+
+// Confirm - allocates stock to a draft, anything that can't be filled is backordered
+func Confirm(w http.ResponseWriter, r *http.Request) {
+	transition(w, r, types.SalesOrderStatusConfirmed)
+}
+
+// Cancel - cancels a draft or confirmed sales order, its allocated stock is released
+func Cancel(w http.ResponseWriter, r *http.Request) {
+	transition(w, r, types.SalesOrderStatusCancelled)
+}
+
+func transition(w http.ResponseWriter, r *http.Request, to types.SalesOrderStatus) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Debug("unable to get id from url parameters", log15.Ctx{"err": err, "vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get id from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	order, err := gr.SalesOrders().Transition(r.Context(), id, to)
+	if err != nil {
+		logger.Debug("unable to transition sales order", log15.Ctx{"err": err, "id": id, "to": to, "requestId": requestID})
+		if types.IsNotFoundError(err) {
+			http.Error(w, "unable to "+string(to)+" sales order id: "+requestID, http.StatusNotFound)
+			return
+		}
+		// not allowed from the current status
+		if types.IsBadRequestError(err) {
+			http.Error(w, "unable to "+string(to)+" sales order id: "+requestID, http.StatusConflict)
+			return
+		}
+		http.Error(w, "unable to "+string(to)+" sales order id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(order)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal sales order id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
@@ -0,0 +1,86 @@
+package salesorders_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/sales-orders", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockGr          *mock_repos.MockGlobalRepo
+		mockSalesOrders *mock_repos.MockSalesOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSalesOrders = mock_repos.NewMockSalesOrders(ctrl)
+
+		mockGr.EXPECT().SalesOrders().Return(mockSalesOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newReq := func(action string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("POST", "/v1/sales-orders/1/"+action, nil), map[string]string{"id": "1"},
+		))
+	}
+
+	Context("/v1/sales-orders/{id}/confirm POST - confirm", func() {
+		It("should return a conflict when the order can't be confirmed", func() {
+			mockSalesOrders.EXPECT().Transition(gomock.Any(), int64(1), types.SalesOrderStatusConfirmed).
+				Return(nil, types.NewBadRequestError("sales order can not go from cancelled to confirmed")).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Confirm(w, newReq("confirm"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should confirm the order", func() {
+			mockSalesOrders.EXPECT().Transition(gomock.Any(), int64(1), types.SalesOrderStatusConfirmed).
+				Return(&types.SalesOrder{ID: 1, Status: types.SalesOrderStatusConfirmed}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Confirm(w, newReq("confirm"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("/v1/sales-orders/{id}/cancel POST - cancel", func() {
+		It("should return not found", func() {
+			mockSalesOrders.EXPECT().Transition(gomock.Any(), int64(1), types.SalesOrderStatusCancelled).
+				Return(nil, types.NewNotFoundError("sales order not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Cancel(w, newReq("cancel"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the order", func() {
+			mockSalesOrders.EXPECT().Transition(gomock.Any(), int64(1), types.SalesOrderStatusCancelled).
+				Return(&types.SalesOrder{ID: 1, Status: types.SalesOrderStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			salesorders.Cancel(w, newReq("cancel"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package serials_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/serials"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/serials", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockSerials *mock_repos.MockSerials
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSerials = mock_repos.NewMockSerials(ctrl)
+
+		mockGr.EXPECT().Serials().Return(mockSerials).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/serials GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/serials?limit=5&offset=10&id=1&product_id=2&serial_number=SN-100&status=in_stock&location_id=3", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockSerials.EXPECT().Find(gomock.Any(), &repos.SerialsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, ProductIDs: []int64{2}, SerialNumbers: []string{"SN-100"},
+				Statuses: []types.SerialStatus{types.SerialStatusInStock}, LocationIDs: []int64{3},
+			}).Return([]*types.Serial{{ID: 1, ProductID: 2, SerialNumber: "SN-100", Status: types.SerialStatusInStock}}, int64(1), nil).Times(1)
+
+			serials.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package serials
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/inconshreveable/log15"
+)
+
+// History - traces a serial number through everything that has happened to it since it was received
+func History(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.New().String()
+	gr, exists := middleware.RetrieveGlobalRepo(r.Context())
+	if !exists {
+		logger.Debug("unable to get global repo from context", log15.Ctx{"requestId": requestID})
+		http.Error(w, "unable to get internal resources id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	serialNumber := mux.Vars(r)["serial"]
+	if serialNumber == "" {
+		logger.Debug("unable to get serial from url parameters", log15.Ctx{"vars": mux.Vars(r), "requestId": requestID})
+		http.Error(w, "unable to get serial from url parameters id: "+requestID, http.StatusBadRequest)
+		return
+	}
+
+	// Use access to the database to find the requested object
+	history, exists, err := gr.Serials().History(r.Context(), serialNumber)
+	if err != nil {
+		logger.Debug("unable to get serial history", log15.Ctx{"err": err, "serial": serialNumber, "requestId": requestID})
+		http.Error(w, "unable to get serial history id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		logger.Debug("unable to get serial history", log15.Ctx{"err": err, "serial": serialNumber, "requestId": requestID})
+		http.Error(w, "unable to get serial history id: "+requestID, http.StatusNotFound)
+		return
+	}
+
+	// Marshal back the response
+	bts, err := json.Marshal(history)
+	if err != nil {
+		logger.Debug("unable to marshal response back", log15.Ctx{"err": err, "requestId": requestID})
+		// json package is heavily tested and this will never happen but we should check for it
+		http.Error(w, "unable to marshal serial history id: "+requestID, http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(bts)
+}
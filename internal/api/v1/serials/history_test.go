@@ -0,0 +1,97 @@
+package serials_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/serials"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/serials/{serial}/history", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockGr      *mock_repos.MockGlobalRepo
+		mockSerials *mock_repos.MockSerials
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSerials = mock_repos.NewMockSerials(ctrl)
+
+		mockGr.EXPECT().Serials().Return(mockSerials).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	historyReq := func(serial string) *http.Request {
+		return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+			httptest.NewRequest("GET", "/v1/serials/"+serial+"/history", nil), map[string]string{"serial": serial},
+		))
+	}
+
+	Context("/v1/serials/{serial}/history GET - history", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			serials.History(w, httptest.NewRequest("GET", "/v1/serials/SN-100/history", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			w := httptest.NewRecorder()
+
+			mockSerials.EXPECT().History(gomock.Any(), "SN-100").Return(nil, false, errors.New("BOGUS")).Times(1)
+
+			serials.History(w, historyReq("SN-100"))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return not found", func() {
+			w := httptest.NewRecorder()
+
+			mockSerials.EXPECT().History(gomock.Any(), "SN-100").Return(nil, false, nil).Times(1)
+
+			serials.History(w, historyReq("SN-100"))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return the serial and its events", func() {
+			w := httptest.NewRecorder()
+
+			mockSerials.EXPECT().History(gomock.Any(), "SN-100").Return(&types.SerialHistory{
+				Serial: &types.Serial{ID: 1, SerialNumber: "SN-100", Status: types.SerialStatusShipped},
+				Events: []*types.SerialEvent{
+					{ID: 1, SerialID: 1, Status: types.SerialStatusInStock},
+					{ID: 2, SerialID: 1, Status: types.SerialStatusAllocated},
+					{ID: 3, SerialID: 1, Status: types.SerialStatusShipped},
+				},
+			}, true, nil).Times(1)
+
+			serials.History(w, historyReq("SN-100"))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"shipped"`))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"allocated"`))
+		})
+	})
+})
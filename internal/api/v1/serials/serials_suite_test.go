@@ -0,0 +1,13 @@
+package serials_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSerials(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Serials Suite")
+}
@@ -0,0 +1,109 @@
+package shipments_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/shipments"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/shipments", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockShipments *mock_repos.MockShipments
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockShipments = mock_repos.NewMockShipments(ctrl)
+
+		mockGr.EXPECT().Shipments().Return(mockShipments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/shipments POST - create", func() {
+		newShipment := types.NewShipment{
+			PickListID: 3,
+			Cartons:    []types.NewCarton{{WeightGrams: 1200, Items: []types.NewCartonItem{{PickListLineID: 4, Qty: 2}}}},
+		}
+		body := []byte(`{"pickListId":3,"cartons":[{"weightGrams":1200,"items":[{"pickListLineId":4,"qty":2}]}]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			shipments.Create(w, httptest.NewRequest("POST", "/v1/shipments", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/shipments", nil))
+			w := httptest.NewRecorder()
+			shipments.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/shipments", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockShipments.EXPECT().Create(gomock.Any(), newShipment).
+				Return(nil, types.NewBadRequestError("BOGUS:Shipments.create")).Times(1)
+
+			shipments.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create shipment"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown pick list", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/shipments", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockShipments.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("pick list not found by id")).Times(1)
+
+			shipments.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a shipment", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/shipments", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockShipments.EXPECT().Create(gomock.Any(), newShipment).
+				Return(&types.Shipment{ID: 1, PickListID: 3, Status: types.ShipmentStatusPacked}, nil).Times(1)
+
+			shipments.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"packed"`))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package shipments_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/shipments"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/shipments", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockShipments *mock_repos.MockShipments
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockShipments = mock_repos.NewMockShipments(ctrl)
+
+		mockGr.EXPECT().Shipments().Return(mockShipments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/shipments GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/shipments?limit=5&offset=10&id=1&sales_order_id=2&status=packed&status=shipped", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockShipments.EXPECT().Find(gomock.Any(), &repos.ShipmentsFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, SalesOrderIDs: []int64{2},
+				Statuses: []types.ShipmentStatus{types.ShipmentStatusPacked, types.ShipmentStatusShipped},
+			}).Return([]*types.Shipment{{ID: 1, SalesOrderID: 2, Status: types.ShipmentStatusShipped}}, int64(1), nil).Times(1)
+
+			shipments.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package shipments_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/shipments"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/shipments", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockShipments *mock_repos.MockShipments
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockShipments = mock_repos.NewMockShipments(ctrl)
+
+		mockGr.EXPECT().Shipments().Return(mockShipments).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/shipments/{id}/ship POST - ship", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/shipments/1/ship", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			shipments.Ship(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request without a tracking number", func() {
+			mockShipments.EXPECT().Ship(gomock.Any(), &types.ShipShipment{ID: 1, Carrier: "UPS"}).
+				Return(nil, types.NewBadRequestError("TrackingNumber is required")).Times(1)
+
+			w := httptest.NewRecorder()
+			shipments.Ship(w, newReq(`{"id":5,"carrier":"UPS"}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockShipments.EXPECT().Ship(gomock.Any(), &types.ShipShipment{ID: 1, Carrier: "UPS", TrackingNumber: "1Z999"}).
+				Return(nil, types.NewNotFoundError("shipment not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			shipments.Ship(w, newReq(`{"carrier":"UPS","trackingNumber":"1Z999"}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should ship the shipment", func() {
+			mockShipments.EXPECT().Ship(gomock.Any(), &types.ShipShipment{ID: 1, Carrier: "UPS", TrackingNumber: "1Z999"}).
+				Return(&types.Shipment{ID: 1, Status: types.ShipmentStatusShipped, TrackingNumber: utils.Ref("1Z999")}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			shipments.Ship(w, newReq(`{"carrier":"UPS","trackingNumber":"1Z999"}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"trackingNumber":"1Z999"`))
+		})
+	})
+})
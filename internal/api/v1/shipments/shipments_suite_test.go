@@ -0,0 +1,13 @@
+package shipments_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestShipments(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shipments Suite")
+}
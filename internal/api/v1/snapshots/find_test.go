@@ -0,0 +1,77 @@
+package snapshots_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/snapshots"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/snapshots", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockSnapshots *mock_repos.MockSnapshots
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSnapshots = mock_repos.NewMockSnapshots(ctrl)
+
+		mockGr.EXPECT().Snapshots().Return(mockSnapshots).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/snapshots GET - find", func() {
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			snapshots.Find(w, httptest.NewRequest("GET", "/v1/snapshots", nil))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/snapshots", nil))
+			w := httptest.NewRecorder()
+
+			mockSnapshots.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, int64(0), errors.New("BOGUS")).Times(1)
+
+			snapshots.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("GET", "/v1/snapshots?limit=5&offset=10", nil))
+			w := httptest.NewRecorder()
+
+			mockSnapshots.EXPECT().Find(gomock.Any(), &repos.SnapshotsFind{Limit: 5, Offset: 10}).
+				Return([]*types.StockSnapshot{{ID: 1, Lines: 40}}, int64(1), nil).Times(1)
+
+			snapshots.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"lines":40`))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
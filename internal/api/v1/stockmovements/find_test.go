@@ -0,0 +1,60 @@
+package stockmovements_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/stockmovements"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/stockmovements", func() {
+	var (
+		ctrl               *gomock.Controller
+		mockGr             *mock_repos.MockGlobalRepo
+		mockStockMovements *mock_repos.MockStockMovements
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockStockMovements = mock_repos.NewMockStockMovements(ctrl)
+
+		mockGr.EXPECT().StockMovements().Return(mockStockMovements).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/stock-movements GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/stock-movements?limit=5&offset=10&product_id=1&location_id=2&reason=return&reference_type=return&reference_id=3", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockStockMovements.EXPECT().Find(gomock.Any(), &repos.StockMovementsFind{
+				Limit: 5, Offset: 10, ProductIDs: []int64{1}, LocationIDs: []int64{2},
+				Reasons:       []types.StockMovementReason{types.StockMovementReasonReturn},
+				ReferenceType: utils.Ref("return"), ReferenceIDs: []int64{3},
+			}).Return([]*types.StockMovement{{ID: 1, ProductID: 1, Qty: 2, Reason: types.StockMovementReasonReturn}}, int64(1), nil).Times(1)
+
+			stockmovements.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package stockmovements_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestStockmovements(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Stockmovements Suite")
+}
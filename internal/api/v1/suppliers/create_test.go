@@ -0,0 +1,93 @@
+package suppliers_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/suppliers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/suppliers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockSuppliers *mock_repos.MockSuppliers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockSuppliers = mock_repos.NewMockSuppliers(ctrl)
+
+		mockGr.EXPECT().Suppliers().Return(mockSuppliers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/suppliers POST - create", func() {
+		body := []byte(`{"name":"Acme Supply","code":"ACME"}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			suppliers.Create(w, httptest.NewRequest("POST", "/v1/suppliers", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/suppliers", nil))
+			w := httptest.NewRecorder()
+			suppliers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/suppliers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSuppliers.EXPECT().Create(gomock.Any(), types.NewSupplier{Name: "Acme Supply", Code: "ACME"}).
+				Return(nil, types.NewBadRequestError("BOGUS:Suppliers.create")).Times(1)
+
+			suppliers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create supplier"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should successfully create a supplier", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/suppliers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockSuppliers.EXPECT().Create(gomock.Any(), types.NewSupplier{Name: "Acme Supply", Code: "ACME"}).
+				Return(&types.Supplier{ID: 1, Name: "Acme Supply", Code: "ACME"}, nil).Times(1)
+
+			suppliers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring("ACME"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package suppliers_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSuppliers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Suppliers Suite")
+}
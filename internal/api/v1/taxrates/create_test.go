@@ -0,0 +1,94 @@
+package taxrates_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/taxrates"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/tax-rates", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockTaxRates *mock_repos.MockTaxRates
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTaxRates = mock_repos.NewMockTaxRates(ctrl)
+
+		mockGr.EXPECT().TaxRates().Return(mockTaxRates).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/tax-rates POST - create", func() {
+		body := []byte(`{"name":"Texas","country":"US","region":"TX","rate":6.25}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			taxrates.Create(w, httptest.NewRequest("POST", "/v1/tax-rates", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/tax-rates", nil))
+			w := httptest.NewRecorder()
+			taxrates.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/tax-rates", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockTaxRates.EXPECT().Create(gomock.Any(), types.NewTaxRate{Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 6.25}).
+				Return(nil, types.NewBadRequestError("BOGUS:TaxRates.create")).Times(1)
+
+			taxrates.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create tax rate"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should successfully create a tax rate", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/tax-rates", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockTaxRates.EXPECT().Create(gomock.Any(), types.NewTaxRate{Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 6.25}).
+				Return(&types.TaxRate{ID: 1, Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 6.25}, nil).Times(1)
+
+			taxrates.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring("Texas"))
+		})
+	})
+})
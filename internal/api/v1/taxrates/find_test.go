@@ -0,0 +1,57 @@
+package taxrates_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/taxrates"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/tax-rates", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockGr       *mock_repos.MockGlobalRepo
+		mockTaxRates *mock_repos.MockTaxRates
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTaxRates = mock_repos.NewMockTaxRates(ctrl)
+
+		mockGr.EXPECT().TaxRates().Return(mockTaxRates).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/tax-rates GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/tax-rates?limit=5&offset=10&id=1&country=US", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockTaxRates.EXPECT().Find(gomock.Any(), &repos.TaxRatesFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, Countries: []string{"US"},
+			}).Return([]*types.TaxRate{{ID: 1, Name: "Texas", Country: "US"}}, int64(1), nil).Times(1)
+
+			taxrates.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package taxrates_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTaxRates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TaxRates Suite")
+}
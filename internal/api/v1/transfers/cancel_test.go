@@ -0,0 +1,74 @@
+package transfers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/transfers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/transfers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockTransfers *mock_repos.MockTransfers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTransfers = mock_repos.NewMockTransfers(ctrl)
+
+		mockGr.EXPECT().Transfers().Return(mockTransfers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/transfers/{id}/cancel POST - cancel", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/transfers/1/cancel", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a conflict once the transfer has shipped", func() {
+			mockTransfers.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewBadRequestError("transfer can not go from shipped to cancelled")).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should return not found", func() {
+			mockTransfers.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewNotFoundError("transfer not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the transfer", func() {
+			mockTransfers.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(&types.Transfer{ID: 1, Status: types.TransferStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package transfers_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/transfers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/transfers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockTransfers *mock_repos.MockTransfers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTransfers = mock_repos.NewMockTransfers(ctrl)
+
+		mockGr.EXPECT().Transfers().Return(mockTransfers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/transfers POST - create", func() {
+		body := []byte(`{"sourceLocationId":1,"destinationLocationId":2,"lines":[{"productId":3,"qty":4}]}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			transfers.Create(w, httptest.NewRequest("POST", "/v1/transfers", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/transfers", nil))
+			w := httptest.NewRecorder()
+			transfers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/transfers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockTransfers.EXPECT().Create(gomock.Any(), types.NewTransfer{SourceLocationID: 1, DestinationLocationID: 2, Lines: []types.NewTransferLine{{ProductID: 3, Qty: 4}}}).
+				Return(nil, types.NewBadRequestError("BOGUS:Transfers.create")).Times(1)
+
+			transfers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create transfer"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown location", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/transfers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockTransfers.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("location not found by id")).Times(1)
+
+			transfers.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a transfer", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/transfers", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockTransfers.EXPECT().Create(gomock.Any(), types.NewTransfer{SourceLocationID: 1, DestinationLocationID: 2, Lines: []types.NewTransferLine{{ProductID: 3, Qty: 4}}}).
+				Return(&types.Transfer{ID: 1, SourceLocationID: 1, DestinationLocationID: 2, Status: types.TransferStatusDraft}, nil).Times(1)
+
+			transfers.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"draft"`))
+		})
+	})
+})
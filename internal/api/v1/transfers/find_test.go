@@ -0,0 +1,58 @@
+package transfers_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/transfers"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/transfers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockTransfers *mock_repos.MockTransfers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTransfers = mock_repos.NewMockTransfers(ctrl)
+
+		mockGr.EXPECT().Transfers().Return(mockTransfers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/transfers GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/transfers?limit=5&offset=10&id=1&location_id=2&status=shipped&status=partially_received", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockTransfers.EXPECT().Find(gomock.Any(), &repos.TransfersFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, LocationIDs: []int64{2},
+				Statuses: []types.TransferStatus{types.TransferStatusShipped, types.TransferStatusPartiallyReceived},
+			}).Return([]*types.Transfer{{ID: 1, SourceLocationID: 1, DestinationLocationID: 2, Status: types.TransferStatusShipped}}, int64(1), nil).Times(1)
+
+			transfers.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package transfers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/transfers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/transfers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockTransfers *mock_repos.MockTransfers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTransfers = mock_repos.NewMockTransfers(ctrl)
+
+		mockGr.EXPECT().Transfers().Return(mockTransfers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/transfers/{id}/receive POST - receive", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/transfers/1/receive", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			transfers.Receive(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when more arrives than was in transit", func() {
+			mockTransfers.EXPECT().Receive(gomock.Any(), &types.ReceiveTransfer{
+				ID: 1, Lines: []types.ReceiveTransferLine{{TransferLineID: 2, Qty: 9}},
+			}).Return(nil, types.NewBadRequestError("can not receive more than is in transit")).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Receive(w, newReq(`{"id":5,"lines":[{"transferLineId":2,"qty":9}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockTransfers.EXPECT().Receive(gomock.Any(), &types.ReceiveTransfer{ID: 1}).
+				Return(nil, types.NewNotFoundError("transfer not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Receive(w, newReq(`{}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should close the transfer short", func() {
+			mockTransfers.EXPECT().Receive(gomock.Any(), &types.ReceiveTransfer{
+				ID: 1, Lines: []types.ReceiveTransferLine{{TransferLineID: 2, Qty: 3}}, Close: true,
+			}).Return(&types.Transfer{ID: 1, Status: types.TransferStatusReceived}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Receive(w, newReq(`{"lines":[{"transferLineId":2,"qty":3}],"close":true}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"received"`))
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package transfers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/transfers"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/transfers", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockGr        *mock_repos.MockGlobalRepo
+		mockTransfers *mock_repos.MockTransfers
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockTransfers = mock_repos.NewMockTransfers(ctrl)
+
+		mockGr.EXPECT().Transfers().Return(mockTransfers).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/transfers/{id}/ship POST - ship", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/transfers/1/ship", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			transfers.Ship(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when the source doesn't hold enough stock", func() {
+			mockTransfers.EXPECT().Ship(gomock.Any(), &types.ShipTransfer{
+				ID: 1, Lines: []types.ShipTransferLine{{TransferLineID: 2, Qty: 9}},
+			}).Return(nil, types.NewBadRequestError("not enough stock at this location")).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Ship(w, newReq(`{"id":5,"lines":[{"transferLineId":2,"qty":9}]}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockTransfers.EXPECT().Ship(gomock.Any(), &types.ShipTransfer{ID: 1}).
+				Return(nil, types.NewNotFoundError("transfer not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Ship(w, newReq(`{}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should ship the transfer", func() {
+			mockTransfers.EXPECT().Ship(gomock.Any(), &types.ShipTransfer{ID: 1}).
+				Return(&types.Transfer{ID: 1, Status: types.TransferStatusShipped}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			transfers.Ship(w, newReq(`{}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"shipped"`))
+		})
+	})
+})
@@ -2,9 +2,55 @@ package v1
 
 import (
 	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/attachments"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/barcodes"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/countsessions"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/customers"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/forecasts"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/invoices"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/kits"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/locations"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/lots"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/picklists"
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/products"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/purchaseorders"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/receipts"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/reports"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/returns"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/salesorders"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/serials"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/shipments"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/snapshots"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/stockmovements"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/suppliers"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/taxrates"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/transfers"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/workorders"
 )
 
 func SetRoutes(subrouter *mux.Router) {
 	products.SetRoutes(subrouter.PathPrefix("/products").Subrouter())
+	barcodes.SetRoutes(subrouter.PathPrefix("/barcodes").Subrouter())
+	attachments.SetRoutes(subrouter.PathPrefix("/attachments").Subrouter())
+	locations.SetRoutes(subrouter.PathPrefix("/locations").Subrouter())
+	reports.SetRoutes(subrouter.PathPrefix("/reports").Subrouter())
+	suppliers.SetRoutes(subrouter.PathPrefix("/suppliers").Subrouter())
+	purchaseorders.SetRoutes(subrouter.PathPrefix("/purchase-orders").Subrouter())
+	receipts.SetRoutes(subrouter.PathPrefix("/receipts").Subrouter())
+	salesorders.SetRoutes(subrouter.PathPrefix("/sales-orders").Subrouter())
+	picklists.SetRoutes(subrouter.PathPrefix("/pick-lists").Subrouter())
+	shipments.SetRoutes(subrouter.PathPrefix("/shipments").Subrouter())
+	returns.SetRoutes(subrouter.PathPrefix("/returns").Subrouter())
+	stockmovements.SetRoutes(subrouter.PathPrefix("/stock-movements").Subrouter())
+	transfers.SetRoutes(subrouter.PathPrefix("/transfers").Subrouter())
+	countsessions.SetRoutes(subrouter.PathPrefix("/count-sessions").Subrouter())
+	lots.SetRoutes(subrouter.PathPrefix("/lots").Subrouter())
+	serials.SetRoutes(subrouter.PathPrefix("/serials").Subrouter())
+	kits.SetRoutes(subrouter.PathPrefix("/kits").Subrouter())
+	workorders.SetRoutes(subrouter.PathPrefix("/work-orders").Subrouter())
+	snapshots.SetRoutes(subrouter.PathPrefix("/snapshots").Subrouter())
+	forecasts.SetRoutes(subrouter.PathPrefix("/forecasts").Subrouter())
+	customers.SetRoutes(subrouter.PathPrefix("/customers").Subrouter())
+	taxrates.SetRoutes(subrouter.PathPrefix("/tax-rates").Subrouter())
+	invoices.SetRoutes(subrouter.PathPrefix("/invoices").Subrouter())
 }
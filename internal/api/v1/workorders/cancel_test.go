@@ -0,0 +1,74 @@
+package workorders_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/workorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/workorders", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockGr         *mock_repos.MockGlobalRepo
+		mockWorkOrders *mock_repos.MockWorkOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockWorkOrders = mock_repos.NewMockWorkOrders(ctrl)
+
+		mockGr.EXPECT().WorkOrders().Return(mockWorkOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/work-orders/{id}/cancel POST - cancel", func() {
+		newReq := func() *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/work-orders/1/cancel", nil), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a conflict once the work order is closed", func() {
+			mockWorkOrders.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewBadRequestError("work order can not go from completed to cancelled")).Times(1)
+
+			w := httptest.NewRecorder()
+			workorders.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It("should return not found", func() {
+			mockWorkOrders.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(nil, types.NewNotFoundError("work order not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			workorders.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should cancel the work order", func() {
+			mockWorkOrders.EXPECT().Cancel(gomock.Any(), int64(1)).
+				Return(&types.WorkOrder{ID: 1, Status: types.WorkOrderStatusCancelled}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			workorders.Cancel(w, newReq())
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+})
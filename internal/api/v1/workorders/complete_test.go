@@ -0,0 +1,83 @@
+package workorders_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/workorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/workorders", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockGr         *mock_repos.MockGlobalRepo
+		mockWorkOrders *mock_repos.MockWorkOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockWorkOrders = mock_repos.NewMockWorkOrders(ctrl)
+
+		mockGr.EXPECT().WorkOrders().Return(mockWorkOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/work-orders/{id}/complete POST - complete", func() {
+		newReq := func(body string) *http.Request {
+			return middleware.SetGlobalRepoOnContext(mockGr, mux.SetURLVars(
+				httptest.NewRequest("POST", "/v1/work-orders/1/complete", bytes.NewReader([]byte(body))), map[string]string{"id": "1"},
+			))
+		}
+
+		It("should return a bad request for an invalid body", func() {
+			w := httptest.NewRecorder()
+			workorders.Complete(w, newReq(`{`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return a bad request when more is completed than remains", func() {
+			mockWorkOrders.EXPECT().Complete(gomock.Any(), &types.CompleteWorkOrder{ID: 1, QtyCompleted: 9, QtyScrapped: 1}).
+				Return(nil, types.NewBadRequestError("can not complete more than remains on the work order")).Times(1)
+
+			w := httptest.NewRecorder()
+			workorders.Complete(w, newReq(`{"id":5,"qtyCompleted":9,"qtyScrapped":1}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found", func() {
+			mockWorkOrders.EXPECT().Complete(gomock.Any(), &types.CompleteWorkOrder{ID: 1, QtyCompleted: 1}).
+				Return(nil, types.NewNotFoundError("work order not found by id")).Times(1)
+
+			w := httptest.NewRecorder()
+			workorders.Complete(w, newReq(`{"qtyCompleted":1}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should complete the work order", func() {
+			mockWorkOrders.EXPECT().Complete(gomock.Any(), &types.CompleteWorkOrder{ID: 1, QtyCompleted: 4}).
+				Return(&types.WorkOrder{ID: 1, Qty: 4, QtyCompleted: 4, Status: types.WorkOrderStatusCompleted}, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			workorders.Complete(w, newReq(`{"qtyCompleted":4}`))
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"status":"completed"`))
+		})
+	})
+})
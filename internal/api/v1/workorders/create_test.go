@@ -0,0 +1,105 @@
+package workorders_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/workorders"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/workorders", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockGr         *mock_repos.MockGlobalRepo
+		mockWorkOrders *mock_repos.MockWorkOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockWorkOrders = mock_repos.NewMockWorkOrders(ctrl)
+
+		mockGr.EXPECT().WorkOrders().Return(mockWorkOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/work-orders POST - create", func() {
+		body := []byte(`{"productId":3,"qty":4}`)
+
+		It("should return an error when the repo is not on the context", func() {
+			w := httptest.NewRecorder()
+			workorders.Create(w, httptest.NewRequest("POST", "/v1/work-orders", nil))
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to get internal resources"))
+			Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return an error when an invalid body is passed in", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/work-orders", nil))
+			w := httptest.NewRecorder()
+			workorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to read body"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should sanitize the err from the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/work-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockWorkOrders.EXPECT().Create(gomock.Any(), types.NewWorkOrder{ProductID: 3, Qty: 4}).
+				Return(nil, types.NewBadRequestError("BOGUS:WorkOrders.create")).Times(1)
+
+			workorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(string(resBts)).To(ContainSubstring("unable to create work order"))
+			Expect(string(resBts)).NotTo(ContainSubstring("BOGUS"))
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found for an unknown product", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/work-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockWorkOrders.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, types.NewNotFoundError("product not found by id")).Times(1)
+
+			workorders.Create(w, req)
+
+			Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should successfully create a work order", func() {
+			req := middleware.SetGlobalRepoOnContext(mockGr, httptest.NewRequest("POST", "/v1/work-orders", bytes.NewBuffer(body)))
+			w := httptest.NewRecorder()
+
+			mockWorkOrders.EXPECT().Create(gomock.Any(), types.NewWorkOrder{ProductID: 3, Qty: 4}).
+				Return(&types.WorkOrder{ID: 1, ProductID: 3, Qty: 4, Status: types.WorkOrderStatusReleased}, nil).Times(1)
+
+			workorders.Create(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(string(resBts)).To(ContainSubstring(`"status":"released"`))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package workorders_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/middleware"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/api/v1/workorders"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	mock_repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos/mocks"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HTTP: /v1/workorders", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockGr         *mock_repos.MockGlobalRepo
+		mockWorkOrders *mock_repos.MockWorkOrders
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockGr = mock_repos.NewMockGlobalRepo(ctrl)
+		mockWorkOrders = mock_repos.NewMockWorkOrders(ctrl)
+
+		mockGr.EXPECT().WorkOrders().Return(mockWorkOrders).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("/v1/work-orders GET - find", func() {
+		It("should pass the query on to the repo", func() {
+			req := middleware.SetGlobalRepoOnContext(
+				mockGr, httptest.NewRequest("GET", "/v1/work-orders?limit=5&offset=10&id=1&product_id=2&status=released&status=completed", nil),
+			)
+			w := httptest.NewRecorder()
+
+			mockWorkOrders.EXPECT().Find(gomock.Any(), &repos.WorkOrdersFind{
+				Limit: 5, Offset: 10, IDs: []int64{1}, ProductIDs: []int64{2},
+				Statuses: []types.WorkOrderStatus{types.WorkOrderStatusReleased, types.WorkOrderStatusCompleted},
+			}).Return([]*types.WorkOrder{{ID: 1, ProductID: 2, Status: types.WorkOrderStatusReleased}}, int64(1), nil).Times(1)
+
+			workorders.Find(w, req)
+
+			resp := w.Result()
+			resBts, _ := io.ReadAll(resp.Body)
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(resBts)).To(ContainSubstring(`"count":1`))
+		})
+	})
+})
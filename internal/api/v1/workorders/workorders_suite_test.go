@@ -0,0 +1,13 @@
+package workorders_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestWorkOrders(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "WorkOrders Suite")
+}
@@ -0,0 +1,114 @@
+package billing
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	// invoices are printed on A4
+	invoicePageWidthMM = 210.0
+	invoiceMarginMM    = 12.0
+	invoiceRowMM       = 7.0
+)
+
+// Money - cents as dollars and cents, e.g. -1234 is -12.34
+func Money(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// RenderInvoice - writes the invoice as a printable pdf with its lines, the tax charged by
+// each rate and what's left to pay
+func RenderInvoice(w io.Writer, invoice *types.Invoice) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(invoiceMarginMM, invoiceMarginMM, invoiceMarginMM)
+	pdf.SetAutoPageBreak(true, invoiceMarginMM)
+	pdf.AddPage()
+
+	// the core fonts are cp1252 so names need translating from utf-8
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	contentWidth := invoicePageWidthMM - 2*invoiceMarginMM
+	half := contentWidth / 2
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(half, 9, "Invoice "+invoice.Number, "", 0, "L", false, 0, "")
+	pdf.CellFormat(half, 9, strings.ToUpper(string(invoice.Status)), "", 1, "R", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, detail := range []string{
+		"Issued " + invoice.IssuedAt.Format("2006-01-02"),
+		"Due " + invoice.DueAt.Format("2006-01-02") + " - " + invoice.Terms(),
+		fmt.Sprintf("Sales order #%d, shipment #%d", invoice.SalesOrderID, invoice.ShipmentID),
+	} {
+		pdf.CellFormat(contentWidth, 6, detail, "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(contentWidth, 6, "Bill to", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	billTo := invoice.CustomerName
+	if invoice.BillTo != nil {
+		billTo = *invoice.BillTo
+	}
+	for _, line := range strings.Split(billTo, "\n") {
+		pdf.CellFormat(contentWidth, 5, tr(line), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	// sku, product, qty, unit price, amount
+	widths := []float64{34, contentWidth - 34 - 18 - 30 - 30, 18, 30, 30}
+
+	pdf.SetFont("Helvetica", "B", 9)
+	for i, heading := range []string{"SKU", "Product", "Qty", "Unit price", "Amount"} {
+		align := "L"
+		if i >= 2 {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], invoiceRowMM, heading, "B", 0, align, false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, line := range invoice.Lines {
+		pdf.CellFormat(widths[0], invoiceRowMM, tr(line.Sku), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], invoiceRowMM, tr(line.Name), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[2], invoiceRowMM, strconv.FormatInt(line.Qty, 10), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], invoiceRowMM, Money(line.UnitPrice), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], invoiceRowMM, Money(line.Amount), "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(2)
+
+	// the totals line up under the amount column
+	labelWidth := contentWidth - widths[4]
+	total := func(label, amount string, bold bool) {
+		style := ""
+		if bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 9)
+		pdf.CellFormat(labelWidth, 6, tr(label), "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], 6, amount, "", 1, "R", false, 0, "")
+	}
+
+	total("Subtotal", Money(invoice.Subtotal), false)
+	for _, invoiceTax := range invoice.Taxes {
+		total(fmt.Sprintf("%s (%s%%)", invoiceTax.Name, strconv.FormatFloat(invoiceTax.Rate, 'f', -1, 64)), Money(invoiceTax.Tax), false)
+	}
+	total("Total", Money(invoice.Total), true)
+	for _, payment := range invoice.Payments {
+		total(fmt.Sprintf("Paid %s by %s", payment.PaidAt.Format("2006-01-02"), payment.Method), "-"+Money(payment.Amount), false)
+	}
+	total("Balance due", Money(invoice.Balance()), true)
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,48 @@
+package billing_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/billing"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BILLING: Invoice", func() {
+	Context("Money", func() {
+		It("should format cents", func() {
+			Expect(billing.Money(0)).To(Equal("0.00"))
+			Expect(billing.Money(5)).To(Equal("0.05"))
+			Expect(billing.Money(123456)).To(Equal("1234.56"))
+			Expect(billing.Money(-1234)).To(Equal("-12.34"))
+		})
+	})
+
+	Context("RenderInvoice", func() {
+		It("should render a pdf", func() {
+			issuedAt := time.Now()
+			invoice := &types.Invoice{
+				ID: 1, Number: types.InvoiceNumber(issuedAt.Year(), 1), SalesOrderID: 2, ShipmentID: 3,
+				CustomerName: "Jane Doe", BillTo: utils.Ref("Jane Doe\n1 Main St\nSpringfield, IL 62701\nUS"),
+				Status: types.InvoiceStatusOpen, Subtotal: 2000, Tax: 125, Total: 2125, AmountPaid: 1000,
+				TermsDays: 30, IssuedAt: issuedAt, DueAt: issuedAt.AddDate(0, 0, 30),
+				Lines: []*types.InvoiceLine{
+					{ID: 1, Sku: "WF-100", Name: "Water Filter", Qty: 2, UnitPrice: 1000, Amount: 2000, Tax: 125},
+				},
+				Taxes: []*types.InvoiceTax{
+					{ID: 1, Name: "Illinois", Rate: 6.25, Taxable: 2000, Tax: 125},
+				},
+				Payments: []*types.InvoicePayment{
+					{ID: 1, Amount: 1000, Method: "card", PaidAt: issuedAt},
+				},
+			}
+
+			buf := new(bytes.Buffer)
+			Expect(billing.RenderInvoice(buf, invoice)).To(Succeed())
+			Expect(buf.String()).To(HavePrefix("%PDF"))
+		})
+	})
+})
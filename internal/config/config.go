@@ -22,10 +22,42 @@ type DBConfig struct {
 	Database string `yaml:"database"`
 }
 
-type Config struct {
+type StorageConfig struct {
+	// Driver - only "local" for now
+	Driver         string `yaml:"driver"`
+	Path           string `yaml:"path"`
+	MaxUploadBytes int64  `yaml:"maxUploadBytes"`
+}
+
+type EmailConfig struct {
+	// Host - the SMTP server, alert emails are off when empty
+	Host     string   `yaml:"host"`
 	Port     int      `yaml:"port"`
-	Debug    bool     `yaml:"debug"`
-	DBConfig DBConfig `yaml:"db"`
+	Username string   `yaml:"user"`
+	Password string   `yaml:"pass"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+type AlertsConfig struct {
+	Log      bool        `yaml:"log"`
+	Webhooks []string    `yaml:"webhooks"`
+	Email    EmailConfig `yaml:"email"`
+}
+
+type JobsConfig struct {
+	// Snapshot - when stock is snapshotted each night, like 23:55 in the server's time zone.
+	// Off when empty.
+	Snapshot string `yaml:"snapshot"`
+}
+
+type Config struct {
+	Port     int           `yaml:"port"`
+	Debug    bool          `yaml:"debug"`
+	DBConfig DBConfig      `yaml:"db"`
+	Storage  StorageConfig `yaml:"storage"`
+	Alerts   AlertsConfig  `yaml:"alerts"`
+	Jobs     JobsConfig    `yaml:"jobs"`
 }
 
 func NewConfig() *Config {
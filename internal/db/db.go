@@ -4,14 +4,15 @@ import (
 	"fmt"
 
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/tax"
 
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/config"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"xorm.io/xorm"
 )
 
-// NewDB creates a new database connection
-func NewDB(cfg config.DBConfig) (repos.GlobalRepo, error) {
+// NewDB creates a new database connection, calculator and observers are handed to the global repo
+func NewDB(cfg config.DBConfig, calculator tax.Calculator, observers ...repos.StockObserver) (repos.GlobalRepo, error) {
 	connStr := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?connect_timeout=180&sslmode=disable",
 		cfg.Username, cfg.Password, cfg.Host, cfg.Port, cfg.Database,
 	)
@@ -23,7 +24,7 @@ func NewDB(cfg config.DBConfig) (repos.GlobalRepo, error) {
 
 	db.ShowSQL(true)
 
-	gr, err := repos.NewGlobalRepo(db)
+	gr, err := repos.NewGlobalRepo(db, calculator, observers...)
 	if err != nil {
 		return nil, err
 	}
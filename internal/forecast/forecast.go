@@ -0,0 +1,239 @@
+package forecast
+
+import (
+	"math"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+)
+
+// Options - how demand is forecast and how much stock is kept to cover it. Anything left at zero
+// takes its default, see WithDefaults.
+type Options struct {
+	Method types.ForecastMethod
+	// PeriodDays - demand history is added up over periods this many days long
+	PeriodDays int
+	// Periods - how many periods of history are used
+	Periods int
+	// AveragePeriods - how many of the latest periods the moving average is taken over
+	AveragePeriods int
+	// Season - how many periods before demand repeats itself, exponential smoothing only adds
+	// seasonality with at least two seasons of history
+	Season int
+	// Alpha, Beta, Gamma - how quickly exponential smoothing follows changes in the level, trend
+	// and seasonality of demand, between 0 and 1
+	Alpha float64
+	Beta  float64
+	Gamma float64
+	// ServiceLevel - the chance of not running out while waiting on an order, between 0.5 and 1
+	ServiceLevel float64
+	// ReviewDays - how often orders are placed, stock is ordered up to what covers the lead
+	// time and the review after it
+	ReviewDays int
+}
+
+// WithDefaults - exponential smoothing over two years of weekly demand with a yearly season, a
+// 95% service level and orders placed every two weeks
+func (o Options) WithDefaults() Options {
+	if o.Method == "" {
+		o.Method = types.ForecastMethodExponential
+	}
+	if o.PeriodDays <= 0 {
+		o.PeriodDays = 7
+	}
+	if o.Season <= 0 {
+		o.Season = 52
+	}
+	if o.Periods <= 0 {
+		o.Periods = 2 * o.Season
+	}
+	if o.AveragePeriods <= 0 {
+		o.AveragePeriods = 12
+	}
+	if o.Alpha <= 0 {
+		o.Alpha = 0.3
+	}
+	if o.Beta <= 0 {
+		o.Beta = 0.1
+	}
+	if o.Gamma <= 0 {
+		o.Gamma = 0.2
+	}
+	if o.ServiceLevel <= 0 {
+		o.ServiceLevel = 0.95
+	}
+	if o.ReviewDays <= 0 {
+		o.ReviewDays = 14
+	}
+	return o
+}
+
+// Item - a product's demand per period, oldest first, and what's needed to plan its stock
+type Item struct {
+	Demand []float64
+	// Position - on hand less allocated plus on order
+	Position     int64
+	LeadTimeDays int64
+	// MinOrderQty - orders are rounded up to a multiple of this
+	MinOrderQty int64
+}
+
+// Plan - how much of a product to keep and order, see SuggestedPurchase
+type Plan struct {
+	DailyDemand  float64
+	Seasonal     bool
+	SafetyStock  int64
+	ReorderPoint int64
+	OrderUpTo    int64
+	Qty          int64
+}
+
+// PlanFor - forecasts the item's demand over its lead time and the review after it. Once its
+// position drops to the demand over the lead time plus safety stock it's ordered back up to the
+// demand over both plus safety stock.
+func PlanFor(item Item, opts Options) Plan {
+	opts = opts.WithDefaults()
+
+	leadPeriods := float64(item.LeadTimeDays) / float64(opts.PeriodDays)
+	coverPeriods := float64(item.LeadTimeDays+int64(opts.ReviewDays)) / float64(opts.PeriodDays)
+
+	var plan Plan
+	var forecast []float64
+	horizon := int(math.Ceil(coverPeriods))
+	switch opts.Method {
+	case types.ForecastMethodMovingAverage:
+		forecast = repeat(MovingAverage(item.Demand, opts.AveragePeriods), horizon)
+	default:
+		forecast, plan.Seasonal = Exponential(item.Demand, opts, horizon)
+	}
+
+	// there's always a review so the cover is never empty
+	plan.DailyDemand = sumOver(forecast, coverPeriods) / (coverPeriods * float64(opts.PeriodDays))
+
+	safety := SafetyStock(opts.ServiceLevel, StdDev(item.Demand), leadPeriods)
+	plan.SafetyStock = int64(math.Ceil(safety))
+	plan.ReorderPoint = int64(math.Ceil(sumOver(forecast, leadPeriods) + safety))
+	plan.OrderUpTo = int64(math.Ceil(sumOver(forecast, coverPeriods) + safety))
+
+	if item.Position <= plan.ReorderPoint && plan.OrderUpTo > item.Position {
+		plan.Qty = plan.OrderUpTo - item.Position
+		if item.MinOrderQty > 1 {
+			plan.Qty = (plan.Qty + item.MinOrderQty - 1) / item.MinOrderQty * item.MinOrderQty
+		}
+	}
+
+	return plan
+}
+
+// MovingAverage - the mean demand of the latest n periods, or all of them when there are fewer
+func MovingAverage(demand []float64, n int) float64 {
+	if n <= 0 || n > len(demand) {
+		n = len(demand)
+	}
+	if n == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, qty := range demand[len(demand)-n:] {
+		total += qty
+	}
+	return total / float64(n)
+}
+
+// Exponential - forecasts demand for the next horizon periods. With two full seasons of history
+// it's additive Holt-Winters, following the level, trend and seasonality of demand, otherwise
+// it's simple exponential smoothing of the level. Seasonal is whether it was Holt-Winters.
+func Exponential(demand []float64, opts Options, horizon int) (forecast []float64, seasonal bool) {
+	opts = opts.WithDefaults()
+	m := opts.Season
+
+	if len(demand) == 0 {
+		return repeat(0, horizon), false
+	}
+
+	if m < 2 || len(demand) < 2*m {
+		level := demand[0]
+		for _, qty := range demand[1:] {
+			level = opts.Alpha*qty + (1-opts.Alpha)*level
+		}
+		return repeat(level, horizon), false
+	}
+
+	// the first season starts things off, its mean sits in the middle of it so the level is
+	// carried to its end and the trend is taken out of the seasons
+	first, second := mean(demand[:m]), mean(demand[m:2*m])
+	trend := (second - first) / float64(m)
+	middle := float64(m-1) / 2
+	level := first + trend*middle
+	seasons := make([]float64, len(demand))
+	for i := 0; i < m; i++ {
+		seasons[i] = demand[i] - (first + trend*(float64(i)-middle))
+	}
+
+	for t := m; t < len(demand); t++ {
+		prevLevel := level
+		level = opts.Alpha*(demand[t]-seasons[t-m]) + (1-opts.Alpha)*(level+trend)
+		trend = opts.Beta*(level-prevLevel) + (1-opts.Beta)*trend
+		seasons[t] = opts.Gamma*(demand[t]-level) + (1-opts.Gamma)*seasons[t-m]
+	}
+
+	forecast = make([]float64, horizon)
+	for h := 1; h <= horizon; h++ {
+		forecast[h-1] = math.Max(0, level+float64(h)*trend+seasons[len(demand)-m+(h-1)%m])
+	}
+	return forecast, true
+}
+
+// SafetyStock - the stock that covers demand varying by stdDev a period over a lead time of
+// leadPeriods at the service level, assuming demand is normally distributed
+func SafetyStock(serviceLevel, stdDev, leadPeriods float64) float64 {
+	if serviceLevel <= 0.5 || serviceLevel >= 1 || stdDev <= 0 || leadPeriods <= 0 {
+		return 0
+	}
+	return ServiceFactor(serviceLevel) * stdDev * math.Sqrt(leadPeriods)
+}
+
+// ServiceFactor - how many standard deviations above the mean cover the service level
+func ServiceFactor(serviceLevel float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*serviceLevel-1)
+}
+
+// StdDev - the sample standard deviation of demand per period
+func StdDev(demand []float64) float64 {
+	if len(demand) < 2 {
+		return 0
+	}
+
+	avg := mean(demand)
+	var total float64
+	for _, qty := range demand {
+		total += (qty - avg) * (qty - avg)
+	}
+	return math.Sqrt(total / float64(len(demand)-1))
+}
+
+func mean(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+func repeat(value float64, n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = value
+	}
+	return values
+}
+
+// sumOver - the forecast added up over a number of periods, the last one only counts for the
+// part of it that's covered
+func sumOver(forecast []float64, periods float64) float64 {
+	var total float64
+	for i := 0; i < len(forecast) && float64(i) < periods; i++ {
+		total += forecast[i] * math.Min(1, periods-float64(i))
+	}
+	return total
+}
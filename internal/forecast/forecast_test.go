@@ -0,0 +1,115 @@
+package forecast_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/forecast"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FORECAST", func() {
+
+	Context("MovingAverage", func() {
+		It("should average the latest periods", func() {
+			Expect(forecast.MovingAverage([]float64{100, 2, 4, 6}, 3)).To(Equal(4.0))
+			Expect(forecast.MovingAverage([]float64{2, 4}, 12)).To(Equal(3.0))
+			Expect(forecast.MovingAverage(nil, 12)).To(BeZero())
+		})
+	})
+
+	Context("Exponential", func() {
+		It("should smooth the level without enough history for seasons", func() {
+			res, seasonal := forecast.Exponential([]float64{10, 20}, forecast.Options{Alpha: 0.5, Season: 4}, 2)
+			Expect(seasonal).To(BeFalse())
+			Expect(res).To(Equal([]float64{15, 15}))
+		})
+
+		It("should follow seasonality with two seasons of history", func() {
+			demand := []float64{}
+			for i := 0; i < 3; i++ {
+				demand = append(demand, 10, 30, 10, 30)
+			}
+
+			res, seasonal := forecast.Exponential(demand, forecast.Options{Season: 4}, 4)
+			Expect(seasonal).To(BeTrue())
+			Expect(res).To(HaveLen(4))
+			Expect(res[0]).To(BeNumerically("~", 10, 0.0001))
+			Expect(res[1]).To(BeNumerically("~", 30, 0.0001))
+			Expect(res[2]).To(BeNumerically("~", 10, 0.0001))
+			Expect(res[3]).To(BeNumerically("~", 30, 0.0001))
+		})
+
+		It("should follow a trend", func() {
+			demand := []float64{}
+			for i := 0; i < 8; i++ {
+				demand = append(demand, float64(10+i))
+			}
+
+			res, _ := forecast.Exponential(demand, forecast.Options{Season: 4}, 2)
+			Expect(res[0]).To(BeNumerically("~", 18, 0.0001))
+			Expect(res[1]).To(BeNumerically("~", 19, 0.0001))
+		})
+
+		It("should never forecast negative demand", func() {
+			res, _ := forecast.Exponential([]float64{40, 30, 20, 10, 4, 3, 2, 1}, forecast.Options{Season: 4}, 8)
+			for _, qty := range res {
+				Expect(qty).To(BeNumerically(">=", 0))
+			}
+		})
+	})
+
+	Context("SafetyStock", func() {
+		It("should cover variation over the lead time at the service level", func() {
+			Expect(forecast.ServiceFactor(0.95)).To(BeNumerically("~", 1.6449, 0.0001))
+			Expect(forecast.StdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9})).To(BeNumerically("~", 2.1381, 0.0001))
+			Expect(forecast.SafetyStock(0.95, 10, 4)).To(BeNumerically("~", 32.897, 0.001))
+			Expect(forecast.SafetyStock(0.95, 0, 4)).To(BeZero())
+			Expect(forecast.SafetyStock(0.95, 10, 0)).To(BeZero())
+		})
+	})
+
+	Context("PlanFor", func() {
+		steady := func() []float64 {
+			demand := make([]float64, 12)
+			for i := range demand {
+				demand[i] = 70
+			}
+			return demand
+		}
+
+		It("should order up to the demand over the lead time and review", func() {
+			plan := forecast.PlanFor(forecast.Item{Demand: steady(), Position: 50, LeadTimeDays: 7}, forecast.Options{
+				Method: types.ForecastMethodMovingAverage,
+			})
+
+			Expect(plan.DailyDemand).To(BeNumerically("~", 10, 0.0001))
+			Expect(plan.SafetyStock).To(BeZero())
+			Expect(plan.ReorderPoint).To(BeNumerically("==", 70))
+			Expect(plan.OrderUpTo).To(BeNumerically("==", 210))
+			Expect(plan.Qty).To(BeNumerically("==", 160))
+		})
+
+		It("should not order above the reorder point", func() {
+			plan := forecast.PlanFor(forecast.Item{Demand: steady(), Position: 71, LeadTimeDays: 7}, forecast.Options{})
+			Expect(plan.Qty).To(BeZero())
+		})
+
+		It("should round up to the minimum order qty", func() {
+			plan := forecast.PlanFor(forecast.Item{Demand: steady(), Position: 50, LeadTimeDays: 7, MinOrderQty: 100}, forecast.Options{})
+			Expect(plan.Qty).To(BeNumerically("==", 200))
+		})
+
+		It("should add safety stock for varying demand", func() {
+			demand := []float64{}
+			for i := 0; i < 6; i++ {
+				demand = append(demand, 50, 90)
+			}
+
+			plan := forecast.PlanFor(forecast.Item{Demand: demand, LeadTimeDays: 7}, forecast.Options{
+				Method: types.ForecastMethodMovingAverage, ServiceLevel: 0.95,
+			})
+			Expect(plan.SafetyStock).To(BeNumerically("==", 35))
+			Expect(plan.ReorderPoint).To(BeNumerically("==", 105))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package fulfillment_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFulfillment(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fulfillment Suite")
+}
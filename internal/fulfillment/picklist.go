@@ -0,0 +1,163 @@
+package fulfillment
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// PickListDocument - a pick list along with what's needed to print it
+type PickListDocument struct {
+	PickList  *types.PickList
+	Order     *types.SalesOrder
+	Products  map[int64]*types.Product
+	Locations map[int64]*types.Location
+	// Lots - the lots lines are picked from, only needed for lot tracked products
+	Lots map[int64]*types.Lot
+}
+
+// LocationGroup - the lines picked from one location, Location is nil for stock that
+// isn't held at a location
+type LocationGroup struct {
+	Location *types.Location
+	Lines    []*types.PickListLine
+}
+
+const (
+	// pick lists are printed on A4
+	pickPageWidthMM = 210.0
+	pickMarginMM    = 12.0
+	pickRowMM       = 7.0
+)
+
+// GroupByLocation - groups the lines so a picker can walk the locations in pick sequence, then
+// code order, anything not at a location comes last. Lines within a location are sorted by sku.
+func GroupByLocation(doc PickListDocument) []LocationGroup {
+	byLocation := map[int64]*LocationGroup{}
+	unassigned := &LocationGroup{}
+
+	for _, line := range doc.PickList.Lines {
+		group := unassigned
+		if line.LocationID != nil {
+			var exists bool
+			group, exists = byLocation[*line.LocationID]
+			if !exists {
+				group = &LocationGroup{Location: doc.Locations[*line.LocationID]}
+				if group.Location == nil {
+					group.Location = &types.Location{ID: *line.LocationID, Code: fmt.Sprintf("#%d", *line.LocationID)}
+				}
+				byLocation[*line.LocationID] = group
+			}
+		}
+		group.Lines = append(group.Lines, line)
+	}
+
+	groups := []LocationGroup{}
+	for _, group := range byLocation {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Location.PickSequence != groups[j].Location.PickSequence {
+			return groups[i].Location.PickSequence < groups[j].Location.PickSequence
+		}
+		return groups[i].Location.Code < groups[j].Location.Code
+	})
+	if len(unassigned.Lines) > 0 {
+		groups = append(groups, *unassigned)
+	}
+
+	for _, group := range groups {
+		sort.SliceStable(group.Lines, func(i, j int) bool {
+			return sku(doc, group.Lines[i]) < sku(doc, group.Lines[j])
+		})
+	}
+
+	return groups
+}
+
+// RenderPickList - writes the pick list as a printable pdf grouped by location with a
+// box to tick off each line
+func RenderPickList(w io.Writer, doc PickListDocument) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pickMarginMM, pickMarginMM, pickMarginMM)
+	pdf.SetAutoPageBreak(true, pickMarginMM)
+	pdf.AddPage()
+
+	// the core fonts are cp1252 so names need translating from utf-8
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	contentWidth := pickPageWidthMM - 2*pickMarginMM
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(contentWidth, 9, fmt.Sprintf("Pick list #%d", doc.PickList.ID), "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	header := fmt.Sprintf("Sales order #%d", doc.PickList.SalesOrderID)
+	if doc.Order != nil {
+		header += " - " + doc.Order.CustomerName
+		if doc.Order.Reference != nil {
+			header += " (" + *doc.Order.Reference + ")"
+		}
+	}
+	pdf.CellFormat(contentWidth, 6, tr(header), "", 1, "L", false, 0, "")
+	pdf.CellFormat(contentWidth, 6, "Created "+doc.PickList.CreatedAt.Format("2006-01-02 15:04"), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	// sku, product, qty, picked
+	widths := []float64{40, contentWidth - 40 - 20 - 26, 20, 26}
+
+	for _, group := range GroupByLocation(doc) {
+		title := "Unassigned stock"
+		if group.Location != nil {
+			title = "Location " + group.Location.Code
+			if group.Location.Name != "" {
+				title += " - " + group.Location.Name
+			}
+		}
+
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(contentWidth, 8, tr(title), "B", 1, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "B", 9)
+		for i, heading := range []string{"SKU", "Product", "Qty", "Picked"} {
+			align := "L"
+			if i >= 2 {
+				align = "C"
+			}
+			pdf.CellFormat(widths[i], pickRowMM, heading, "", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+
+		pdf.SetFont("Helvetica", "", 9)
+		for _, line := range group.Lines {
+			name := ""
+			if product := doc.Products[line.ProductID]; product != nil {
+				name = product.Name
+			}
+			if line.LotID != nil {
+				if lot := doc.Lots[*line.LotID]; lot != nil {
+					name += " - lot " + lot.LotNumber
+				} else {
+					name += fmt.Sprintf(" - lot #%d", *line.LotID)
+				}
+			}
+
+			pdf.CellFormat(widths[0], pickRowMM, tr(sku(doc, line)), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(widths[1], pickRowMM, tr(name), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(widths[2], pickRowMM, fmt.Sprintf("%d", line.Qty), "1", 0, "C", false, 0, "")
+			pdf.CellFormat(widths[3], pickRowMM, "", "1", 1, "C", false, 0, "")
+		}
+		pdf.Ln(4)
+	}
+
+	return pdf.Output(w)
+}
+
+func sku(doc PickListDocument, line *types.PickListLine) string {
+	if product := doc.Products[line.ProductID]; product != nil {
+		return product.Sku
+	}
+	return fmt.Sprintf("#%d", line.ProductID)
+}
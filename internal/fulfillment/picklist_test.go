@@ -0,0 +1,82 @@
+package fulfillment_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/fulfillment"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FULFILLMENT: PickList", func() {
+	var doc fulfillment.PickListDocument
+
+	BeforeEach(func() {
+		doc = fulfillment.PickListDocument{
+			PickList: &types.PickList{
+				ID: 1, SalesOrderID: 2, Status: types.PickListStatusOpen, CreatedAt: time.Now(),
+				Lines: []*types.PickListLine{
+					{ID: 1, ProductID: 1, LocationID: utils.Ref[int64](20), Qty: 3},
+					{ID: 2, ProductID: 2, Qty: 1},
+					{ID: 3, ProductID: 2, LocationID: utils.Ref[int64](10), Qty: 4},
+					{ID: 4, ProductID: 1, LocationID: utils.Ref[int64](10), Qty: 2},
+				},
+			},
+			Order: &types.SalesOrder{ID: 2, CustomerName: "Jane Doe"},
+			Products: map[int64]*types.Product{
+				1: {ID: 1, Name: "Water Filter", Sku: "WF-100"},
+				2: {ID: 2, Name: "Filter Housing", Sku: "FH-200"},
+			},
+			Locations: map[int64]*types.Location{
+				10: {ID: 10, Name: "Aisle A", Code: "A-01"},
+				20: {ID: 20, Name: "Aisle B", Code: "B-01"},
+			},
+		}
+	})
+
+	Context("GroupByLocation", func() {
+		It("should group by location code with unassigned stock last", func() {
+			groups := fulfillment.GroupByLocation(doc)
+			Expect(groups).To(HaveLen(3))
+
+			Expect(groups[0].Location.Code).To(Equal("A-01"))
+			Expect(groups[0].Lines).To(HaveLen(2))
+			// sorted by sku within the location
+			Expect(groups[0].Lines[0].ID).To(BeNumerically("==", 3))
+			Expect(groups[0].Lines[1].ID).To(BeNumerically("==", 4))
+
+			Expect(groups[1].Location.Code).To(Equal("B-01"))
+			Expect(groups[2].Location).To(BeNil())
+			Expect(groups[2].Lines[0].ID).To(BeNumerically("==", 2))
+		})
+
+		It("should walk the locations in pick sequence before code", func() {
+			doc.Locations[10].PickSequence = 2
+			doc.Locations[20].PickSequence = 1
+
+			groups := fulfillment.GroupByLocation(doc)
+			Expect(groups).To(HaveLen(3))
+			Expect(groups[0].Location.Code).To(Equal("B-01"))
+			Expect(groups[1].Location.Code).To(Equal("A-01"))
+		})
+
+		It("should still group lines at a location it doesn't know about", func() {
+			delete(doc.Locations, 20)
+
+			groups := fulfillment.GroupByLocation(doc)
+			Expect(groups).To(HaveLen(3))
+			Expect(groups[0].Location.Code).To(Equal("#20"))
+		})
+	})
+
+	Context("RenderPickList", func() {
+		It("should render a pdf", func() {
+			buf := new(bytes.Buffer)
+			Expect(fulfillment.RenderPickList(buf, doc)).To(Succeed())
+			Expect(buf.String()).To(HavePrefix("%PDF"))
+		})
+	})
+})
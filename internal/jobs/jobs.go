@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inconshreveable/log15"
+)
+
+var logger = log15.New("jobs")
+
+// TimeOfDay - when a daily job runs, in the server's time zone
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// ParseTimeOfDay - a 24 hour time like 23:55
+func ParseTimeOfDay(raw string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", raw)
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q, it should look like 23:55", raw)
+	}
+
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+// Next - the first time after now that it's at o'clock, in now's time zone
+func Next(now time.Time, at TimeOfDay) time.Time {
+	y, m, d := now.Date()
+	next := time.Date(y, m, d, at.Hour, at.Minute, 0, 0, now.Location())
+	if !next.After(now) {
+		next = time.Date(y, m, d+1, at.Hour, at.Minute, 0, 0, now.Location())
+	}
+
+	return next
+}
+
+// Daily - runs fn every day at the time of day until ctx is done. A run that fails is logged and
+// the job carries on the next day.
+func Daily(ctx context.Context, name string, at TimeOfDay, fn func(ctx context.Context) error) {
+	for {
+		timer := time.NewTimer(time.Until(Next(time.Now(), at)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		started := time.Now()
+		if err := fn(ctx); err != nil {
+			logger.Error("job failed", log15.Ctx{"job": name, "err": err})
+			continue
+		}
+		logger.Info("job finished", log15.Ctx{"job": name, "took": time.Since(started).String()})
+	}
+}
@@ -0,0 +1,58 @@
+package jobs_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/jobs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Jobs", func() {
+	Context("ParseTimeOfDay", func() {
+		It("should parse a 24 hour time", func() {
+			at, err := jobs.ParseTimeOfDay("23:55")
+			Expect(err).To(BeNil())
+			Expect(at).To(Equal(jobs.TimeOfDay{Hour: 23, Minute: 55}))
+		})
+
+		It("should reject anything else", func() {
+			for _, raw := range []string{"", "midnight", "25:00", "11:55pm"} {
+				_, err := jobs.ParseTimeOfDay(raw)
+				Expect(err).NotTo(BeNil(), raw)
+			}
+		})
+	})
+
+	Context("Next", func() {
+		at := jobs.TimeOfDay{Hour: 23, Minute: 55}
+
+		It("should run later today", func() {
+			now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+			Expect(jobs.Next(now, at)).To(Equal(time.Date(2026, 10, 19, 23, 55, 0, 0, time.UTC)))
+		})
+
+		It("should run tomorrow once today's time has passed", func() {
+			now := time.Date(2026, 10, 31, 23, 55, 0, 0, time.UTC)
+			Expect(jobs.Next(now, at)).To(Equal(time.Date(2026, 11, 1, 23, 55, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("Daily", func() {
+		It("should stop once the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				jobs.Daily(ctx, "test", jobs.TimeOfDay{}, func(context.Context) error {
+					Fail("the job should not have run")
+					return nil
+				})
+				close(done)
+			}()
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
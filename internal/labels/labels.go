@@ -0,0 +1,238 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/qr"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"github.com/jung-kurt/gofpdf"
+)
+
+type Symbology string
+
+const (
+	SymbologyCode128 Symbology = "code128"
+	SymbologyEAN13   Symbology = "ean13"
+	SymbologyQR      Symbology = "qr"
+)
+
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatPDF Format = "pdf"
+	FormatZPL Format = "zpl"
+)
+
+// ContentType - the mime type to send a rendered label back with
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPNG:
+		return "image/png"
+	case FormatPDF:
+		return "application/pdf"
+	case FormatZPL:
+		return "application/zpl"
+	}
+	return "application/octet-stream"
+}
+
+// Label - everything that gets printed on a shelf label
+type Label struct {
+	Name      string
+	Sku       string
+	Data      string
+	Symbology Symbology
+}
+
+const (
+	// labels are printed on 4x2 inch stock
+	pageWidthMM  = 101.6
+	pageHeightMM = 50.8
+	marginMM     = 4.0
+	// the same 4x2 inch stock at 203 dpi, the most common thermal printer resolution
+	zplWidthDots  = 812
+	zplHeightDots = 406
+	zplMarginDots = 32
+	zplBarHeight  = 160
+	// white space scanners need around a barcode to find its edges
+	pngQuietZone = 20
+)
+
+// Encode - builds the barcode for the given symbology scaled to roughly width x height pixels
+func Encode(sym Symbology, data string, width, height int) (barcode.Barcode, error) {
+	var (
+		bc  barcode.Barcode
+		err error
+	)
+
+	switch sym {
+	case SymbologyCode128:
+		bc, err = code128.Encode(data)
+	case SymbologyEAN13:
+		if len(data) != 12 && len(data) != 13 {
+			return nil, types.NewBadRequestError("ean13 barcodes must be 12 or 13 digits")
+		}
+		if len(data) == 13 && !types.IsValidGTIN(data) {
+			return nil, types.NewBadRequestError("ean13 barcode has an invalid check digit")
+		}
+		bc, err = ean.Encode(data)
+	case SymbologyQR:
+		bc, err = qr.Encode(data, qr.M, qr.Auto)
+	default:
+		return nil, types.NewBadRequestError(fmt.Sprintf("unsupported symbology '%s'", sym))
+	}
+	if err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	// barcodes can only be scaled up and 1D codes should be scaled by whole modules
+	// so the bars stay crisp
+	bounds := bc.Bounds()
+	if width < bounds.Dx() {
+		width = bounds.Dx()
+	}
+	width -= width % bounds.Dx()
+
+	if sym == SymbologyQR {
+		height = width
+	} else if height < 1 {
+		height = 1
+	}
+
+	return barcode.Scale(bc, width, height)
+}
+
+// Render - writes the label out in the requested format
+func Render(w io.Writer, format Format, l Label) error {
+	switch format {
+	case FormatPNG:
+		return RenderPNG(w, l)
+	case FormatPDF:
+		return RenderPDF(w, l)
+	case FormatZPL:
+		return RenderZPL(w, l)
+	}
+	return types.NewBadRequestError(fmt.Sprintf("unsupported format '%s'", format))
+}
+
+// RenderPNG - writes just the barcode image
+func RenderPNG(w io.Writer, l Label) error {
+	height := 150
+	if l.Symbology == SymbologyQR {
+		height = 0
+	}
+
+	bc, err := Encode(l.Symbology, l.Data, 300, height)
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, toGray(bc, pngQuietZone))
+}
+
+// RenderPDF - writes a single page 4x2 inch label with the name, sku and barcode
+func RenderPDF(w io.Writer, l Label) error {
+	bc, err := Encode(l.Symbology, l.Data, 600, 200)
+	if err != nil {
+		return err
+	}
+
+	img := new(bytes.Buffer)
+	if err := png.Encode(img, toGray(bc, 0)); err != nil {
+		return err
+	}
+
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		OrientationStr: "L",
+		UnitStr:        "mm",
+		Size:           gofpdf.SizeType{Wd: pageWidthMM, Ht: pageHeightMM},
+	})
+	pdf.SetMargins(marginMM, marginMM, marginMM)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	// the core fonts are cp1252 so product names need translating from utf-8
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	contentWidth := pageWidthMM - 2*marginMM
+
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(contentWidth, 6, tr(l.Name), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(contentWidth, 5, tr("SKU: "+l.Sku), "", 1, "L", false, 0, "")
+
+	opts := gofpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader("barcode", opts, img)
+
+	top := marginMM + 13
+	available := pageHeightMM - top - marginMM
+	if l.Symbology == SymbologyQR {
+		pdf.ImageOptions("barcode", marginMM, top, available, available, false, opts, 0, "")
+	} else {
+		pdf.ImageOptions("barcode", marginMM, top, contentWidth, available-4, false, opts, 0, "")
+		pdf.SetFont("Courier", "", 8)
+		pdf.SetXY(marginMM, pageHeightMM-marginMM-4)
+		pdf.CellFormat(contentWidth, 4, l.Data, "", 0, "C", false, 0, "")
+	}
+
+	return pdf.Output(w)
+}
+
+// RenderZPL - writes the label as ZPL II for Zebra style thermal printers
+func RenderZPL(w io.Writer, l Label) error {
+	var code string
+	switch l.Symbology {
+	case SymbologyCode128:
+		code = fmt.Sprintf("^BY2^BCN,%d,Y,N,N^FH^FD%s^FS", zplBarHeight, zplEscape(l.Data))
+	case SymbologyEAN13:
+		if _, err := Encode(l.Symbology, l.Data, 0, 0); err != nil {
+			return err
+		}
+		// the printer calculates the check digit itself
+		code = fmt.Sprintf("^BY2^BEN,%d,Y,N^FD%s^FS", zplBarHeight, l.Data[:12])
+	case SymbologyQR:
+		code = fmt.Sprintf("^BQN,2,6^FH^FDMA,%s^FS", zplEscape(l.Data))
+	default:
+		return types.NewBadRequestError(fmt.Sprintf("unsupported symbology '%s'", l.Symbology))
+	}
+
+	margin := zplMarginDots
+	zpl := strings.Join([]string{
+		"^XA",
+		"^CI28",
+		fmt.Sprintf("^PW%d", zplWidthDots),
+		fmt.Sprintf("^LL%d", zplHeightDots),
+		fmt.Sprintf("^FO%d,%d^A0N,32,32^FH^FD%s^FS", margin, margin, zplEscape(l.Name)),
+		fmt.Sprintf("^FO%d,%d^A0N,24,24^FH^FDSKU: %s^FS", margin, margin+40, zplEscape(l.Sku)),
+		fmt.Sprintf("^FO%d,%d%s", margin, margin+80, code),
+		"^XZ",
+	}, "\n")
+
+	_, err := io.WriteString(w, zpl+"\n")
+	return err
+}
+
+// zplEscape - hex encodes the characters that ZPL treats as commands so they
+// print literally inside a ^FH field
+func zplEscape(s string) string {
+	return strings.NewReplacer("_", "_5F", "^", "_5E", "~", "_7E").Replace(s)
+}
+
+// toGray - barcodes use a 16 bit color model which makes for large png files
+// that the pdf writer can not embed, 8 bit gray is plenty for black and white.
+// A white border of pad pixels is added around the code.
+func toGray(src image.Image, pad int) *image.Gray {
+	dst := image.NewGray(image.Rect(0, 0, src.Bounds().Dx()+2*pad, src.Bounds().Dy()+2*pad))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds().Inset(pad), src, src.Bounds().Min, draw.Src)
+	return dst
+}
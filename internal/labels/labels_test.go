@@ -0,0 +1,92 @@
+package labels_test
+
+import (
+	"bytes"
+	"image/png"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/labels"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LABELS", func() {
+	var label labels.Label
+
+	BeforeEach(func() {
+		label = labels.Label{
+			Name: "Water Filter", Sku: "WF-100", Data: "WF-100", Symbology: labels.SymbologyCode128,
+		}
+	})
+
+	Context("Encode", func() {
+		It("should reject unknown symbologies", func() {
+			_, err := labels.Encode("bogus", "123", 100, 100)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should reject ean13 codes that are not 12 or 13 digits", func() {
+			_, err := labels.Encode(labels.SymbologyEAN13, "WF-100", 100, 100)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should reject ean13 codes with a bad check digit", func() {
+			_, err := labels.Encode(labels.SymbologyEAN13, "4006381333932", 100, 100)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should make qr codes square", func() {
+			bc, err := labels.Encode(labels.SymbologyQR, "WF-100", 300, 0)
+			Expect(err).To(BeNil())
+			Expect(bc.Bounds().Dx()).To(Equal(bc.Bounds().Dy()))
+		})
+	})
+
+	Context("Render", func() {
+		It("should render a png for each symbology", func() {
+			for _, l := range []labels.Label{
+				label,
+				{Name: "x", Sku: "x", Data: "4006381333931", Symbology: labels.SymbologyEAN13},
+				{Name: "x", Sku: "x", Data: "WF-100", Symbology: labels.SymbologyQR},
+			} {
+				buf := new(bytes.Buffer)
+				Expect(labels.Render(buf, labels.FormatPNG, l)).To(Succeed())
+
+				img, err := png.Decode(buf)
+				Expect(err).To(BeNil())
+				Expect(img.Bounds().Dx()).To(BeNumerically(">", 0))
+			}
+		})
+
+		It("should render a pdf", func() {
+			buf := new(bytes.Buffer)
+			Expect(labels.Render(buf, labels.FormatPDF, label)).To(Succeed())
+			Expect(buf.String()).To(HavePrefix("%PDF"))
+		})
+
+		It("should render zpl with the name, sku and barcode", func() {
+			buf := new(bytes.Buffer)
+			Expect(labels.Render(buf, labels.FormatZPL, label)).To(Succeed())
+
+			zpl := buf.String()
+			Expect(zpl).To(HavePrefix("^XA"))
+			Expect(zpl).To(ContainSubstring("^FDWater Filter^FS"))
+			Expect(zpl).To(ContainSubstring("^FDSKU: WF-100^FS"))
+			Expect(zpl).To(ContainSubstring("^BCN"))
+			Expect(zpl).To(HaveSuffix("^XZ\n"))
+		})
+
+		It("should escape zpl control characters", func() {
+			label.Name = "Caret^Tilde~Under_score"
+
+			buf := new(bytes.Buffer)
+			Expect(labels.Render(buf, labels.FormatZPL, label)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("^FDCaret_5ETilde_7EUnder_5Fscore^FS"))
+		})
+
+		It("should reject unknown formats", func() {
+			err := labels.Render(new(bytes.Buffer), "bogus", label)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+})
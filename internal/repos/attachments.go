@@ -0,0 +1,177 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type AttachmentsFind struct {
+	Limit      int
+	Offset     int
+	IDs        []int64
+	ProductIDs []int64
+}
+
+//go:generate mockgen -source=./attachments.go -destination=./mocks/Attachments.go -package=mock_repos Attachments
+type Attachments interface {
+	Find(ctx context.Context, opts *AttachmentsFind) ([]*types.Attachment, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *AttachmentsFind) ([]*types.Attachment, int64, error)
+	Get(ctx context.Context, id int64) (*types.Attachment, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Attachment, bool, error)
+	Create(ctx context.Context, newAttachment types.NewAttachment) (*types.Attachment, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newAttachment types.NewAttachment) (*types.Attachment, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+}
+
+func NewAttachments(db *xorm.Engine) Attachments {
+	return &attachmentsRepo{db}
+}
+
+type attachmentsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *attachmentsRepo) Find(ctx context.Context, opts *AttachmentsFind) ([]*types.Attachment, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		a, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return a, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Attachment), count, nil
+}
+
+func (r *attachmentsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *AttachmentsFind) ([]*types.Attachment, int64, error) {
+	if opts == nil {
+		opts = &AttachmentsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	objs := []*types.Attachment{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("product_attachments", err)
+	}
+
+	for _, obj := range objs {
+		obj.SetURLs()
+	}
+
+	return objs, count, nil
+}
+
+func (r *attachmentsRepo) Get(ctx context.Context, id int64) (*types.Attachment, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		a, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return a, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Attachment), exists, nil
+}
+
+func (r *attachmentsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Attachment, bool, error) {
+	obj := &types.Attachment{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("product_attachments", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj.SetURLs(), exists, nil
+}
+
+func (r *attachmentsRepo) Create(ctx context.Context, newAttachment types.NewAttachment) (*types.Attachment, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newAttachment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Attachment), nil
+}
+
+func (r *attachmentsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newAttachment types.NewAttachment) (*types.Attachment, error) {
+	if err := types.Validate(newAttachment); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	exists, err := tx.Table("products").Where("id = ?", newAttachment.ProductID).Exist()
+	if err != nil {
+		return nil, normalizeErr("product_attachments", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	obj := &types.Attachment{
+		ProductID:    newAttachment.ProductID,
+		FileName:     newAttachment.FileName,
+		ContentType:  newAttachment.ContentType,
+		Size:         newAttachment.Size,
+		StorageKey:   newAttachment.StorageKey,
+		ThumbnailKey: newAttachment.ThumbnailKey,
+		CreatedAt:    time.Now(),
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("product_attachments", err)
+	}
+
+	return obj.SetURLs(), nil
+}
+
+func (r *attachmentsRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+func (r *attachmentsRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	count, err := tx.Where("id = ?", id).Delete(&types.Attachment{})
+	if err != nil {
+		return normalizeErr("product_attachments", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("attachment not found")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package repos_test
+
+import (
+	"fmt"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Attachments", func() {
+
+	var (
+		repo    repos.Attachments
+		product *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("products", "product_attachments")
+
+		repo = gr.Attachments()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 50})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should fail with invalid attachments", func() {
+			_, err := repo.Create(ctx, types.NewAttachment{})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewAttachment{
+				ProductID: 99999999, FileName: "a.pdf", ContentType: "application/pdf", StorageKey: "a",
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should successfully create an attachment", func() {
+			attachment, err := repo.Create(ctx, types.NewAttachment{
+				ProductID: product.ID, FileName: "photo.png", ContentType: "image/png", Size: 100,
+				StorageKey: "products/1/photo", ThumbnailKey: utils.Ref("products/1/photo-thumb"),
+			})
+			Expect(err).To(BeNil())
+			Expect(attachment.ID).To(BeNumerically(">", 0))
+			Expect(attachment.DownloadURL).To(Equal(fmt.Sprintf("/v1/attachments/%d/download", attachment.ID)))
+			Expect(attachment.ThumbnailURL).NotTo(BeNil())
+		})
+	})
+
+	Context("attachment data creation", func() {
+		var ids []int64
+		BeforeEach(func() {
+			ids = []int64{}
+			for i := 0; i < 3; i++ {
+				attachment, err := repo.Create(ctx, types.NewAttachment{
+					ProductID: product.ID, FileName: fmt.Sprintf("spec-%d.pdf", i), ContentType: "application/pdf",
+					Size: 100, StorageKey: fmt.Sprintf("products/%d/spec-%d", product.ID, i),
+				})
+				Expect(err).To(BeNil())
+				ids = append(ids, attachment.ID)
+			}
+		})
+
+		Context("Find(Tx)", func() {
+			It("should return the attachments for a product", func() {
+				attachments, count, err := repo.Find(ctx, &repos.AttachmentsFind{ProductIDs: []int64{product.ID}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 3))
+				Expect(attachments[0].ID).To(Equal(ids[0]))
+				Expect(attachments[0].DownloadURL).NotTo(BeEmpty())
+				Expect(attachments[0].ThumbnailURL).To(BeNil())
+			})
+		})
+
+		Context("Get(Tx)", func() {
+			It("should not find an invalid attachment without an err", func() {
+				attachment, exists, err := repo.Get(ctx, 99999999)
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeFalse())
+				Expect(attachment).To(BeNil())
+			})
+
+			It("should find a specific attachment", func() {
+				attachment, exists, err := repo.Get(ctx, ids[1])
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeTrue())
+				Expect(attachment.FileName).To(Equal("spec-1.pdf"))
+			})
+		})
+
+		Context("Destroy(Tx)", func() {
+			It("should return an error when the attachment does not exist", func() {
+				Expect(repo.Destroy(ctx, 99999999)).NotTo(Succeed())
+			})
+
+			It("should successfully delete an attachment", func() {
+				Expect(repo.Destroy(ctx, ids[0])).To(Succeed())
+
+				_, count, err := repo.Find(ctx, &repos.AttachmentsFind{ProductIDs: []int64{product.ID}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 2))
+			})
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type BarcodesFind struct {
+	Limit      int
+	Offset     int
+	ProductIDs []int64
+	Codes      []string
+}
+
+//go:generate mockgen -source=./barcodes.go -destination=./mocks/Barcodes.go -package=mock_repos Barcodes
+type Barcodes interface {
+	Find(ctx context.Context, opts *BarcodesFind) ([]*types.Barcode, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *BarcodesFind) ([]*types.Barcode, int64, error)
+	Get(ctx context.Context, code string) (*types.Barcode, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, code string) (*types.Barcode, bool, error)
+	Create(ctx context.Context, newBarcode types.NewBarcode) (*types.Barcode, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newBarcode types.NewBarcode) (*types.Barcode, error)
+	Destroy(ctx context.Context, code string) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, code string) error
+}
+
+func NewBarcodes(db *xorm.Engine) Barcodes {
+	return &barcodesRepo{db}
+}
+
+type barcodesRepo struct {
+	db *xorm.Engine
+}
+
+func (r *barcodesRepo) Find(ctx context.Context, opts *BarcodesFind) ([]*types.Barcode, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		b, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return b, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Barcode), count, nil
+}
+
+func (r *barcodesRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *BarcodesFind) ([]*types.Barcode, int64, error) {
+	if opts == nil {
+		opts = &BarcodesFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.Codes) > 0 {
+		gtins := []interface{}{}
+		for _, code := range opts.Codes {
+			gtins = append(gtins, types.NormalizeGTIN(code))
+		}
+		tx = tx.In("gtin", gtins...)
+	}
+
+	objs := []*types.Barcode{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("product_barcodes", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *barcodesRepo) Get(ctx context.Context, code string) (*types.Barcode, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		b, ex, e := r.GetTx(ctx, tx, code)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return b, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Barcode), exists, nil
+}
+
+// GetTx - looks a barcode up by any of its GTIN representations
+func (r *barcodesRepo) GetTx(ctx context.Context, tx *xorm.Session, code string) (*types.Barcode, bool, error) {
+	obj := &types.Barcode{}
+	exists, err := tx.Where("gtin = ?", types.NormalizeGTIN(code)).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("product_barcodes", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *barcodesRepo) Create(ctx context.Context, newBarcode types.NewBarcode) (*types.Barcode, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newBarcode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Barcode), nil
+}
+
+func (r *barcodesRepo) CreateTx(ctx context.Context, tx *xorm.Session, newBarcode types.NewBarcode) (*types.Barcode, error) {
+	if err := types.Validate(newBarcode); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if len(newBarcode.Code) != newBarcode.Type.Length() {
+		return nil, types.NewBadRequestError("barcode length does not match its type")
+	}
+
+	exists, err := tx.Table("products").Where("id = ?", newBarcode.ProductID).Exist()
+	if err != nil {
+		return nil, normalizeErr("product_barcodes", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	obj := &types.Barcode{
+		ProductID: newBarcode.ProductID,
+		Code:      newBarcode.Code,
+		Gtin:      types.NormalizeGTIN(newBarcode.Code),
+		Type:      newBarcode.Type,
+		PackSize:  newBarcode.PackSize,
+		CreatedAt: time.Now(),
+	}
+
+	if obj.PackSize == 0 {
+		obj.PackSize = 1
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("product_barcodes", err)
+	}
+
+	return obj, nil
+}
+
+func (r *barcodesRepo) Destroy(ctx context.Context, code string) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, code)
+	})
+	return err
+}
+
+func (r *barcodesRepo) DestroyTx(ctx context.Context, tx *xorm.Session, code string) error {
+	count, err := tx.Where("gtin = ?", types.NormalizeGTIN(code)).Delete(&types.Barcode{})
+	if err != nil {
+		return normalizeErr("product_barcodes", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("barcode not found")
+	}
+	return nil
+}
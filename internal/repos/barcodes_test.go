@@ -0,0 +1,133 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Barcodes", func() {
+
+	var (
+		repo    repos.Barcodes
+		product *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("products", "product_barcodes")
+
+		repo = gr.Barcodes()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 50})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should fail with invalid barcodes", func() {
+			_, err := repo.Create(ctx, types.NewBarcode{})
+			Expect(err).NotTo(BeNil())
+
+			// bad check digit
+			_, err = repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "4006381333932", Type: types.BarcodeTypeEAN13,
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			// valid code but the wrong type
+			_, err = repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "4006381333931", Type: types.BarcodeTypeUPCA,
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewBarcode{
+				ProductID: 99999999, Code: "4006381333931", Type: types.BarcodeTypeEAN13,
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should successfully create a barcode", func() {
+			barcode, err := repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "036000291452", Type: types.BarcodeTypeUPCA,
+			})
+			Expect(err).To(BeNil())
+			Expect(barcode.ID).To(BeNumerically(">", 0))
+			Expect(barcode.Gtin).To(Equal("00036000291452"))
+			Expect(barcode.PackSize).To(BeNumerically("==", 1))
+		})
+
+		It("should not allow the same GTIN in a different representation", func() {
+			_, err := repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "036000291452", Type: types.BarcodeTypeUPCA,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "0036000291452", Type: types.BarcodeTypeEAN13,
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("barcode data creation", func() {
+		BeforeEach(func() {
+			_, err := repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "036000291452", Type: types.BarcodeTypeUPCA,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewBarcode{
+				ProductID: product.ID, Code: "10036000291459", Type: types.BarcodeTypeGTIN14, PackSize: 12,
+			})
+			Expect(err).To(BeNil())
+		})
+
+		Context("Find(Tx)", func() {
+			It("should return the barcodes for a product", func() {
+				barcodes, count, err := repo.Find(ctx, &repos.BarcodesFind{ProductIDs: []int64{product.ID}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 2))
+				Expect(barcodes).To(HaveLen(2))
+			})
+		})
+
+		Context("Get(Tx)", func() {
+			It("should not find an unknown barcode without an err", func() {
+				barcode, exists, err := repo.Get(ctx, "4006381333931")
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeFalse())
+				Expect(barcode).To(BeNil())
+			})
+
+			It("should find a barcode by any representation", func() {
+				barcode, exists, err := repo.Get(ctx, "0036000291452")
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeTrue())
+				Expect(barcode.Code).To(Equal("036000291452"))
+			})
+
+			It("should return the pack size", func() {
+				barcode, exists, err := repo.Get(ctx, "10036000291459")
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeTrue())
+				Expect(barcode.PackSize).To(BeNumerically("==", 12))
+			})
+		})
+
+		Context("Destroy(Tx)", func() {
+			It("should return an error when the barcode does not exist", func() {
+				Expect(repo.Destroy(ctx, "4006381333931")).NotTo(Succeed())
+			})
+
+			It("should successfully delete a barcode", func() {
+				Expect(repo.Destroy(ctx, "036000291452")).To(Succeed())
+
+				_, exists, err := repo.Get(ctx, "036000291452")
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,135 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+//go:generate mockgen -source=./boms.go -destination=./mocks/Boms.go -package=mock_repos Boms
+type Boms interface {
+	Get(ctx context.Context, productID int64) ([]*types.BomLine, error)
+	GetTx(ctx context.Context, tx *xorm.Session, productID int64) ([]*types.BomLine, error)
+	Set(ctx context.Context, set *types.SetBom) ([]*types.BomLine, error)
+	SetTx(ctx context.Context, tx *xorm.Session, set *types.SetBom) ([]*types.BomLine, error)
+}
+
+// NewBoms - bills of materials for products made on work orders, kits keep their own
+func NewBoms(db *xorm.Engine) Boms {
+	return &bomsRepo{db}
+}
+
+type bomsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *bomsRepo) Get(ctx context.Context, productID int64) ([]*types.BomLine, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.GetTx(ctx, tx, productID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.BomLine), nil
+}
+
+// GetTx - a product without a bill of materials has no lines, unknown products are not found
+func (r *bomsRepo) GetTx(ctx context.Context, tx *xorm.Session, productID int64) ([]*types.BomLine, error) {
+	exists, err := tx.Table("products").Where("id = ?", productID).Exist()
+	if err != nil {
+		return nil, normalizeErr("products", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	objs := []*types.BomLine{}
+	if err := tx.Where("product_id = ?", productID).OrderBy("id").Find(&objs); err != nil {
+		return nil, normalizeErr("bom_lines", err)
+	}
+
+	return objs, nil
+}
+
+func (r *bomsRepo) Set(ctx context.Context, set *types.SetBom) ([]*types.BomLine, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.SetTx(ctx, tx, set)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.BomLine), nil
+}
+
+// SetTx - replaces the product's bill of materials. Kits and lot tracked or serialized products
+// can't be made on work orders or go into them, a product can't go into itself and each
+// component is only listed once.
+func (r *bomsRepo) SetTx(ctx context.Context, tx *xorm.Session, set *types.SetBom) ([]*types.BomLine, error) {
+	if err := types.Validate(set); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	product := &types.Product{}
+	exists, err := tx.Where("id = ?", set.ProductID).ForUpdate().Get(product)
+	if err != nil {
+		return nil, normalizeErr("products", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	if product.Kit {
+		return nil, types.NewBadRequestError("kits are built from their kit components, not a bill of materials")
+	}
+	if product.LotTracked || product.Serialized {
+		return nil, types.NewBadRequestError("lot tracked and serialized products can not be made on work orders")
+	}
+
+	if _, err := tx.Where("product_id = ?", product.ID).Delete(&types.BomLine{}); err != nil {
+		return nil, normalizeErr("bom_lines", err)
+	}
+
+	objs := []*types.BomLine{}
+	seen := map[int64]bool{}
+	for _, nl := range set.Lines {
+		if nl.ComponentID == product.ID {
+			return nil, types.NewBadRequestError("a product can not be a component of itself")
+		}
+		if seen[nl.ComponentID] {
+			return nil, types.NewBadRequestError("a component can only be on a bill of materials once")
+		}
+		seen[nl.ComponentID] = true
+
+		component := &types.Product{}
+		exists, err := tx.Where("id = ?", nl.ComponentID).Get(component)
+		if err != nil {
+			return nil, normalizeErr("products", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("component product not found by id")
+		}
+
+		if component.Kit || component.LotTracked || component.Serialized {
+			return nil, types.NewBadRequestError("kits, lot tracked and serialized products can not be components")
+		}
+
+		obj := &types.BomLine{
+			ProductID:   product.ID,
+			ComponentID: nl.ComponentID,
+			Qty:         nl.Qty,
+			CreatedAt:   time.Now(),
+		}
+
+		if _, err := tx.Insert(obj); err != nil {
+			return nil, normalizeErr("bom_lines", err)
+		}
+
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,69 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Boms", func() {
+
+	var (
+		repo     repos.Boms
+		finished *types.Product
+		part     *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("bom_lines", "kit_components", "products")
+
+		repo = gr.Boms()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		finished, err = gr.Products().Create(ctx, types.NewProduct{Name: "lamp", Sku: "lamp", Qty: 1})
+		Expect(err).To(BeNil())
+		part, err = gr.Products().Create(ctx, types.NewProduct{Name: "bulb", Sku: "bulb", Qty: 10})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Set(Tx)", func() {
+		It("should replace the bill of materials", func() {
+			lines, err := repo.Get(ctx, finished.ID)
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(0))
+
+			_, err = repo.Set(ctx, &types.SetBom{ProductID: finished.ID, Lines: []types.NewBomLine{{ComponentID: part.ID, Qty: 1}}})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Set(ctx, &types.SetBom{ProductID: finished.ID, Lines: []types.NewBomLine{{ComponentID: part.ID, Qty: 2}}})
+			Expect(err).To(BeNil())
+
+			lines, err = repo.Get(ctx, finished.ID)
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0].Qty).To(BeNumerically("==", 2))
+		})
+
+		It("should refuse bad bills of materials", func() {
+			_, err := repo.Set(ctx, &types.SetBom{ProductID: finished.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Set(ctx, &types.SetBom{ProductID: 99999999, Lines: []types.NewBomLine{{ComponentID: part.ID, Qty: 1}}})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Set(ctx, &types.SetBom{ProductID: finished.ID, Lines: []types.NewBomLine{{ComponentID: finished.ID, Qty: 1}}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Set(ctx, &types.SetBom{ProductID: finished.ID, Lines: []types.NewBomLine{{ComponentID: part.ID, Qty: 1}, {ComponentID: part.ID, Qty: 1}}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			kit, err := gr.Kits().Create(ctx, types.NewKit{Name: "kit", Sku: "kit", Components: []types.NewKitComponent{{ComponentID: part.ID, Qty: 1}}})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Set(ctx, &types.SetBom{ProductID: kit.Product.ID, Lines: []types.NewBomLine{{ComponentID: part.ID, Qty: 1}}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,618 @@
+package repos
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type CountSessionsFind struct {
+	Limit    int
+	Offset   int
+	IDs      []int64
+	Statuses []types.CountSessionStatus
+}
+
+//go:generate mockgen -source=./countSessions.go -destination=./mocks/CountSessions.go -package=mock_repos CountSessions
+type CountSessions interface {
+	Find(ctx context.Context, opts *CountSessionsFind) ([]*types.CountSession, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *CountSessionsFind) ([]*types.CountSession, int64, error)
+	Get(ctx context.Context, id int64) (*types.CountSession, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, bool, error)
+	Create(ctx context.Context, newSession types.NewCountSession) (*types.CountSession, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newSession types.NewCountSession) (*types.CountSession, error)
+	Count(ctx context.Context, count *types.CountCountSession) (*types.CountSession, error)
+	CountTx(ctx context.Context, tx *xorm.Session, count *types.CountCountSession) (*types.CountSession, error)
+	Submit(ctx context.Context, submit *types.SubmitCountSession) (*types.CountSession, error)
+	SubmitTx(ctx context.Context, tx *xorm.Session, submit *types.SubmitCountSession) (*types.CountSession, error)
+	Reopen(ctx context.Context, id int64) (*types.CountSession, error)
+	ReopenTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, error)
+	Post(ctx context.Context, post *types.PostCountSession) (*types.CountSession, error)
+	PostTx(ctx context.Context, tx *xorm.Session, post *types.PostCountSession) (*types.CountSession, error)
+	Cancel(ctx context.Context, id int64) (*types.CountSession, error)
+	CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, error)
+}
+
+// NewCountSessions - approved variances are posted through stock levels and recorded on the
+// stock movement ledger in the same transaction, barcodes resolves scanned codes
+func NewCountSessions(db *xorm.Engine, stockLevels StockLevels, stockMovements StockMovements, barcodes Barcodes) CountSessions {
+	return &countSessionsRepo{db, stockLevels, stockMovements, barcodes}
+}
+
+type countSessionsRepo struct {
+	db             *xorm.Engine
+	stockLevels    StockLevels
+	stockMovements StockMovements
+	barcodes       Barcodes
+}
+
+// countSessionReference - the reference type count sessions write to the stock movement ledger
+var countSessionReference = "count_session"
+
+func (r *countSessionsRepo) Find(ctx context.Context, opts *CountSessionsFind) ([]*types.CountSession, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return s, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.CountSession), count, nil
+}
+
+func (r *countSessionsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *CountSessionsFind) ([]*types.CountSession, int64, error) {
+	if opts == nil {
+		opts = &CountSessionsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.CountSession{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("count_sessions", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *countSessionsRepo) Get(ctx context.Context, id int64) (*types.CountSession, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return s, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.CountSession), exists, nil
+}
+
+func (r *countSessionsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, bool, error) {
+	obj := &types.CountSession{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("count_sessions", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *countSessionsRepo) Create(ctx context.Context, newSession types.NewCountSession) (*types.CountSession, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newSession)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.CountSession), nil
+}
+
+// CreateTx - opens a session with a line for every product and location being counted, each
+// with the stock level at that moment as its expected quantity
+func (r *countSessionsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newSession types.NewCountSession) (*types.CountSession, error) {
+	if err := types.Validate(newSession); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	for _, locationID := range newSession.LocationIDs {
+		exists, err := tx.Table("locations").Where("id = ?", locationID).Exist()
+		if err != nil {
+			return nil, normalizeErr("count_sessions", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("location not found by id")
+		}
+	}
+
+	for _, productID := range newSession.ProductIDs {
+		exists, err := tx.Table("products").Where("id = ?", productID).Exist()
+		if err != nil {
+			return nil, normalizeErr("count_sessions", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("product not found by id")
+		}
+	}
+
+	levels, _, err := r.stockLevels.FindTx(ctx, tx, &StockLevelsFind{
+		ProductIDs: newSession.ProductIDs, LocationIDs: newSession.LocationIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct{ productID, locationID int64 }
+	expected := map[key]int64{}
+	for _, level := range levels {
+		expected[key{level.ProductID, level.LocationID}] = level.Qty
+	}
+
+	// listed products are counted at every location, even where nothing is expected
+	for _, productID := range newSession.ProductIDs {
+		for _, locationID := range newSession.LocationIDs {
+			if _, exists := expected[key{productID, locationID}]; !exists {
+				expected[key{productID, locationID}] = 0
+			}
+		}
+	}
+
+	if len(expected) == 0 {
+		return nil, types.NewBadRequestError("nothing is stocked at these locations to count")
+	}
+
+	keys := []key{}
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	// the count sheet walks each location in turn
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].locationID != keys[j].locationID {
+			return keys[i].locationID < keys[j].locationID
+		}
+		return keys[i].productID < keys[j].productID
+	})
+
+	obj := &types.CountSession{
+		Name:      newSession.Name,
+		Notes:     newSession.Notes,
+		Blind:     newSession.Blind,
+		Status:    types.CountSessionStatusOpen,
+		CreatedAt: time.Now(),
+		Lines:     []*types.CountLine{},
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("count_sessions", err)
+	}
+
+	for _, k := range keys {
+		line, err := r.addLine(tx, obj, k.productID, k.locationID, expected[k])
+		if err != nil {
+			return nil, err
+		}
+		obj.Lines = append(obj.Lines, line)
+	}
+
+	return obj, nil
+}
+
+func (r *countSessionsRepo) Count(ctx context.Context, count *types.CountCountSession) (*types.CountSession, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CountTx(ctx, tx, count)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.CountSession), nil
+}
+
+// CountTx - records counted quantities. Stock found that the session didn't expect, a product
+// at one of its locations without a line, gets a line of its own.
+func (r *countSessionsRepo) CountTx(ctx context.Context, tx *xorm.Session, count *types.CountCountSession) (*types.CountSession, error) {
+	if err := types.Validate(count); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, err := r.getForUpdate(tx, count.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Status != types.CountSessionStatusOpen {
+		return nil, types.NewBadRequestError("count session is not open for counting")
+	}
+
+	type key struct{ productID, locationID int64 }
+	lineByKey := map[key]*types.CountLine{}
+	for _, line := range obj.Lines {
+		lineByKey[key{line.ProductID, line.LocationID}] = line
+	}
+
+	// entries for the same product and location in one request are added together
+	counted := map[*types.CountLine]int64{}
+	for _, entry := range count.Entries {
+		productID, qty := entry.ProductID, entry.Qty
+		if entry.Barcode != "" {
+			barcode, exists, err := r.barcodes.GetTx(ctx, tx, entry.Barcode)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, types.NewNotFoundError("barcode not found")
+			}
+			productID, qty = barcode.ProductID, qty*barcode.PackSize
+		}
+
+		line, exists := lineByKey[key{productID, entry.LocationID}]
+		if !exists {
+			line, err = r.unexpected(ctx, tx, obj, productID, entry.LocationID)
+			if err != nil {
+				return nil, err
+			}
+			lineByKey[key{productID, entry.LocationID}] = line
+			obj.Lines = append(obj.Lines, line)
+		}
+
+		counted[line] += qty
+	}
+
+	for line, qty := range counted {
+		if count.Accumulate && line.CountedQty != nil {
+			qty += *line.CountedQty
+		}
+
+		if err := r.setCounted(tx, line, qty); err != nil {
+			return nil, err
+		}
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols("updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("count_sessions", err)
+	}
+
+	return obj, nil
+}
+
+func (r *countSessionsRepo) Submit(ctx context.Context, submit *types.SubmitCountSession) (*types.CountSession, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.SubmitTx(ctx, tx, submit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.CountSession), nil
+}
+
+// SubmitTx - finishes counting and puts the variances up for review
+func (r *countSessionsRepo) SubmitTx(ctx context.Context, tx *xorm.Session, submit *types.SubmitCountSession) (*types.CountSession, error) {
+	obj, err := r.getForUpdate(tx, submit.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !obj.Status.CanTransition(types.CountSessionStatusReview) {
+		return nil, types.NewBadRequestError("count session can not go from " + string(obj.Status) + " to review")
+	}
+
+	for _, line := range obj.Lines {
+		if line.CountedQty != nil {
+			continue
+		}
+		if !submit.ZeroUncounted {
+			return nil, types.NewBadRequestError("every line has to be counted before review")
+		}
+		if err := r.setCounted(tx, line, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.setStatus(tx, obj, types.CountSessionStatusReview); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *countSessionsRepo) Reopen(ctx context.Context, id int64) (*types.CountSession, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReopenTx(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.CountSession), nil
+}
+
+// ReopenTx - sends a session in review back for a recount, the counts taken so far are kept
+func (r *countSessionsRepo) ReopenTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, error) {
+	obj, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.setStatus(tx, obj, types.CountSessionStatusOpen); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *countSessionsRepo) Post(ctx context.Context, post *types.PostCountSession) (*types.CountSession, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.PostTx(ctx, tx, post)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.CountSession), nil
+}
+
+// PostTx - adjusts stock by each approved variance. The variance is applied rather than the
+// counted quantity being set, so stock that moved while the session was open isn't lost.
+// Variances that weren't approved leave stock as it is. A lot tracked product's variance goes
+// to the lot given for its line.
+func (r *countSessionsRepo) PostTx(ctx context.Context, tx *xorm.Session, post *types.PostCountSession) (*types.CountSession, error) {
+	obj, err := r.getForUpdate(tx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !obj.Status.CanTransition(types.CountSessionStatusPosted) {
+		return nil, types.NewBadRequestError("count session can not go from " + string(obj.Status) + " to posted")
+	}
+
+	approved := map[int64]bool{}
+	for _, id := range post.LineIDs {
+		approved[id] = true
+	}
+
+	lots := map[int64]int64{}
+	for _, lot := range post.Lots {
+		lots[lot.CountLineID] = lot.LotID
+	}
+
+	for _, line := range obj.Lines {
+		if len(post.LineIDs) > 0 {
+			if !approved[line.ID] {
+				continue
+			}
+			delete(approved, line.ID)
+		}
+
+		if line.Variance == nil || *line.Variance == 0 {
+			continue
+		}
+
+		var lotID *int64
+		if id, exists := lots[line.ID]; exists {
+			lotID = &id
+		}
+
+		if _, err := r.stockLevels.AdjustTx(ctx, tx, line.ProductID, line.LocationID, *line.Variance, lotID); err != nil {
+			return nil, err
+		}
+
+		if _, err := r.stockMovements.CreateTx(ctx, tx, types.NewStockMovement{
+			ProductID:     line.ProductID,
+			LocationID:    &line.LocationID,
+			Qty:           *line.Variance,
+			Reason:        types.StockMovementReasonCount,
+			ReferenceType: &countSessionReference,
+			ReferenceID:   &obj.ID,
+		}); err != nil {
+			return nil, err
+		}
+
+		line.Approved = true
+		line.UpdatedAt = utils.Ref(time.Now())
+		if _, err := tx.ID(line.ID).Cols("approved", "updated_at").Update(line); err != nil {
+			return nil, normalizeErr("count_lines", err)
+		}
+	}
+
+	if len(approved) > 0 {
+		return nil, types.NewNotFoundError("count line not found on this count session")
+	}
+
+	obj.PostedAt = utils.Ref(time.Now())
+	if err := r.setStatus(tx, obj, types.CountSessionStatusPosted, "posted_at"); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *countSessionsRepo) Cancel(ctx context.Context, id int64) (*types.CountSession, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CancelTx(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.CountSession), nil
+}
+
+// CancelTx - abandons a session that hasn't been posted, stock is never touched before posting
+func (r *countSessionsRepo) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, error) {
+	obj, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.setStatus(tx, obj, types.CountSessionStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// unexpected - a line for stock found where the session had none, expecting whatever the
+// stock level is now. Only the session's own locations can be counted.
+func (r *countSessionsRepo) unexpected(ctx context.Context, tx *xorm.Session, obj *types.CountSession, productID, locationID int64) (*types.CountLine, error) {
+	inSession := false
+	for _, line := range obj.Lines {
+		if line.LocationID == locationID {
+			inSession = true
+			break
+		}
+	}
+	if !inSession {
+		return nil, types.NewBadRequestError("location is not part of this count session")
+	}
+
+	exists, err := tx.Table("products").Where("id = ?", productID).Exist()
+	if err != nil {
+		return nil, normalizeErr("count_lines", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	var expected int64
+	level, exists, err := r.stockLevels.GetTx(ctx, tx, productID, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		expected = level.Qty
+	}
+
+	return r.addLine(tx, obj, productID, locationID, expected)
+}
+
+func (r *countSessionsRepo) addLine(tx *xorm.Session, obj *types.CountSession, productID, locationID, expected int64) (*types.CountLine, error) {
+	line := &types.CountLine{
+		CountSessionID: obj.ID,
+		ProductID:      productID,
+		LocationID:     locationID,
+		ExpectedQty:    utils.Ref(expected),
+		CreatedAt:      time.Now(),
+	}
+
+	if _, err := tx.Insert(line); err != nil {
+		return nil, normalizeErr("count_lines", err)
+	}
+
+	return line, nil
+}
+
+func (r *countSessionsRepo) setCounted(tx *xorm.Session, line *types.CountLine, qty int64) error {
+	line.CountedQty = utils.Ref(qty)
+	line.Variance = utils.Ref(qty - *line.ExpectedQty)
+	line.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(line.ID).Cols("counted_qty", "variance", "updated_at").Update(line); err != nil {
+		return normalizeErr("count_lines", err)
+	}
+
+	return nil
+}
+
+// getForUpdate - locks the count session and loads its lines
+func (r *countSessionsRepo) getForUpdate(tx *xorm.Session, id int64) (*types.CountSession, error) {
+	obj := &types.CountSession{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("count_sessions", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("count session not found by id")
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// setStatus - cols are any other columns that changed with the status
+func (r *countSessionsRepo) setStatus(tx *xorm.Session, obj *types.CountSession, to types.CountSessionStatus, cols ...string) error {
+	if !obj.Status.CanTransition(to) {
+		return types.NewBadRequestError("count session can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	obj.Status = to
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols(append(cols, "status", "updated_at")...).Update(obj); err != nil {
+		return normalizeErr("count_sessions", err)
+	}
+
+	return nil
+}
+
+func (r *countSessionsRepo) loadLines(tx *xorm.Session, sessions ...*types.CountSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.CountSession{}
+	ids := []int64{}
+	for _, session := range sessions {
+		session.Lines = []*types.CountLine{}
+		byID[session.ID] = session
+		ids = append(ids, session.ID)
+	}
+
+	lines := []*types.CountLine{}
+	if err := tx.In("count_session_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("location_id, product_id").Find(&lines); err != nil {
+		return normalizeErr("count_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.CountSessionID].Lines = append(byID[line.CountSessionID].Lines, line)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: CountSessions", func() {
+
+	var (
+		repo     repos.CountSessions
+		product  *types.Product
+		other    *types.Product
+		location *types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_movements", "count_sessions", "product_barcodes", "stock_levels", "locations", "lots", "products")
+
+		repo = gr.CountSessions()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		other, err = gr.Products().Create(ctx, types.NewProduct{Name: "other", Sku: "other", Qty: 10})
+		Expect(err).To(BeNil())
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref[int64](10)})
+		Expect(err).To(BeNil())
+	})
+
+	open := func() *types.CountSession {
+		session, err := repo.Create(ctx, types.NewCountSession{LocationIDs: []int64{location.ID}})
+		Expect(err).To(BeNil())
+		return session
+	}
+
+	levelOf := func(p *types.Product) int64 {
+		level, exists, err := gr.StockLevels().Get(ctx, p.ID, location.ID)
+		Expect(err).To(BeNil())
+		if !exists {
+			return 0
+		}
+		return level.Qty
+	}
+
+	Context("Create(Tx)", func() {
+		It("should snapshot what's stocked at the locations", func() {
+			session := open()
+			Expect(session.Status).To(Equal(types.CountSessionStatusOpen))
+			Expect(session.Lines).To(HaveLen(1))
+			Expect(session.Lines[0].ProductID).To(Equal(product.ID))
+			Expect(*session.Lines[0].ExpectedQty).To(BeNumerically("==", 10))
+			Expect(session.Lines[0].CountedQty).To(BeNil())
+		})
+
+		It("should count listed products even where nothing is expected", func() {
+			session, err := repo.Create(ctx, types.NewCountSession{
+				LocationIDs: []int64{location.ID}, ProductIDs: []int64{product.ID, other.ID},
+			})
+			Expect(err).To(BeNil())
+			Expect(session.Lines).To(HaveLen(2))
+			Expect(*session.Lines[1].ExpectedQty).To(BeNumerically("==", 0))
+		})
+
+		It("should fail for unknown locations", func() {
+			_, err := repo.Create(ctx, types.NewCountSession{LocationIDs: []int64{99999999}})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Count(Tx)", func() {
+		It("should accumulate scans and add lines for unexpected stock", func() {
+			_, err := gr.Barcodes().Create(ctx, types.NewBarcode{ProductID: other.ID, Code: "036000291452", Type: types.BarcodeTypeUPCA, PackSize: 6})
+			Expect(err).To(BeNil())
+
+			session := open()
+
+			session, err = repo.Count(ctx, &types.CountCountSession{
+				ID:         session.ID,
+				Accumulate: true,
+				Entries: []types.CountEntry{
+					{LocationID: location.ID, ProductID: product.ID, Qty: 4},
+					{LocationID: location.ID, Barcode: "036000291452", Qty: 1},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			session, err = repo.Count(ctx, &types.CountCountSession{
+				ID:         session.ID,
+				Accumulate: true,
+				Entries:    []types.CountEntry{{LocationID: location.ID, ProductID: product.ID, Qty: 5}},
+			})
+			Expect(err).To(BeNil())
+			Expect(session.Lines).To(HaveLen(2))
+
+			for _, line := range session.Lines {
+				switch line.ProductID {
+				case product.ID:
+					Expect(*line.CountedQty).To(BeNumerically("==", 9))
+					Expect(*line.Variance).To(BeNumerically("==", -1))
+				case other.ID:
+					Expect(*line.ExpectedQty).To(BeNumerically("==", 0))
+					Expect(*line.CountedQty).To(BeNumerically("==", 6))
+					Expect(*line.Variance).To(BeNumerically("==", 6))
+				}
+			}
+		})
+
+		It("should only count the session's own locations", func() {
+			elsewhere, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Store", Code: "STORE"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Count(ctx, &types.CountCountSession{
+				ID:      open().ID,
+				Entries: []types.CountEntry{{LocationID: elsewhere.ID, ProductID: product.ID, Qty: 1}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Submit(Tx)", func() {
+		It("should need every line counted unless uncounted lines are zeroed", func() {
+			session := open()
+
+			_, err := repo.Submit(ctx, &types.SubmitCountSession{ID: session.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			session, err = repo.Submit(ctx, &types.SubmitCountSession{ID: session.ID, ZeroUncounted: true})
+			Expect(err).To(BeNil())
+			Expect(session.Status).To(Equal(types.CountSessionStatusReview))
+			Expect(*session.Lines[0].Variance).To(BeNumerically("==", -10))
+
+			_, err = repo.Count(ctx, &types.CountCountSession{
+				ID: session.ID, Entries: []types.CountEntry{{LocationID: location.ID, ProductID: product.ID, Qty: 10}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			session, err = repo.Reopen(ctx, session.ID)
+			Expect(err).To(BeNil())
+			Expect(session.Status).To(Equal(types.CountSessionStatusOpen))
+		})
+	})
+
+	Context("Post(Tx)", func() {
+		It("should adjust stock by the approved variances and record them", func() {
+			session := open()
+
+			session, err := repo.Count(ctx, &types.CountCountSession{
+				ID: session.ID,
+				Entries: []types.CountEntry{
+					{LocationID: location.ID, ProductID: product.ID, Qty: 8},
+					{LocationID: location.ID, ProductID: other.ID, Qty: 3},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Post(ctx, &types.PostCountSession{ID: session.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			session, err = repo.Submit(ctx, &types.SubmitCountSession{ID: session.ID})
+			Expect(err).To(BeNil())
+
+			var approve int64
+			for _, line := range session.Lines {
+				if line.ProductID == product.ID {
+					approve = line.ID
+				}
+			}
+
+			session, err = repo.Post(ctx, &types.PostCountSession{ID: session.ID, LineIDs: []int64{approve}})
+			Expect(err).To(BeNil())
+			Expect(session.Status).To(Equal(types.CountSessionStatusPosted))
+			Expect(session.PostedAt).NotTo(BeNil())
+
+			// only the approved variance moved stock
+			Expect(levelOf(product)).To(BeNumerically("==", 8))
+			Expect(levelOf(other)).To(BeNumerically("==", 0))
+
+			movements, count, err := gr.StockMovements().Find(ctx, &repos.StockMovementsFind{
+				ReferenceType: utils.Ref("count_session"), ReferenceIDs: []int64{session.ID},
+			})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(movements[0].Qty).To(BeNumerically("==", -2))
+			Expect(movements[0].Reason).To(Equal(types.StockMovementReasonCount))
+
+			_, err = repo.Cancel(ctx, session.ID)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should post a lot tracked product's variance to the lot given for its line", func() {
+			tracked, err := gr.Products().Create(ctx, types.NewProduct{Name: "tracked", Sku: "tracked", LotTracked: true})
+			Expect(err).To(BeNil())
+
+			lot, err := gr.Lots().Receive(ctx, tracked.ID, types.NewLot{LotNumber: "L-1"}, 0)
+			Expect(err).To(BeNil())
+
+			_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{
+				ProductID: tracked.ID, LocationID: location.ID, Qty: utils.Ref[int64](6), LotID: &lot.ID,
+			})
+			Expect(err).To(BeNil())
+
+			session, err := repo.Create(ctx, types.NewCountSession{LocationIDs: []int64{location.ID}, ProductIDs: []int64{tracked.ID}})
+			Expect(err).To(BeNil())
+
+			session, err = repo.Count(ctx, &types.CountCountSession{
+				ID: session.ID, Entries: []types.CountEntry{{LocationID: location.ID, ProductID: tracked.ID, Qty: 4}},
+			})
+			Expect(err).To(BeNil())
+
+			session, err = repo.Submit(ctx, &types.SubmitCountSession{ID: session.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Post(ctx, &types.PostCountSession{ID: session.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Post(ctx, &types.PostCountSession{
+				ID: session.ID, Lots: []types.CountLineLot{{CountLineID: session.Lines[0].ID, LotID: lot.ID}},
+			})
+			Expect(err).To(BeNil())
+			Expect(levelOf(tracked)).To(BeNumerically("==", 4))
+
+			lot, _, err = gr.Lots().Get(ctx, lot.ID)
+			Expect(err).To(BeNil())
+			Expect(lot.Qty).To(BeNumerically("==", 4))
+		})
+	})
+})
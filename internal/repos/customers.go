@@ -0,0 +1,403 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type CustomersFind struct {
+	Limit  int
+	Offset int
+	IDs    []int64
+	Codes  []string
+	Groups []string
+	// TaxExempt - only customers that are, or aren't, tax exempt
+	TaxExempt *bool
+	// Search - matches part of the customer's name, code or email or one of their contact's
+	// names or emails, ignoring case
+	Search string
+}
+
+//go:generate mockgen -source=./customers.go -destination=./mocks/Customers.go -package=mock_repos Customers
+type Customers interface {
+	Find(ctx context.Context, opts *CustomersFind) ([]*types.Customer, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *CustomersFind) ([]*types.Customer, int64, error)
+	Get(ctx context.Context, id int64) (*types.Customer, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Customer, bool, error)
+	Create(ctx context.Context, newCustomer types.NewCustomer) (*types.Customer, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newCustomer types.NewCustomer) (*types.Customer, error)
+	Update(ctx context.Context, diff *types.UpdateCustomer) (*types.Customer, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateCustomer) (*types.Customer, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+}
+
+func NewCustomers(db *xorm.Engine) Customers {
+	return &customersRepo{db}
+}
+
+type customersRepo struct {
+	db *xorm.Engine
+}
+
+func (r *customersRepo) Find(ctx context.Context, opts *CustomersFind) ([]*types.Customer, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		c, cnt, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = cnt
+		return c, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Customer), count, nil
+}
+
+func (r *customersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *CustomersFind) ([]*types.Customer, int64, error) {
+	if opts == nil {
+		opts = &CustomersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.Codes) > 0 {
+		tx = tx.In("code", utils.StringArrToInterfaceArr(opts.Codes...)...)
+	}
+
+	if len(opts.Groups) > 0 {
+		tx = tx.In("customer_group", utils.StringArrToInterfaceArr(opts.Groups...)...)
+	}
+
+	if opts.TaxExempt != nil {
+		tx = tx.Where("tax_exempt = ?", *opts.TaxExempt)
+	}
+
+	if opts.Search != "" {
+		// wildcards typed in are searched for as themselves
+		pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(opts.Search) + "%"
+		tx = tx.Where(`name ILIKE ? OR code ILIKE ? OR email ILIKE ? OR EXISTS (
+			SELECT 1 FROM customer_contacts c WHERE c.customer_id = customers.id AND (c.name ILIKE ? OR c.email ILIKE ?)
+		)`, pattern, pattern, pattern, pattern, pattern)
+	}
+
+	objs := []*types.Customer{}
+	count, err := tx.OrderBy("name").OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("customers", err)
+	}
+
+	if err := r.loadDetails(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *customersRepo) Get(ctx context.Context, id int64) (*types.Customer, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		c, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return c, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Customer), exists, nil
+}
+
+func (r *customersRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Customer, bool, error) {
+	obj := &types.Customer{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("customers", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadDetails(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *customersRepo) Create(ctx context.Context, newCustomer types.NewCustomer) (*types.Customer, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newCustomer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Customer), nil
+}
+
+func (r *customersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newCustomer types.NewCustomer) (*types.Customer, error) {
+	if err := types.Validate(newCustomer); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj := &types.Customer{
+		Name:        newCustomer.Name,
+		Code:        newCustomer.Code,
+		Email:       newCustomer.Email,
+		Phone:       newCustomer.Phone,
+		Group:       newCustomer.Group,
+		TaxExempt:   newCustomer.TaxExempt,
+		TaxExemptID: newCustomer.TaxExemptID,
+		Notes:       newCustomer.Notes,
+		CreatedAt:   time.Now(),
+	}
+	obj.PaymentTermsDays = 30
+	if newCustomer.PaymentTermsDays != nil {
+		obj.PaymentTermsDays = *newCustomer.PaymentTermsDays
+	}
+	if !obj.TaxExempt {
+		obj.TaxExemptID = nil
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("customers", err)
+	}
+
+	if err := r.insertContacts(tx, obj, newCustomer.Contacts); err != nil {
+		return nil, err
+	}
+
+	if err := r.insertAddresses(tx, obj, newCustomer.Addresses); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *customersRepo) Update(ctx context.Context, diff *types.UpdateCustomer) (*types.Customer, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Customer), nil
+}
+
+// UpdateTx - contacts and addresses are replaced when they're passed
+func (r *customersRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateCustomer) (*types.Customer, error) {
+	if err := types.Validate(diff); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, exists, err := r.GetTx(ctx, tx, diff.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("customer not found by id")
+	}
+
+	if diff.Name != nil {
+		obj.Name = *diff.Name
+	}
+
+	if diff.Code != nil {
+		obj.Code = *diff.Code
+	}
+
+	if diff.Email != nil {
+		obj.Email = diff.Email
+	}
+
+	if diff.Phone != nil {
+		obj.Phone = diff.Phone
+	}
+
+	if diff.Group != nil {
+		obj.Group = diff.Group
+	}
+
+	if diff.TaxExempt != nil {
+		obj.TaxExempt = *diff.TaxExempt
+	}
+
+	if diff.TaxExemptID != nil {
+		obj.TaxExemptID = diff.TaxExemptID
+	}
+	if !obj.TaxExempt {
+		obj.TaxExemptID = nil
+	}
+
+	if diff.PaymentTermsDays != nil {
+		obj.PaymentTermsDays = *diff.PaymentTermsDays
+	}
+
+	if diff.Notes != nil {
+		obj.Notes = diff.Notes
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).
+		Cols("name", "code", "email", "phone", "customer_group", "tax_exempt", "tax_exempt_id", "payment_terms_days", "notes",
+			"updated_at").
+		Update(obj); err != nil {
+		return nil, normalizeErr("customers", err)
+	}
+
+	if diff.Contacts != nil {
+		if _, err := tx.Where("customer_id = ?", obj.ID).Delete(&types.CustomerContact{}); err != nil {
+			return nil, normalizeErr("customer_contacts", err)
+		}
+		if err := r.insertContacts(tx, obj, *diff.Contacts); err != nil {
+			return nil, err
+		}
+	}
+
+	if diff.Addresses != nil {
+		if _, err := tx.Where("customer_id = ?", obj.ID).Delete(&types.CustomerAddress{}); err != nil {
+			return nil, normalizeErr("customer_addresses", err)
+		}
+		if err := r.insertAddresses(tx, obj, *diff.Addresses); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func (r *customersRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+// DestroyTx - the customer's contacts and addresses go with it, their sales orders are kept
+// with just the customer name
+func (r *customersRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	count, err := tx.Where("id = ?", id).Delete(&types.Customer{})
+	if err != nil {
+		return normalizeErr("customers", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("customer not found")
+	}
+	return nil
+}
+
+func (r *customersRepo) insertContacts(tx *xorm.Session, customer *types.Customer, newContacts []types.NewCustomerContact) error {
+	customer.Contacts = []*types.CustomerContact{}
+	for _, nc := range newContacts {
+		contact := &types.CustomerContact{
+			CustomerID: customer.ID,
+			Name:       nc.Name,
+			Role:       nc.Role,
+			Email:      nc.Email,
+			Phone:      nc.Phone,
+			CreatedAt:  time.Now(),
+		}
+
+		if _, err := tx.Insert(contact); err != nil {
+			return normalizeErr("customer_contacts", err)
+		}
+		customer.Contacts = append(customer.Contacts, contact)
+	}
+
+	return nil
+}
+
+// insertAddresses - only one address of each kind can be the default, the first of a kind is
+// when none of them are
+func (r *customersRepo) insertAddresses(tx *xorm.Session, customer *types.Customer, newAddresses []types.NewCustomerAddress) error {
+	defaults := map[types.AddressKind]int{}
+	for i, na := range newAddresses {
+		if _, exists := defaults[na.Kind]; !exists || na.Default {
+			if na.Default && exists && newAddresses[defaults[na.Kind]].Default {
+				return types.NewBadRequestError("only one " + string(na.Kind) + " address can be the default")
+			}
+			defaults[na.Kind] = i
+		}
+	}
+
+	customer.Addresses = []*types.CustomerAddress{}
+	for i, na := range newAddresses {
+		address := &types.CustomerAddress{
+			CustomerID: customer.ID,
+			Kind:       na.Kind,
+			Name:       na.Name,
+			Line1:      na.Line1,
+			Line2:      na.Line2,
+			City:       na.City,
+			Region:     na.Region,
+			PostalCode: na.PostalCode,
+			Country:    na.Country,
+			Default:    defaults[na.Kind] == i,
+			CreatedAt:  time.Now(),
+		}
+
+		if _, err := tx.Insert(address); err != nil {
+			return normalizeErr("customer_addresses", err)
+		}
+		customer.Addresses = append(customer.Addresses, address)
+	}
+
+	return nil
+}
+
+func (r *customersRepo) loadDetails(tx *xorm.Session, customers ...*types.Customer) error {
+	if len(customers) == 0 {
+		return nil
+	}
+
+	ids := []int64{}
+	byID := map[int64]*types.Customer{}
+	for _, customer := range customers {
+		customer.Contacts = []*types.CustomerContact{}
+		customer.Addresses = []*types.CustomerAddress{}
+		ids = append(ids, customer.ID)
+		byID[customer.ID] = customer
+	}
+
+	contacts := []*types.CustomerContact{}
+	if err := tx.In("customer_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&contacts); err != nil {
+		return normalizeErr("customer_contacts", err)
+	}
+	for _, contact := range contacts {
+		byID[contact.CustomerID].Contacts = append(byID[contact.CustomerID].Contacts, contact)
+	}
+
+	addresses := []*types.CustomerAddress{}
+	if err := tx.In("customer_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&addresses); err != nil {
+		return normalizeErr("customer_addresses", err)
+	}
+	for _, address := range addresses {
+		byID[address.CustomerID].Addresses = append(byID[address.CustomerID].Addresses, address)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Customers", func() {
+
+	var (
+		repo repos.Customers
+	)
+
+	BeforeEach(func() {
+		clearDatabase("customers", "customer_contacts", "customer_addresses", "sales_orders", "sales_order_lines", "products")
+
+		repo = gr.Customers()
+		Expect(repo).NotTo(BeNil())
+	})
+
+	shipping := func(line1 string) types.NewCustomerAddress {
+		return types.NewCustomerAddress{Kind: types.AddressKindShipping, Line1: line1, City: "Springfield", Country: "US"}
+	}
+
+	Context("Create(Tx)", func() {
+		It("should fail with invalid customers", func() {
+			_, err := repo.Create(ctx, types.NewCustomer{})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewCustomer{Name: "Jane Doe", Code: "JANE", Email: utils.Ref("nope")})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewCustomer{
+				Name: "Jane Doe", Code: "JANE", Addresses: []types.NewCustomerAddress{{Kind: "home", Line1: "1 Main St", City: "Springfield", Country: "US"}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should not allow duplicate codes", func() {
+			_, err := repo.Create(ctx, types.NewCustomer{Name: "Jane Doe", Code: "JANE"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewCustomer{Name: "Other", Code: "JANE"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should not allow two default addresses of a kind", func() {
+			first, second := shipping("1 Main St"), shipping("2 Main St")
+			first.Default, second.Default = true, true
+
+			_, err := repo.Create(ctx, types.NewCustomer{Name: "Jane Doe", Code: "JANE", Addresses: []types.NewCustomerAddress{first, second}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should make the first address of each kind the default when none are", func() {
+			customer, err := repo.Create(ctx, types.NewCustomer{
+				Name: "Jane Doe", Code: "JANE", TaxExemptID: utils.Ref("EX-1"),
+				Contacts: []types.NewCustomerContact{{Name: "John Doe", Role: utils.Ref("buyer")}},
+				Addresses: []types.NewCustomerAddress{
+					shipping("1 Main St"), shipping("2 Main St"),
+					{Kind: types.AddressKindBilling, Line1: "3 Main St", City: "Springfield", Country: "US"},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(customer.TaxExemptID).To(BeNil())
+
+			found, exists, err := repo.Get(ctx, customer.ID)
+			Expect(err).To(BeNil())
+			Expect(exists).To(BeTrue())
+			Expect(found.Contacts).To(HaveLen(1))
+			Expect(found.Addresses).To(HaveLen(3))
+			Expect(found.DefaultAddress(types.AddressKindShipping).Line1).To(Equal("1 Main St"))
+			Expect(found.DefaultAddress(types.AddressKindBilling).Line1).To(Equal("3 Main St"))
+		})
+	})
+
+	Context("customer data creation", func() {
+		var customer *types.Customer
+
+		BeforeEach(func() {
+			var err error
+			customer, err = repo.Create(ctx, types.NewCustomer{
+				Name: "Jane Doe", Code: "JANE", Group: utils.Ref("retail"),
+				Contacts:  []types.NewCustomerContact{{Name: "John Smith", Email: utils.Ref("john@example.com")}},
+				Addresses: []types.NewCustomerAddress{shipping("1 Main St")},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewCustomer{
+				Name: "Globex", Code: "GLOBEX", Group: utils.Ref("wholesale"), TaxExempt: true, TaxExemptID: utils.Ref("EX-1"),
+			})
+			Expect(err).To(BeNil())
+		})
+
+		Context("Find(Tx)", func() {
+			It("should find by group and tax exempt", func() {
+				customers, count, err := repo.Find(ctx, &repos.CustomersFind{Groups: []string{"wholesale"}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(customers[0].Code).To(Equal("GLOBEX"))
+
+				customers, count, err = repo.Find(ctx, &repos.CustomersFind{TaxExempt: utils.Ref(false)})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(customers[0].Code).To(Equal("JANE"))
+			})
+
+			It("should search names, codes, emails and contacts", func() {
+				customers, count, err := repo.Find(ctx, &repos.CustomersFind{Search: "glob"})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(customers[0].Code).To(Equal("GLOBEX"))
+
+				customers, count, err = repo.Find(ctx, &repos.CustomersFind{Search: "JOHN@"})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(customers[0].Code).To(Equal("JANE"))
+				Expect(customers[0].Contacts).To(HaveLen(1))
+				Expect(customers[0].Addresses).To(HaveLen(1))
+
+				_, count, err = repo.Find(ctx, &repos.CustomersFind{Search: "%"})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 0))
+			})
+		})
+
+		Context("Update(Tx)", func() {
+			It("should return not found for an unknown customer", func() {
+				_, err := repo.Update(ctx, &types.UpdateCustomer{ID: 99999999, Name: utils.Ref("x")})
+				Expect(types.IsNotFoundError(err)).To(BeTrue())
+			})
+
+			It("should keep contacts and addresses unless they're passed", func() {
+				updated, err := repo.Update(ctx, &types.UpdateCustomer{ID: customer.ID, Name: utils.Ref("Jane Smith"), TaxExempt: utils.Ref(true)})
+				Expect(err).To(BeNil())
+				Expect(updated.Name).To(Equal("Jane Smith"))
+				Expect(updated.TaxExempt).To(BeTrue())
+				Expect(updated.Contacts).To(HaveLen(1))
+				Expect(updated.Addresses).To(HaveLen(1))
+
+				updated, err = repo.Update(ctx, &types.UpdateCustomer{
+					ID: customer.ID, Contacts: &[]types.NewCustomerContact{}, Addresses: &[]types.NewCustomerAddress{shipping("9 Elm St")},
+				})
+				Expect(err).To(BeNil())
+
+				found, _, err := repo.Get(ctx, customer.ID)
+				Expect(err).To(BeNil())
+				Expect(found.Contacts).To(BeEmpty())
+				Expect(found.Addresses).To(HaveLen(1))
+				Expect(found.DefaultAddress(types.AddressKindShipping).Line1).To(Equal("9 Elm St"))
+				Expect(found.TaxExempt).To(BeTrue())
+			})
+		})
+
+		Context("sales orders", func() {
+			var product *types.Product
+
+			BeforeEach(func() {
+				var err error
+				product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+				Expect(err).To(BeNil())
+			})
+
+			It("should default the order's customer name and keep the order when the customer is destroyed", func() {
+				order, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+					CustomerID: &customer.ID,
+					Lines:      []types.NewSalesOrderLine{{ProductID: product.ID, Qty: 1}},
+				})
+				Expect(err).To(BeNil())
+				Expect(order.CustomerName).To(Equal("Jane Doe"))
+
+				orders, count, err := gr.SalesOrders().Find(ctx, &repos.SalesOrdersFind{CustomerIDs: []int64{customer.ID}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(orders[0].ID).To(Equal(order.ID))
+
+				Expect(repo.Destroy(ctx, customer.ID)).To(Succeed())
+				Expect(types.IsNotFoundError(repo.Destroy(ctx, customer.ID))).To(BeTrue())
+
+				found, _, err := gr.SalesOrders().Get(ctx, order.ID)
+				Expect(err).To(BeNil())
+				Expect(found.CustomerID).To(BeNil())
+				Expect(found.CustomerName).To(Equal("Jane Doe"))
+			})
+
+			It("should return not found for an unknown customer", func() {
+				_, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+					CustomerID: utils.Ref(int64(99999999)),
+					Lines:      []types.NewSalesOrderLine{{ProductID: product.ID, Qty: 1}},
+				})
+				Expect(types.IsNotFoundError(err)).To(BeTrue())
+			})
+		})
+	})
+})
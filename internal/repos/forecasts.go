@@ -0,0 +1,237 @@
+package repos
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/forecast"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type ForecastsFind struct {
+	ProductIDs []int64
+	// SupplierIDs - only products these are the preferred supplier of
+	SupplierIDs []int64
+	Options     forecast.Options
+}
+
+//go:generate mockgen -source=./forecasts.go -destination=./mocks/Forecasts.go -package=mock_repos Forecasts
+type Forecasts interface {
+	Suggest(ctx context.Context, opts *ForecastsFind) ([]*types.SuggestedPurchase, error)
+	SuggestTx(ctx context.Context, tx *xorm.Session, opts *ForecastsFind) ([]*types.SuggestedPurchase, error)
+	Draft(ctx context.Context, opts *ForecastsFind) ([]*types.PurchaseOrder, error)
+	DraftTx(ctx context.Context, tx *xorm.Session, opts *ForecastsFind) ([]*types.PurchaseOrder, error)
+}
+
+// NewForecasts - purchaseOrders is used to draft orders for the suggestions
+func NewForecasts(db *xorm.Engine, purchaseOrders PurchaseOrders) Forecasts {
+	return &forecastsRepo{db, purchaseOrders}
+}
+
+type forecastsRepo struct {
+	db             *xorm.Engine
+	purchaseOrders PurchaseOrders
+}
+
+func (r *forecastsRepo) Suggest(ctx context.Context, opts *ForecastsFind) ([]*types.SuggestedPurchase, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.SuggestTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.SuggestedPurchase), nil
+}
+
+// SuggestTx - every product, other than kits, that's at or below its forecast reorder point and
+// what to order of it, by sku. Products that haven't been around for the whole history are only
+// forecast from when they were created.
+func (r *forecastsRepo) SuggestTx(ctx context.Context, tx *xorm.Session, opts *ForecastsFind) ([]*types.SuggestedPurchase, error) {
+	if opts == nil {
+		opts = &ForecastsFind{}
+	}
+	options := opts.Options.WithDefaults()
+
+	products := []*types.Product{}
+	query := tx.Where("kit = ?", false)
+	if len(opts.ProductIDs) > 0 {
+		query = query.In("id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+	if err := query.OrderBy("sku").Find(&products); err != nil {
+		return nil, normalizeErr("products", err)
+	}
+	if len(products) == 0 {
+		return []*types.SuggestedPurchase{}, nil
+	}
+
+	ids := make([]int64, len(products))
+	for i, product := range products {
+		ids[i] = product.ID
+	}
+
+	links := []*types.ProductSupplier{}
+	if err := tx.Where("preferred = ?", true).In("product_id", utils.Int64ArrToInterfaceArr(ids...)...).
+		Find(&links); err != nil {
+		return nil, normalizeErr("product_suppliers", err)
+	}
+	preferred := map[int64]*types.ProductSupplier{}
+	for _, link := range links {
+		preferred[link.ProductID] = link
+	}
+
+	demand, err := demandTx(tx, opts.ProductIDs, options)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	period := time.Duration(options.PeriodDays) * 24 * time.Hour
+	objs := []*types.SuggestedPurchase{}
+	for _, product := range products {
+		link := preferred[product.ID]
+		if len(opts.SupplierIDs) > 0 && (link == nil || !slices.Contains(opts.SupplierIDs, link.SupplierID)) {
+			continue
+		}
+
+		// periods before the product existed aren't a lack of demand
+		periods := int(math.Ceil(float64(now.Sub(product.CreatedAt)) / float64(period)))
+		periods = max(1, min(periods, options.Periods))
+		history := make([]float64, periods)
+		for p, qty := range demand[product.ID] {
+			if p < periods {
+				history[periods-1-p] = qty
+			}
+		}
+
+		item := forecast.Item{Demand: history, Position: product.Qty - product.Allocated + product.OnOrder}
+		if link != nil {
+			item.LeadTimeDays, item.MinOrderQty = link.LeadTimeDays, link.MinOrderQty
+		}
+
+		plan := forecast.PlanFor(item, options)
+		if plan.Qty <= 0 {
+			continue
+		}
+
+		obj := &types.SuggestedPurchase{
+			ProductID:    product.ID,
+			Sku:          product.Sku,
+			Name:         product.Name,
+			Position:     item.Position,
+			DailyDemand:  math.Round(plan.DailyDemand*100) / 100,
+			Seasonal:     plan.Seasonal,
+			SafetyStock:  plan.SafetyStock,
+			ReorderPoint: plan.ReorderPoint,
+			OrderUpTo:    plan.OrderUpTo,
+			Qty:          plan.Qty,
+		}
+		if link != nil {
+			obj.SupplierID, obj.UnitCost, obj.LeadTimeDays = &link.SupplierID, link.UnitCost, link.LeadTimeDays
+		}
+
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+func (r *forecastsRepo) Draft(ctx context.Context, opts *ForecastsFind) ([]*types.PurchaseOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.DraftTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.PurchaseOrder), nil
+}
+
+// DraftTx - turns the suggestions into a draft purchase order for each preferred supplier at
+// their cost, by supplier. Products without a preferred supplier are left for someone to order.
+func (r *forecastsRepo) DraftTx(ctx context.Context, tx *xorm.Session, opts *ForecastsFind) ([]*types.PurchaseOrder, error) {
+	suggestions, err := r.SuggestTx(ctx, tx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bySupplier := map[int64][]types.NewPurchaseOrderLine{}
+	supplierIDs := []int64{}
+	for _, suggestion := range suggestions {
+		if suggestion.SupplierID == nil {
+			continue
+		}
+
+		supplierID := *suggestion.SupplierID
+		if _, exists := bySupplier[supplierID]; !exists {
+			supplierIDs = append(supplierIDs, supplierID)
+		}
+		bySupplier[supplierID] = append(bySupplier[supplierID], types.NewPurchaseOrderLine{
+			ProductID: suggestion.ProductID,
+			Qty:       suggestion.Qty,
+		})
+	}
+	sort.Slice(supplierIDs, func(i, j int) bool { return supplierIDs[i] < supplierIDs[j] })
+
+	objs := []*types.PurchaseOrder{}
+	for _, supplierID := range supplierIDs {
+		obj, err := r.purchaseOrders.CreateTx(ctx, tx, types.NewPurchaseOrder{
+			SupplierID: supplierID,
+			Notes:      utils.Ref("Suggested from forecast demand"),
+			Lines:      bySupplier[supplierID],
+		})
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+// demandTx - what went out of stock of these products, all of them when there aren't any, over
+// the periods of history by product then period, the latest period first. Moves between
+// locations aren't demand.
+func demandTx(tx *xorm.Session, productIDs []int64, options forecast.Options) (map[int64]map[int]float64, error) {
+	now := time.Now()
+	periodSeconds := options.PeriodDays * 24 * 60 * 60
+	since := now.Add(-time.Duration(options.Periods*periodSeconds) * time.Second)
+
+	filter := ""
+	args := []interface{}{now, periodSeconds, since}
+	if len(productIDs) > 0 {
+		filter = " AND product_id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(productIDs)), ",") + ")"
+		args = append(args, utils.Int64ArrToInterfaceArr(productIDs...)...)
+	}
+
+	rows := []*struct {
+		ProductID int64 `xorm:"product_id"`
+		Period    int   `xorm:"period"`
+		Qty       int64 `xorm:"qty"`
+	}{}
+	if err := tx.SQL(`
+		SELECT product_id, FLOOR(EXTRACT(EPOCH FROM (?::timestamptz - created_at)) / ?)::INTEGER AS period,
+			-SUM(qty) AS qty
+		FROM valuation_entries
+		WHERE qty < 0 AND NOT move AND created_at > ?`+filter+`
+		GROUP BY product_id, period`, args...,
+	).Find(&rows); err != nil {
+		return nil, normalizeErr("valuation_entries", err)
+	}
+
+	demand := map[int64]map[int]float64{}
+	for _, row := range rows {
+		if demand[row.ProductID] == nil {
+			demand[row.ProductID] = map[int]float64{}
+		}
+		demand[row.ProductID][row.Period] += float64(row.Qty)
+	}
+
+	return demand, nil
+}
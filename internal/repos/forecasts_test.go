@@ -0,0 +1,113 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/forecast"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Forecasts", func() {
+
+	var (
+		repo      repos.Forecasts
+		busy      *types.Product
+		stocked   *types.Product
+		unsourced *types.Product
+		acme      *types.Supplier
+		options   forecast.Options
+	)
+
+	BeforeEach(func() {
+		clearDatabase("purchase_orders", "product_suppliers", "suppliers", "products")
+
+		repo = gr.Forecasts()
+		Expect(repo).NotTo(BeNil())
+
+		options = forecast.Options{Method: types.ForecastMethodMovingAverage}
+
+		var err error
+		acme, err = gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+		Expect(err).To(BeNil())
+
+		// busy: 70 a week goes out and 30 is left, acme takes a week to deliver in fifties
+		busy, err = gr.Products().Create(ctx, types.NewProduct{Name: "busy", Sku: "busy", Qty: 100})
+		Expect(err).To(BeNil())
+		_, err = gr.Products().Adjust(ctx, busy.ID, types.ProductAdjustment{Qty: -70})
+		Expect(err).To(BeNil())
+		_, err = gr.ProductSuppliers().Create(ctx, types.NewProductSupplier{
+			ProductID: busy.ID, SupplierID: acme.ID, UnitCost: 125, MinOrderQty: 50, LeadTimeDays: 7, Preferred: true,
+		})
+		Expect(err).To(BeNil())
+
+		stocked, err = gr.Products().Create(ctx, types.NewProduct{Name: "stocked", Sku: "stocked", Qty: 1000})
+		Expect(err).To(BeNil())
+		_, err = gr.Products().Adjust(ctx, stocked.ID, types.ProductAdjustment{Qty: -10})
+		Expect(err).To(BeNil())
+		_, err = gr.ProductSuppliers().Create(ctx, types.NewProductSupplier{
+			ProductID: stocked.ID, SupplierID: acme.ID, LeadTimeDays: 7, Preferred: true,
+		})
+		Expect(err).To(BeNil())
+
+		unsourced, err = gr.Products().Create(ctx, types.NewProduct{Name: "unsourced", Sku: "unsourced", Qty: 10})
+		Expect(err).To(BeNil())
+		_, err = gr.Products().Adjust(ctx, unsourced.ID, types.ProductAdjustment{Qty: -10})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Suggest(Tx)", func() {
+		It("should suggest what to order of products at or below their reorder point", func() {
+			suggestions, err := repo.Suggest(ctx, &repos.ForecastsFind{Options: options})
+			Expect(err).To(BeNil())
+			Expect(suggestions).To(HaveLen(2))
+
+			Expect(suggestions[0].ProductID).To(Equal(busy.ID))
+			Expect(*suggestions[0].SupplierID).To(Equal(acme.ID))
+			Expect(suggestions[0].Position).To(BeNumerically("==", 30))
+			Expect(suggestions[0].DailyDemand).To(Equal(10.0))
+			Expect(suggestions[0].ReorderPoint).To(BeNumerically("==", 70))
+			Expect(suggestions[0].OrderUpTo).To(BeNumerically("==", 210))
+			Expect(suggestions[0].Qty).To(BeNumerically("==", 200))
+
+			Expect(suggestions[1].ProductID).To(Equal(unsourced.ID))
+			Expect(suggestions[1].SupplierID).To(BeNil())
+			Expect(suggestions[1].Qty).To(BeNumerically("==", 20))
+		})
+
+		It("should not count stock moving between locations as demand", func() {
+			location, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+			Expect(err).To(BeNil())
+			_, err = gr.StockLevels().Receive(ctx, stocked.ID, location.ID, 10, 0)
+			Expect(err).To(BeNil())
+			_, err = gr.StockLevels().Move(ctx, stocked.ID, location.ID, -10)
+			Expect(err).To(BeNil())
+
+			suggestions, err := repo.Suggest(ctx, &repos.ForecastsFind{ProductIDs: []int64{stocked.ID}, Options: options})
+			Expect(err).To(BeNil())
+			Expect(suggestions).To(BeEmpty())
+		})
+
+		It("should only suggest products of a supplier", func() {
+			suggestions, err := repo.Suggest(ctx, &repos.ForecastsFind{SupplierIDs: []int64{acme.ID}, Options: options})
+			Expect(err).To(BeNil())
+			Expect(suggestions).To(HaveLen(1))
+			Expect(suggestions[0].ProductID).To(Equal(busy.ID))
+		})
+	})
+
+	Context("Draft(Tx)", func() {
+		It("should draft a purchase order for each preferred supplier", func() {
+			orders, err := repo.Draft(ctx, &repos.ForecastsFind{Options: options})
+			Expect(err).To(BeNil())
+			Expect(orders).To(HaveLen(1))
+
+			Expect(orders[0].SupplierID).To(Equal(acme.ID))
+			Expect(orders[0].Status).To(Equal(types.PurchaseOrderStatusDraft))
+			Expect(orders[0].Lines).To(HaveLen(1))
+			Expect(orders[0].Lines[0].ProductID).To(Equal(busy.ID))
+			Expect(orders[0].Lines[0].QtyOrdered).To(BeNumerically("==", 200))
+			Expect(orders[0].Lines[0].UnitCost).To(BeNumerically("==", 125))
+		})
+	})
+})
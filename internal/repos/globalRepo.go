@@ -3,6 +3,7 @@ package repos
 import (
 	"sync"
 
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/tax"
 	"xorm.io/xorm"
 )
 
@@ -12,14 +13,45 @@ var singular GlobalRepo
 type GlobalRepo interface {
 	DB() *xorm.Engine
 	Products() Products
+	Barcodes() Barcodes
+	Attachments() Attachments
+	Locations() Locations
+	StockLevels() StockLevels
+	Reports() Reports
+	Suppliers() Suppliers
+	ProductSuppliers() ProductSuppliers
+	PurchaseOrders() PurchaseOrders
+	Receipts() Receipts
+	SalesOrders() SalesOrders
+	PickLists() PickLists
+	Shipments() Shipments
+	StockMovements() StockMovements
+	Returns() Returns
+	Transfers() Transfers
+	CountSessions() CountSessions
+	Lots() Lots
+	Serials() Serials
+	Putaway() Putaway
+	Kits() Kits
+	Boms() Boms
+	WorkOrders() WorkOrders
+	Snapshots() Snapshots
+	Forecasts() Forecasts
+	Customers() Customers
+	TaxRates() TaxRates
+	Invoices() Invoices
 }
 
-func NewGlobalRepo(db *xorm.Engine) (GlobalRepo, error) {
+// NewGlobalRepo - calculator works out the tax on sales orders, nil is the built-in table of tax
+// rates, see NewTaxRates. Observers are told about every committed change to stock quantities.
+func NewGlobalRepo(db *xorm.Engine, calculator tax.Calculator, observers ...StockObserver) (GlobalRepo, error) {
 	if singular == nil {
 		singular = &globalRepo{
-			db:    db,
-			mutex: &sync.RWMutex{},
-			repos: make(map[string]interface{}),
+			db:         db,
+			mutex:      &sync.RWMutex{},
+			repos:      make(map[string]interface{}),
+			calculator: calculator,
+			observers:  observers,
 		}
 	}
 
@@ -27,9 +59,11 @@ func NewGlobalRepo(db *xorm.Engine) (GlobalRepo, error) {
 }
 
 type globalRepo struct {
-	db    *xorm.Engine
-	repos map[string]interface{}
-	mutex *sync.RWMutex
+	db         *xorm.Engine
+	repos      map[string]interface{}
+	mutex      *sync.RWMutex
+	calculator tax.Calculator
+	observers  []StockObserver
 }
 
 func (gr *globalRepo) DB() *xorm.Engine {
@@ -52,5 +86,154 @@ func (gr *globalRepo) factory(key string, fn func(db *xorm.Engine) interface{})
 }
 
 func (gr *globalRepo) Products() Products {
-	return gr.factory("Products", func(db *xorm.Engine) interface{} { return NewProducts(db) }).(Products)
+	return gr.factory("Products", func(db *xorm.Engine) interface{} { return NewProducts(db, gr.observers...) }).(Products)
+}
+
+func (gr *globalRepo) Barcodes() Barcodes {
+	return gr.factory("Barcodes", func(db *xorm.Engine) interface{} { return NewBarcodes(db) }).(Barcodes)
+}
+
+func (gr *globalRepo) Attachments() Attachments {
+	return gr.factory("Attachments", func(db *xorm.Engine) interface{} { return NewAttachments(db) }).(Attachments)
+}
+
+func (gr *globalRepo) Locations() Locations {
+	return gr.factory("Locations", func(db *xorm.Engine) interface{} { return NewLocations(db) }).(Locations)
+}
+
+func (gr *globalRepo) StockLevels() StockLevels {
+	// resolved before taking the factory lock, it isn't reentrant
+	products := gr.Products()
+	return gr.factory("StockLevels", func(db *xorm.Engine) interface{} {
+		return NewStockLevels(db, products, gr.observers...)
+	}).(StockLevels)
+}
+
+func (gr *globalRepo) Reports() Reports {
+	return gr.factory("Reports", func(db *xorm.Engine) interface{} { return NewReports(db) }).(Reports)
+}
+
+func (gr *globalRepo) Suppliers() Suppliers {
+	return gr.factory("Suppliers", func(db *xorm.Engine) interface{} { return NewSuppliers(db) }).(Suppliers)
+}
+
+func (gr *globalRepo) ProductSuppliers() ProductSuppliers {
+	return gr.factory("ProductSuppliers", func(db *xorm.Engine) interface{} { return NewProductSuppliers(db) }).(ProductSuppliers)
+}
+
+func (gr *globalRepo) PurchaseOrders() PurchaseOrders {
+	products := gr.Products()
+	return gr.factory("PurchaseOrders", func(db *xorm.Engine) interface{} {
+		return NewPurchaseOrders(db, products)
+	}).(PurchaseOrders)
+}
+
+func (gr *globalRepo) Receipts() Receipts {
+	products, stockLevels, purchaseOrders, salesOrders := gr.Products(), gr.StockLevels(), gr.PurchaseOrders(), gr.SalesOrders()
+	lots, serials := gr.Lots(), gr.Serials()
+	return gr.factory("Receipts", func(db *xorm.Engine) interface{} {
+		return NewReceipts(db, products, stockLevels, purchaseOrders, salesOrders, lots, serials)
+	}).(Receipts)
+}
+
+func (gr *globalRepo) SalesOrders() SalesOrders {
+	products, kits := gr.Products(), gr.Kits()
+	return gr.factory("SalesOrders", func(db *xorm.Engine) interface{} {
+		return NewSalesOrders(db, products, kits)
+	}).(SalesOrders)
+}
+
+func (gr *globalRepo) PickLists() PickLists {
+	serials := gr.Serials()
+	return gr.factory("PickLists", func(db *xorm.Engine) interface{} { return NewPickLists(db, serials) }).(PickLists)
+}
+
+func (gr *globalRepo) Shipments() Shipments {
+	products, stockLevels, salesOrders, lots, serials := gr.Products(), gr.StockLevels(), gr.SalesOrders(), gr.Lots(), gr.Serials()
+	invoices := gr.Invoices()
+	return gr.factory("Shipments", func(db *xorm.Engine) interface{} {
+		return NewShipments(db, products, stockLevels, salesOrders, lots, serials, invoices)
+	}).(Shipments)
+}
+
+func (gr *globalRepo) StockMovements() StockMovements {
+	return gr.factory("StockMovements", func(db *xorm.Engine) interface{} { return NewStockMovements(db) }).(StockMovements)
+}
+
+func (gr *globalRepo) Returns() Returns {
+	products, stockLevels, salesOrders, stockMovements, serials := gr.Products(), gr.StockLevels(), gr.SalesOrders(), gr.StockMovements(), gr.Serials()
+	return gr.factory("Returns", func(db *xorm.Engine) interface{} {
+		return NewReturns(db, products, stockLevels, salesOrders, stockMovements, serials)
+	}).(Returns)
+}
+
+func (gr *globalRepo) Transfers() Transfers {
+	products, stockLevels, stockMovements := gr.Products(), gr.StockLevels(), gr.StockMovements()
+	return gr.factory("Transfers", func(db *xorm.Engine) interface{} {
+		return NewTransfers(db, products, stockLevels, stockMovements)
+	}).(Transfers)
+}
+
+func (gr *globalRepo) CountSessions() CountSessions {
+	stockLevels, stockMovements, barcodes := gr.StockLevels(), gr.StockMovements(), gr.Barcodes()
+	return gr.factory("CountSessions", func(db *xorm.Engine) interface{} {
+		return NewCountSessions(db, stockLevels, stockMovements, barcodes)
+	}).(CountSessions)
+}
+
+func (gr *globalRepo) Lots() Lots {
+	return gr.factory("Lots", func(db *xorm.Engine) interface{} { return NewLots(db) }).(Lots)
+}
+
+func (gr *globalRepo) Serials() Serials {
+	return gr.factory("Serials", func(db *xorm.Engine) interface{} { return NewSerials(db) }).(Serials)
+}
+
+func (gr *globalRepo) Putaway() Putaway {
+	stockLevels, stockMovements := gr.StockLevels(), gr.StockMovements()
+	return gr.factory("Putaway", func(db *xorm.Engine) interface{} {
+		return NewPutaway(db, stockLevels, stockMovements)
+	}).(Putaway)
+}
+
+func (gr *globalRepo) Kits() Kits {
+	products, stockLevels, stockMovements := gr.Products(), gr.StockLevels(), gr.StockMovements()
+	return gr.factory("Kits", func(db *xorm.Engine) interface{} {
+		return NewKits(db, products, stockLevels, stockMovements)
+	}).(Kits)
+}
+
+func (gr *globalRepo) Boms() Boms {
+	return gr.factory("Boms", func(db *xorm.Engine) interface{} { return NewBoms(db) }).(Boms)
+}
+
+func (gr *globalRepo) WorkOrders() WorkOrders {
+	products, stockLevels, stockMovements, boms, salesOrders := gr.Products(), gr.StockLevels(), gr.StockMovements(), gr.Boms(), gr.SalesOrders()
+	return gr.factory("WorkOrders", func(db *xorm.Engine) interface{} {
+		return NewWorkOrders(db, products, stockLevels, stockMovements, boms, salesOrders)
+	}).(WorkOrders)
+}
+
+func (gr *globalRepo) Snapshots() Snapshots {
+	return gr.factory("Snapshots", func(db *xorm.Engine) interface{} { return NewSnapshots(db) }).(Snapshots)
+}
+
+func (gr *globalRepo) Forecasts() Forecasts {
+	purchaseOrders := gr.PurchaseOrders()
+	return gr.factory("Forecasts", func(db *xorm.Engine) interface{} {
+		return NewForecasts(db, purchaseOrders)
+	}).(Forecasts)
+}
+
+func (gr *globalRepo) Customers() Customers {
+	return gr.factory("Customers", func(db *xorm.Engine) interface{} { return NewCustomers(db) }).(Customers)
+}
+
+func (gr *globalRepo) TaxRates() TaxRates {
+	return gr.factory("TaxRates", func(db *xorm.Engine) interface{} { return NewTaxRates(db, gr.calculator) }).(TaxRates)
+}
+
+func (gr *globalRepo) Invoices() Invoices {
+	taxRates := gr.TaxRates()
+	return gr.factory("Invoices", func(db *xorm.Engine) interface{} { return NewInvoices(db, taxRates) }).(Invoices)
 }
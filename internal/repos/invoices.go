@@ -0,0 +1,451 @@
+package repos
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type InvoicesFind struct {
+	Limit         int
+	Offset        int
+	IDs           []int64
+	SalesOrderIDs []int64
+	CustomerIDs   []int64
+	Statuses      []types.InvoiceStatus
+	Years         []int
+	// Overdue - only open invoices past their due date
+	Overdue bool
+}
+
+//go:generate mockgen -source=./invoices.go -destination=./mocks/Invoices.go -package=mock_repos Invoices
+type Invoices interface {
+	Find(ctx context.Context, opts *InvoicesFind) ([]*types.Invoice, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *InvoicesFind) ([]*types.Invoice, int64, error)
+	Get(ctx context.Context, id int64) (*types.Invoice, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Invoice, bool, error)
+	Create(ctx context.Context, shipmentID int64) (*types.Invoice, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, shipmentID int64) (*types.Invoice, error)
+	Pay(ctx context.Context, payment types.NewInvoicePayment) (*types.Invoice, error)
+	PayTx(ctx context.Context, tx *xorm.Session, payment types.NewInvoicePayment) (*types.Invoice, error)
+}
+
+// NewInvoices - taxRates works out the tax on what was shipped
+func NewInvoices(db *xorm.Engine, taxRates TaxRates) Invoices {
+	return &invoicesRepo{db, taxRates}
+}
+
+type invoicesRepo struct {
+	db       *xorm.Engine
+	taxRates TaxRates
+}
+
+func (r *invoicesRepo) Find(ctx context.Context, opts *InvoicesFind) ([]*types.Invoice, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		i, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return i, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Invoice), count, nil
+}
+
+func (r *invoicesRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *InvoicesFind) ([]*types.Invoice, int64, error) {
+	if opts == nil {
+		opts = &InvoicesFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.SalesOrderIDs) > 0 {
+		tx = tx.In("sales_order_id", utils.Int64ArrToInterfaceArr(opts.SalesOrderIDs...)...)
+	}
+
+	if len(opts.CustomerIDs) > 0 {
+		tx = tx.In("customer_id", utils.Int64ArrToInterfaceArr(opts.CustomerIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	if len(opts.Years) > 0 {
+		years := []interface{}{}
+		for _, year := range opts.Years {
+			years = append(years, year)
+		}
+		tx = tx.In("year", years...)
+	}
+
+	if opts.Overdue {
+		tx = tx.Where("status = ? AND due_at < ?", types.InvoiceStatusOpen, time.Now())
+	}
+
+	objs := []*types.Invoice{}
+	count, err := tx.OrderBy("year DESC").OrderBy("sequence DESC").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("invoices", err)
+	}
+
+	if err := r.loadDetails(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *invoicesRepo) Get(ctx context.Context, id int64) (*types.Invoice, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		i, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return i, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Invoice), exists, nil
+}
+
+func (r *invoicesRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Invoice, bool, error) {
+	obj := &types.Invoice{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("invoices", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadDetails(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *invoicesRepo) Create(ctx context.Context, shipmentID int64) (*types.Invoice, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, shipmentID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Invoice), nil
+}
+
+// CreateTx - invoices what went out on a shipped shipment at the order's prices, taxed where the
+// order ships to. An invoice with nothing to pay is paid as it's issued. It takes the next
+// number of the year, the year's sequence stays locked until the transaction ends so numbers are
+// never skipped.
+func (r *invoicesRepo) CreateTx(ctx context.Context, tx *xorm.Session, shipmentID int64) (*types.Invoice, error) {
+	shipment := &types.Shipment{}
+	exists, err := tx.Where("id = ?", shipmentID).Get(shipment)
+	if err != nil {
+		return nil, normalizeErr("shipments", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("shipment not found by id")
+	}
+
+	if shipment.Status != types.ShipmentStatusShipped {
+		return nil, types.NewBadRequestError("only shipped shipments can be invoiced")
+	}
+
+	invoiced, err := tx.Where("shipment_id = ?", shipmentID).Exist(&types.Invoice{})
+	if err != nil {
+		return nil, normalizeErr("invoices", err)
+	}
+	if invoiced {
+		return nil, types.NewBadRequestError("shipment is already invoiced")
+	}
+
+	order := &types.SalesOrder{}
+	if _, err := tx.Where("id = ?", shipment.SalesOrderID).Get(order); err != nil {
+		return nil, normalizeErr("sales_orders", err)
+	}
+
+	shipped := []*types.InvoiceLine{}
+	if err := tx.SQL(`
+		SELECT ci.sales_order_line_id, l.product_id, p.sku, p.name, SUM(ci.qty) AS qty, l.unit_price
+		FROM shipment_carton_items ci
+		JOIN shipment_cartons c ON c.id = ci.carton_id
+		JOIN sales_order_lines l ON l.id = ci.sales_order_line_id
+		JOIN products p ON p.id = l.product_id
+		WHERE c.shipment_id = ?
+		GROUP BY ci.sales_order_line_id, l.product_id, p.sku, p.name, l.unit_price
+		ORDER BY ci.sales_order_line_id`, shipmentID,
+	).Find(&shipped); err != nil {
+		return nil, normalizeErr("shipment_carton_items", err)
+	}
+
+	qtys := map[int64]int64{}
+	for _, line := range shipped {
+		qtys[line.SalesOrderLineID] = line.Qty
+	}
+
+	breakdown, err := r.taxRates.CalculateQtysTx(ctx, tx, order.ID, qtys)
+	if err != nil {
+		return nil, err
+	}
+	lineTax := map[int64]int64{}
+	for _, line := range breakdown.Lines {
+		lineTax[line.SalesOrderLineID] = line.Tax
+	}
+
+	now := time.Now()
+	obj := &types.Invoice{
+		SalesOrderID: order.ID,
+		ShipmentID:   shipment.ID,
+		CustomerID:   order.CustomerID,
+		CustomerName: order.CustomerName,
+		Status:       types.InvoiceStatusOpen,
+		Subtotal:     breakdown.Subtotal,
+		Tax:          breakdown.Tax,
+		Total:        breakdown.Total,
+		TermsDays:    30,
+		IssuedAt:     now,
+		CreatedAt:    now,
+	}
+
+	if order.CustomerID != nil {
+		customer := &types.Customer{}
+		exists, err := tx.Where("id = ?", *order.CustomerID).Get(customer)
+		if err != nil {
+			return nil, normalizeErr("customers", err)
+		}
+		if exists {
+			obj.TermsDays = customer.PaymentTermsDays
+
+			address := &types.CustomerAddress{}
+			exists, err := tx.Where("customer_id = ? AND kind = ? AND is_default", customer.ID, types.AddressKindBilling).
+				Get(address)
+			if err != nil {
+				return nil, normalizeErr("customer_addresses", err)
+			}
+			if exists {
+				obj.BillTo = utils.Ref(billTo(customer, address))
+			}
+		}
+	}
+	obj.DueAt = obj.IssuedAt.AddDate(0, 0, int(obj.TermsDays))
+
+	// nothing is owed, e.g. it's all tax exempt and free, so there's no payment to wait for
+	if obj.Total == 0 {
+		obj.Status = types.InvoiceStatusPaid
+		obj.PaidAt = &obj.IssuedAt
+	}
+
+	obj.Year = obj.IssuedAt.Year()
+	if _, err := tx.SQL(`
+		INSERT INTO invoice_sequences (year, last_number) VALUES (?, 1)
+		ON CONFLICT (year) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+		RETURNING last_number`, obj.Year,
+	).Get(&obj.Sequence); err != nil {
+		return nil, normalizeErr("invoice_sequences", err)
+	}
+	obj.Number = types.InvoiceNumber(obj.Year, obj.Sequence)
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("invoices", err)
+	}
+
+	obj.Lines = []*types.InvoiceLine{}
+	for _, line := range shipped {
+		line.InvoiceID = obj.ID
+		line.Amount = line.Qty * line.UnitPrice
+		line.Tax = lineTax[line.SalesOrderLineID]
+
+		if _, err := tx.Insert(line); err != nil {
+			return nil, normalizeErr("invoice_lines", err)
+		}
+		obj.Lines = append(obj.Lines, line)
+	}
+
+	obj.Taxes = []*types.InvoiceTax{}
+	for _, amount := range breakdown.Rates {
+		invoiceTax := &types.InvoiceTax{
+			InvoiceID: obj.ID,
+			TaxRateID: amount.TaxRateID,
+			Name:      amount.Name,
+			Rate:      amount.Rate,
+			Taxable:   amount.Taxable,
+			Tax:       amount.Tax,
+		}
+
+		if _, err := tx.Insert(invoiceTax); err != nil {
+			return nil, normalizeErr("invoice_taxes", err)
+		}
+		obj.Taxes = append(obj.Taxes, invoiceTax)
+	}
+
+	obj.Payments = []*types.InvoicePayment{}
+
+	return obj, nil
+}
+
+func (r *invoicesRepo) Pay(ctx context.Context, payment types.NewInvoicePayment) (*types.Invoice, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.PayTx(ctx, tx, payment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Invoice), nil
+}
+
+// PayTx - records a payment against an invoice, it's paid once nothing is left owing
+func (r *invoicesRepo) PayTx(ctx context.Context, tx *xorm.Session, payment types.NewInvoicePayment) (*types.Invoice, error) {
+	if err := types.Validate(payment); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj := &types.Invoice{}
+	exists, err := tx.Where("id = ?", payment.InvoiceID).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("invoices", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("invoice not found by id")
+	}
+
+	if obj.Status == types.InvoiceStatusPaid {
+		return nil, types.NewBadRequestError("invoice is already paid")
+	}
+
+	if payment.Amount > obj.Balance() {
+		return nil, types.NewBadRequestError("payment is more than the invoice's balance")
+	}
+
+	now := time.Now()
+	paid := &types.InvoicePayment{
+		InvoiceID: obj.ID,
+		Amount:    payment.Amount,
+		Method:    payment.Method,
+		Reference: payment.Reference,
+		PaidAt:    now,
+		CreatedAt: now,
+	}
+	if payment.PaidAt != nil {
+		paid.PaidAt = *payment.PaidAt
+	}
+
+	if _, err := tx.Insert(paid); err != nil {
+		return nil, normalizeErr("invoice_payments", err)
+	}
+
+	obj.AmountPaid += paid.Amount
+	if obj.Balance() == 0 {
+		obj.Status = types.InvoiceStatusPaid
+		obj.PaidAt = &paid.PaidAt
+	}
+	obj.UpdatedAt = utils.Ref(now)
+
+	if _, err := tx.ID(obj.ID).Cols("amount_paid", "status", "paid_at", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("invoices", err)
+	}
+
+	if err := r.loadDetails(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *invoicesRepo) loadDetails(tx *xorm.Session, invoices ...*types.Invoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	ids := []int64{}
+	byID := map[int64]*types.Invoice{}
+	for _, invoice := range invoices {
+		invoice.Lines = []*types.InvoiceLine{}
+		invoice.Taxes = []*types.InvoiceTax{}
+		invoice.Payments = []*types.InvoicePayment{}
+		ids = append(ids, invoice.ID)
+		byID[invoice.ID] = invoice
+	}
+
+	lines := []*types.InvoiceLine{}
+	if err := tx.In("invoice_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("invoice_lines", err)
+	}
+	for _, line := range lines {
+		byID[line.InvoiceID].Lines = append(byID[line.InvoiceID].Lines, line)
+	}
+
+	taxes := []*types.InvoiceTax{}
+	if err := tx.In("invoice_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&taxes); err != nil {
+		return normalizeErr("invoice_taxes", err)
+	}
+	for _, invoiceTax := range taxes {
+		byID[invoiceTax.InvoiceID].Taxes = append(byID[invoiceTax.InvoiceID].Taxes, invoiceTax)
+	}
+
+	payments := []*types.InvoicePayment{}
+	if err := tx.In("invoice_id", utils.Int64ArrToInterfaceArr(ids...)...).Find(&payments); err != nil {
+		return normalizeErr("invoice_payments", err)
+	}
+	sort.SliceStable(payments, func(i, j int) bool { return payments[i].PaidAt.Before(payments[j].PaidAt) })
+	for _, payment := range payments {
+		byID[payment.InvoiceID].Payments = append(byID[payment.InvoiceID].Payments, payment)
+	}
+
+	return nil
+}
+
+// billTo - a billing address the way it's printed, one line after another
+func billTo(customer *types.Customer, address *types.CustomerAddress) string {
+	lines := []string{customer.Name}
+	if address.Name != nil && *address.Name != customer.Name {
+		lines = append(lines, *address.Name)
+	}
+	lines = append(lines, address.Line1)
+	if address.Line2 != nil {
+		lines = append(lines, *address.Line2)
+	}
+
+	city := address.City
+	if address.Region != nil {
+		city += ", " + *address.Region
+	}
+	if address.PostalCode != nil {
+		city += " " + *address.PostalCode
+	}
+	lines = append(lines, city, address.Country)
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,180 @@
+package repos_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Invoices", func() {
+
+	var (
+		repo     repos.Invoices
+		product  *types.Product
+		customer *types.Customer
+	)
+
+	BeforeEach(func() {
+		clearDatabase("invoices", "invoice_sequences", "shipments", "pick_lists", "sales_orders", "stock_levels",
+			"locations", "products", "customers", "tax_rates")
+
+		repo = gr.Invoices()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		customer, err = gr.Customers().Create(ctx, types.NewCustomer{
+			Name: "Jane Doe", Code: "JDOE", PaymentTermsDays: utils.Ref[int64](15),
+			Addresses: []types.NewCustomerAddress{
+				{Kind: types.AddressKindShipping, Line1: "1 Main St", City: "Austin", Region: utils.Ref("TX"), Country: "US"},
+				{Kind: types.AddressKindBilling, Line1: "PO Box 5", City: "Austin", Region: utils.Ref("TX"), PostalCode: utils.Ref("78701"), Country: "US"},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		_, err = gr.TaxRates().Create(ctx, types.NewTaxRate{Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 6.25})
+		Expect(err).To(BeNil())
+	})
+
+	// ships picked of an order of qty at unitPrice each
+	ship := func(qty, picked, unitPrice int64) *types.Shipment {
+		order, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+			CustomerID: &customer.ID,
+			Lines:      []types.NewSalesOrderLine{{ProductID: product.ID, Qty: qty, UnitPrice: unitPrice}},
+		})
+		Expect(err).To(BeNil())
+
+		_, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+		Expect(err).To(BeNil())
+
+		list, err := gr.PickLists().Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+		Expect(err).To(BeNil())
+
+		list, err = gr.PickLists().Pick(ctx, &types.PickPickList{
+			ID: list.ID, Lines: []types.PickedLine{{PickListLineID: list.Lines[0].ID, QtyPicked: picked}},
+		})
+		Expect(err).To(BeNil())
+
+		shipment, err := gr.Shipments().Create(ctx, types.NewShipment{
+			PickListID: list.ID,
+			Cartons:    []types.NewCarton{{Items: []types.NewCartonItem{{PickListLineID: list.Lines[0].ID, Qty: picked}}}},
+		})
+		Expect(err).To(BeNil())
+
+		shipment, err = gr.Shipments().Ship(ctx, &types.ShipShipment{ID: shipment.ID, Carrier: "UPS", TrackingNumber: "1Z999"})
+		Expect(err).To(BeNil())
+		return shipment
+	}
+
+	Context("Create(Tx)", func() {
+		It("should invoice what was shipped with tax when it's shipped", func() {
+			shipment := ship(3, 2, 1000)
+
+			invoices, count, err := repo.Find(ctx, &repos.InvoicesFind{SalesOrderIDs: []int64{shipment.SalesOrderID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+
+			invoice := invoices[0]
+			Expect(invoice.ShipmentID).To(Equal(shipment.ID))
+			Expect(invoice.Number).To(Equal(types.InvoiceNumber(time.Now().Year(), 1)))
+			Expect(invoice.Status).To(Equal(types.InvoiceStatusOpen))
+			Expect(invoice.Subtotal).To(BeNumerically("==", 2000))
+			Expect(invoice.Tax).To(BeNumerically("==", 125))
+			Expect(invoice.Total).To(BeNumerically("==", 2125))
+			Expect(invoice.TermsDays).To(BeNumerically("==", 15))
+			Expect(invoice.DueAt).To(BeTemporally("~", invoice.IssuedAt.AddDate(0, 0, 15), time.Second))
+			Expect(*invoice.BillTo).To(ContainSubstring("PO Box 5"))
+
+			Expect(invoice.Lines).To(HaveLen(1))
+			Expect(invoice.Lines[0].Qty).To(BeNumerically("==", 2))
+			Expect(invoice.Lines[0].Amount).To(BeNumerically("==", 2000))
+			Expect(invoice.Lines[0].Tax).To(BeNumerically("==", 125))
+			Expect(invoice.Taxes).To(HaveLen(1))
+			Expect(invoice.Taxes[0].Name).To(Equal("Texas"))
+		})
+
+		It("should number invoices in sequence", func() {
+			ship(1, 1, 1000)
+			ship(1, 1, 1000)
+
+			invoices, _, err := repo.Find(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(invoices).To(HaveLen(2))
+			// newest first
+			Expect(invoices[0].Sequence).To(BeNumerically("==", 2))
+			Expect(invoices[1].Sequence).To(BeNumerically("==", 1))
+		})
+
+		It("should issue an invoice with nothing to pay as paid", func() {
+			shipment := ship(1, 1, 0)
+
+			invoices, _, err := repo.Find(ctx, &repos.InvoicesFind{SalesOrderIDs: []int64{shipment.SalesOrderID}})
+			Expect(err).To(BeNil())
+			Expect(invoices).To(HaveLen(1))
+
+			invoice := invoices[0]
+			Expect(invoice.Total).To(BeNumerically("==", 0))
+			Expect(invoice.Status).To(Equal(types.InvoiceStatusPaid))
+			Expect(invoice.PaidAt).NotTo(BeNil())
+		})
+
+		It("should not invoice a shipment twice", func() {
+			shipment := ship(1, 1, 1000)
+
+			_, err := repo.Create(ctx, shipment.ID)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should return not found for an unknown shipment", func() {
+			_, err := repo.Create(ctx, 99999999)
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Pay(Tx)", func() {
+		var invoice *types.Invoice
+
+		BeforeEach(func() {
+			ship(1, 1, 1000)
+
+			invoices, _, err := repo.Find(ctx, nil)
+			Expect(err).To(BeNil())
+			invoice = invoices[0]
+		})
+
+		It("should fail with invalid payments", func() {
+			_, err := repo.Pay(ctx, types.NewInvoicePayment{InvoiceID: invoice.ID, Method: "card"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Pay(ctx, types.NewInvoicePayment{InvoiceID: invoice.ID, Amount: invoice.Total + 1, Method: "card"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should return not found for an unknown invoice", func() {
+			_, err := repo.Pay(ctx, types.NewInvoicePayment{InvoiceID: 99999999, Amount: 100, Method: "card"})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should be paid once nothing is owing", func() {
+			paid, err := repo.Pay(ctx, types.NewInvoicePayment{InvoiceID: invoice.ID, Amount: 500, Method: "card"})
+			Expect(err).To(BeNil())
+			Expect(paid.Status).To(Equal(types.InvoiceStatusOpen))
+			Expect(paid.Balance()).To(BeNumerically("==", invoice.Total-500))
+
+			paid, err = repo.Pay(ctx, types.NewInvoicePayment{InvoiceID: invoice.ID, Amount: paid.Balance(), Method: "wire"})
+			Expect(err).To(BeNil())
+			Expect(paid.Status).To(Equal(types.InvoiceStatusPaid))
+			Expect(paid.PaidAt).NotTo(BeNil())
+			Expect(paid.Payments).To(HaveLen(2))
+
+			_, err = repo.Pay(ctx, types.NewInvoicePayment{InvoiceID: invoice.ID, Amount: 1, Method: "card"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,382 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type KitsFind struct {
+	Limit  int
+	Offset int
+	IDs    []int64
+	// ComponentIDs - only kits built from any of these products
+	ComponentIDs []int64
+}
+
+//go:generate mockgen -source=./kits.go -destination=./mocks/Kits.go -package=mock_repos Kits
+type Kits interface {
+	Find(ctx context.Context, opts *KitsFind) ([]*types.Kit, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *KitsFind) ([]*types.Kit, int64, error)
+	Get(ctx context.Context, id int64) (*types.Kit, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Kit, bool, error)
+	Create(ctx context.Context, newKit types.NewKit) (*types.Kit, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newKit types.NewKit) (*types.Kit, error)
+	Update(ctx context.Context, diff *types.UpdateKit) (*types.Kit, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateKit) (*types.Kit, error)
+	Assemble(ctx context.Context, assemble *types.AssembleKit) (*types.Kit, error)
+	AssembleTx(ctx context.Context, tx *xorm.Session, assemble *types.AssembleKit) (*types.Kit, error)
+}
+
+// NewKits - building a kit takes its components off hand and out of their locations so it goes
+// through those repos and writes both sides to the ledger in the same transaction
+func NewKits(db *xorm.Engine, products Products, stockLevels StockLevels, stockMovements StockMovements) Kits {
+	return &kitsRepo{db, products, stockLevels, stockMovements}
+}
+
+type kitsRepo struct {
+	db             *xorm.Engine
+	products       Products
+	stockLevels    StockLevels
+	stockMovements StockMovements
+}
+
+// kitReference - the reference type kit builds write to the ledger
+var kitReference = "kit"
+
+func (r *kitsRepo) Find(ctx context.Context, opts *KitsFind) ([]*types.Kit, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		k, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return k, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Kit), count, nil
+}
+
+func (r *kitsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *KitsFind) ([]*types.Kit, int64, error) {
+	if opts == nil {
+		opts = &KitsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	tx = tx.Where("kit")
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.ComponentIDs) > 0 {
+		tx = tx.Where("id IN (SELECT kit_id FROM kit_components WHERE component_id IN ("+
+			strings.TrimSuffix(strings.Repeat("?,", len(opts.ComponentIDs)), ",")+"))",
+			utils.Int64ArrToInterfaceArr(opts.ComponentIDs...)...)
+	}
+
+	products := []*types.Product{}
+	count, err := tx.OrderBy("id").FindAndCount(&products)
+	if err != nil {
+		return nil, 0, normalizeErr("products", err)
+	}
+
+	objs, err := r.load(tx, products...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *kitsRepo) Get(ctx context.Context, id int64) (*types.Kit, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		k, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return k, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Kit), exists, nil
+}
+
+func (r *kitsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Kit, bool, error) {
+	product := &types.Product{}
+	exists, err := tx.Where("id = ? AND kit", id).Get(product)
+	if err != nil {
+		return nil, false, normalizeErr("products", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	objs, err := r.load(tx, product)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return objs[0], exists, nil
+}
+
+func (r *kitsRepo) Create(ctx context.Context, newKit types.NewKit) (*types.Kit, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newKit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Kit), nil
+}
+
+// CreateTx - a kit is a product of its own with nothing on hand, it gets stock as it's built
+func (r *kitsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newKit types.NewKit) (*types.Kit, error) {
+	if err := types.Validate(newKit); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	product := &types.Product{
+		Name:       newKit.Name,
+		Sku:        newKit.Sku,
+		Kit:        true,
+		CostMethod: types.CostMethodFIFO,
+		CreatedAt:  time.Now(),
+	}
+
+	if _, err := tx.Insert(product); err != nil {
+		return nil, normalizeErr("products", err)
+	}
+
+	if err := r.insertComponents(tx, product.ID, newKit.Components); err != nil {
+		return nil, err
+	}
+
+	objs, err := r.load(tx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs[0], nil
+}
+
+func (r *kitsRepo) Update(ctx context.Context, diff *types.UpdateKit) (*types.Kit, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Kit), nil
+}
+
+// UpdateTx - replaces the bill of materials, kits that are already built keep what went into them
+func (r *kitsRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateKit) (*types.Kit, error) {
+	if err := types.Validate(diff); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	product := &types.Product{}
+	exists, err := tx.Where("id = ? AND kit", diff.ID).ForUpdate().Get(product)
+	if err != nil {
+		return nil, normalizeErr("products", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("kit not found by id")
+	}
+
+	if _, err := tx.Where("kit_id = ?", product.ID).Delete(&types.KitComponent{}); err != nil {
+		return nil, normalizeErr("kit_components", err)
+	}
+
+	if err := r.insertComponents(tx, product.ID, diff.Components); err != nil {
+		return nil, err
+	}
+
+	objs, err := r.load(tx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs[0], nil
+}
+
+func (r *kitsRepo) Assemble(ctx context.Context, assemble *types.AssembleKit) (*types.Kit, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.AssembleTx(ctx, tx, assemble)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Kit), nil
+}
+
+// AssembleTx - builds kits. Each component has to have enough available stock for all of
+// them, see consumeStock. The kits are added to the kit's qty outside of any location at what
+// their components were worth and both sides are written to the ledger.
+func (r *kitsRepo) AssembleTx(ctx context.Context, tx *xorm.Session, assemble *types.AssembleKit) (*types.Kit, error) {
+	if err := types.Validate(assemble); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	kit := &types.Product{}
+	exists, err := tx.Where("id = ? AND kit", assemble.ID).ForUpdate().Get(kit)
+	if err != nil {
+		return nil, normalizeErr("products", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("kit not found by id")
+	}
+
+	components := []*types.KitComponent{}
+	if err := tx.Where("kit_id = ?", kit.ID).OrderBy("component_id").Find(&components); err != nil {
+		return nil, normalizeErr("kit_components", err)
+	}
+	if len(components) == 0 {
+		return nil, types.NewBadRequestError("kit " + kit.Sku + " has no components")
+	}
+
+	var cost int64
+	for _, component := range components {
+		value, err := consumeStock(ctx, tx, r.products, r.stockLevels, r.stockMovements, component.ComponentID, assemble.Qty*component.Qty, types.NewStockMovement{
+			Reason:        types.StockMovementReasonKit,
+			ReferenceType: &kitReference,
+			ReferenceID:   &kit.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cost += value
+	}
+
+	if _, err := r.products.AdjustTx(ctx, tx, kit.ID, types.ProductAdjustment{
+		Qty: assemble.Qty, UnitCost: utils.Ref(cost / assemble.Qty),
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.stockMovements.CreateTx(ctx, tx, types.NewStockMovement{
+		ProductID:     kit.ID,
+		Qty:           assemble.Qty,
+		Reason:        types.StockMovementReasonKit,
+		ReferenceType: &kitReference,
+		ReferenceID:   &kit.ID,
+	}); err != nil {
+		return nil, err
+	}
+
+	obj, _, err := r.GetTx(ctx, tx, kit.ID)
+	return obj, err
+}
+
+// insertComponents - kits can't be nested and lot tracked or serialized products can't go into
+// them, building a kit doesn't know which lot or serial it used
+func (r *kitsRepo) insertComponents(tx *xorm.Session, kitID int64, newComponents []types.NewKitComponent) error {
+	seen := map[int64]bool{}
+	for _, nc := range newComponents {
+		if seen[nc.ComponentID] {
+			return types.NewBadRequestError("a component can only be on a kit once")
+		}
+		seen[nc.ComponentID] = true
+
+		product := &types.Product{}
+		exists, err := tx.Where("id = ?", nc.ComponentID).Get(product)
+		if err != nil {
+			return normalizeErr("products", err)
+		}
+		if !exists {
+			return types.NewNotFoundError("component product not found by id")
+		}
+
+		if product.Kit {
+			return types.NewBadRequestError("a kit can not be a component of another kit")
+		}
+		if product.LotTracked || product.Serialized {
+			return types.NewBadRequestError("lot tracked and serialized products can not be kit components")
+		}
+
+		component := &types.KitComponent{
+			KitID:       kitID,
+			ComponentID: nc.ComponentID,
+			Qty:         nc.Qty,
+			CreatedAt:   time.Now(),
+		}
+
+		if _, err := tx.Insert(component); err != nil {
+			return normalizeErr("kit_components", err)
+		}
+	}
+
+	return nil
+}
+
+// load - wraps kit products with their components and works out what's available
+func (r *kitsRepo) load(tx *xorm.Session, products ...*types.Product) ([]*types.Kit, error) {
+	objs := []*types.Kit{}
+	if len(products) == 0 {
+		return objs, nil
+	}
+
+	byID := map[int64]*types.Kit{}
+	ids := []int64{}
+	for _, product := range products {
+		obj := &types.Kit{Product: product, Components: []*types.KitComponent{}}
+		byID[product.ID] = obj
+		ids = append(ids, product.ID)
+		objs = append(objs, obj)
+	}
+
+	components := []*types.KitComponent{}
+	if err := tx.In("kit_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&components); err != nil {
+		return nil, normalizeErr("kit_components", err)
+	}
+
+	componentIDs := []int64{}
+	for _, component := range components {
+		componentIDs = append(componentIDs, component.ComponentID)
+	}
+
+	available := map[int64]int64{}
+	if len(componentIDs) > 0 {
+		stocked := []*types.Product{}
+		if err := tx.In("id", utils.Int64ArrToInterfaceArr(componentIDs...)...).Find(&stocked); err != nil {
+			return nil, normalizeErr("products", err)
+		}
+		for _, product := range stocked {
+			available[product.ID] = product.Available()
+		}
+	}
+
+	for _, component := range components {
+		component.Available = available[component.ComponentID]
+		byID[component.KitID].Components = append(byID[component.KitID].Components, component)
+	}
+
+	for _, obj := range objs {
+		obj.Available = max(obj.Product.Available(), 0) + obj.Buildable()
+	}
+
+	return objs, nil
+}
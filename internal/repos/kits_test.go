@@ -0,0 +1,174 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Kits", func() {
+
+	var (
+		repo    repos.Kits
+		base    *types.Product
+		filter  *types.Product
+		manual  *types.Product
+		starter *types.Kit
+	)
+
+	BeforeEach(func() {
+		clearDatabase("kit_components", "stock_movements", "sales_orders", "sales_order_lines", "stock_levels", "locations", "products")
+
+		repo = gr.Kits()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		base, err = gr.Products().Create(ctx, types.NewProduct{Name: "base", Sku: "base", Qty: 3})
+		Expect(err).To(BeNil())
+		filter, err = gr.Products().Create(ctx, types.NewProduct{Name: "filter", Sku: "filter", Qty: 5})
+		Expect(err).To(BeNil())
+		manual, err = gr.Products().Create(ctx, types.NewProduct{Name: "manual", Sku: "manual", Qty: 10})
+		Expect(err).To(BeNil())
+
+		starter, err = repo.Create(ctx, types.NewKit{
+			Name: "starter kit",
+			Sku:  "starter",
+			Components: []types.NewKitComponent{
+				{ComponentID: base.ID, Qty: 1},
+				{ComponentID: filter.ID, Qty: 2},
+				{ComponentID: manual.ID, Qty: 1},
+			},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	qtyOf := func(id int64) int64 {
+		p, _, err := gr.Products().Get(ctx, id)
+		Expect(err).To(BeNil())
+		return p.Qty
+	}
+
+	Context("Create(Tx)", func() {
+		It("should work out availability from the scarcest component", func() {
+			Expect(starter.Product.Kit).To(BeTrue())
+			Expect(starter.Product.Qty).To(BeNumerically("==", 0))
+			Expect(starter.Components).To(HaveLen(3))
+			// 5 filters only make 2 kits
+			Expect(starter.Available).To(BeNumerically("==", 2))
+		})
+
+		It("should fail with unknown, repeated or nested components", func() {
+			_, err := repo.Create(ctx, types.NewKit{Name: "empty", Sku: "empty"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewKit{Name: "unknown", Sku: "unknown",
+				Components: []types.NewKitComponent{{ComponentID: 99999999, Qty: 1}}})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewKit{Name: "twice", Sku: "twice",
+				Components: []types.NewKitComponent{{ComponentID: base.ID, Qty: 1}, {ComponentID: base.ID, Qty: 1}}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewKit{Name: "nested", Sku: "nested",
+				Components: []types.NewKitComponent{{ComponentID: starter.Product.ID, Qty: 1}}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Find(Tx)", func() {
+		It("should find kits by component", func() {
+			kits, count, err := repo.Find(ctx, &repos.KitsFind{ComponentIDs: []int64{filter.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(kits[0].Product.ID).To(Equal(starter.Product.ID))
+
+			_, count, err = repo.Find(ctx, &repos.KitsFind{ComponentIDs: []int64{starter.Product.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 0))
+		})
+	})
+
+	Context("Update(Tx)", func() {
+		It("should replace the bill of materials", func() {
+			kit, err := repo.Update(ctx, &types.UpdateKit{ID: starter.Product.ID,
+				Components: []types.NewKitComponent{{ComponentID: manual.ID, Qty: 5}}})
+			Expect(err).To(BeNil())
+			Expect(kit.Components).To(HaveLen(1))
+			Expect(kit.Available).To(BeNumerically("==", 2))
+
+			_, err = repo.Update(ctx, &types.UpdateKit{ID: base.ID,
+				Components: []types.NewKitComponent{{ComponentID: manual.ID, Qty: 1}}})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Assemble(Tx)", func() {
+		It("should take the components off hand, from their locations first", func() {
+			location, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+			Expect(err).To(BeNil())
+			_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: filter.ID, LocationID: location.ID, Qty: utils.Ref[int64](3)})
+			Expect(err).To(BeNil())
+
+			// only 3 bases
+			_, err = repo.Assemble(ctx, &types.AssembleKit{ID: starter.Product.ID, Qty: 4})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			kit, err := repo.Assemble(ctx, &types.AssembleKit{ID: starter.Product.ID, Qty: 2})
+			Expect(err).To(BeNil())
+			Expect(kit.Product.Qty).To(BeNumerically("==", 2))
+			Expect(kit.Available).To(BeNumerically("==", 3))
+
+			Expect(qtyOf(base.ID)).To(BeNumerically("==", 1))
+			Expect(qtyOf(filter.ID)).To(BeNumerically("==", 4))
+			Expect(qtyOf(manual.ID)).To(BeNumerically("==", 8))
+
+			levels, _, err := gr.StockLevels().Find(ctx, &repos.StockLevelsFind{ProductIDs: []int64{filter.ID}})
+			Expect(err).To(BeNil())
+			Expect(levels[0].Qty).To(BeNumerically("==", 0))
+
+			movements, count, err := gr.StockMovements().Find(ctx, &repos.StockMovementsFind{
+				Reasons: []types.StockMovementReason{types.StockMovementReasonKit},
+			})
+			Expect(err).To(BeNil())
+			// filters come from the location and then from unassigned stock
+			Expect(count).To(BeNumerically("==", 5))
+			Expect(movements).To(HaveLen(5))
+		})
+	})
+
+	Context("selling kits", func() {
+		It("should build kits as orders are confirmed and backorder what can't be built", func() {
+			order, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+				CustomerName: "Jane Doe",
+				Lines:        []types.NewSalesOrderLine{{ProductID: starter.Product.ID, Qty: 3, UnitPrice: 4999}},
+			})
+			Expect(err).To(BeNil())
+
+			order, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+			Expect(order.Lines).To(HaveLen(2))
+			Expect(order.Lines[0].QtyAllocated).To(BeNumerically("==", 2))
+			Expect(order.Lines[1].Backorder).To(BeTrue())
+
+			Expect(qtyOf(starter.Product.ID)).To(BeNumerically("==", 2))
+			Expect(qtyOf(filter.ID)).To(BeNumerically("==", 1))
+
+			// two more filters let the backorder be built
+			_, err = gr.Products().Adjust(ctx, filter.ID, types.ProductAdjustment{Qty: 2})
+			Expect(err).To(BeNil())
+
+			lines, err := gr.SalesOrders().Allocate(ctx, filter.ID)
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0].ProductID).To(Equal(starter.Product.ID))
+
+			kit, _, err := repo.Get(ctx, starter.Product.ID)
+			Expect(err).To(BeNil())
+			Expect(kit.Product.Qty).To(BeNumerically("==", 3))
+			Expect(kit.Product.Allocated).To(BeNumerically("==", 3))
+			Expect(kit.Available).To(BeNumerically("==", 0))
+		})
+	})
+})
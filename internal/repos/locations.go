@@ -0,0 +1,252 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type LocationsFind struct {
+	Limit  int
+	Offset int
+	IDs    []int64
+	Codes  []string
+	// ParentIDs - only the locations directly inside these
+	ParentIDs []int64
+	Kinds     []types.LocationKind
+}
+
+//go:generate mockgen -source=./locations.go -destination=./mocks/Locations.go -package=mock_repos Locations
+type Locations interface {
+	Find(ctx context.Context, opts *LocationsFind) ([]*types.Location, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *LocationsFind) ([]*types.Location, int64, error)
+	Get(ctx context.Context, id int64) (*types.Location, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Location, bool, error)
+	Create(ctx context.Context, newLocation types.NewLocation) (*types.Location, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newLocation types.NewLocation) (*types.Location, error)
+	Update(ctx context.Context, diff *types.UpdateLocation) (*types.Location, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateLocation) (*types.Location, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+}
+
+func NewLocations(db *xorm.Engine) Locations {
+	return &locationsRepo{db}
+}
+
+type locationsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *locationsRepo) Find(ctx context.Context, opts *LocationsFind) ([]*types.Location, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return l, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Location), count, nil
+}
+
+func (r *locationsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *LocationsFind) ([]*types.Location, int64, error) {
+	if opts == nil {
+		opts = &LocationsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.Codes) > 0 {
+		tx = tx.In("code", utils.StringArrToInterfaceArr(opts.Codes...)...)
+	}
+
+	if len(opts.ParentIDs) > 0 {
+		tx = tx.In("parent_id", utils.Int64ArrToInterfaceArr(opts.ParentIDs...)...)
+	}
+
+	if len(opts.Kinds) > 0 {
+		kinds := []interface{}{}
+		for _, kind := range opts.Kinds {
+			kinds = append(kinds, kind)
+		}
+		tx = tx.In("kind", kinds...)
+	}
+
+	objs := []*types.Location{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("locations", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *locationsRepo) Get(ctx context.Context, id int64) (*types.Location, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return l, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Location), exists, nil
+}
+
+func (r *locationsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Location, bool, error) {
+	obj := &types.Location{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("locations", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *locationsRepo) Create(ctx context.Context, newLocation types.NewLocation) (*types.Location, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newLocation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Location), nil
+}
+
+// CreateTx - a location other than a warehouse has to sit inside one of a broader kind, a bin
+// can go on a shelf but not the other way around
+func (r *locationsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newLocation types.NewLocation) (*types.Location, error) {
+	obj := &types.Location{
+		Name:         newLocation.Name,
+		Code:         newLocation.Code,
+		ParentID:     newLocation.ParentID,
+		Kind:         newLocation.Kind,
+		PickSequence: newLocation.PickSequence,
+		CreatedAt:    time.Now(),
+	}
+
+	if obj.Kind == "" {
+		obj.Kind = types.LocationKindWarehouse
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if obj.ParentID == nil && obj.Kind != types.LocationKindWarehouse {
+		return nil, types.NewBadRequestError("a " + string(obj.Kind) + " has to be inside another location")
+	}
+
+	if obj.ParentID != nil {
+		parent, exists, err := r.GetTx(ctx, tx, *obj.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("parent location not found by id")
+		}
+
+		if !parent.Kind.CanContain(obj.Kind) {
+			return nil, types.NewBadRequestError("a " + string(parent.Kind) + " can not hold a " + string(obj.Kind))
+		}
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("locations", err)
+	}
+
+	return obj, nil
+}
+
+func (r *locationsRepo) Update(ctx context.Context, diff *types.UpdateLocation) (*types.Location, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Location), nil
+}
+
+func (r *locationsRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateLocation) (*types.Location, error) {
+	obj, exists, err := r.GetTx(ctx, tx, diff.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, types.NewNotFoundError("location not found by id")
+	}
+
+	if diff.Name != nil {
+		obj.Name = *diff.Name
+	}
+
+	if diff.Code != nil {
+		obj.Code = *diff.Code
+	}
+
+	if diff.PickSequence != nil {
+		obj.PickSequence = *diff.PickSequence
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(diff.ID).Cols("name", "code", "pick_sequence", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("locations", err)
+	}
+
+	return obj, nil
+}
+
+func (r *locationsRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+// DestroyTx - a location still holding stock can not be removed
+func (r *locationsRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	count, err := tx.Where("id = ?", id).Delete(&types.Location{})
+	if err != nil {
+		return normalizeErr("locations", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("location not found")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Locations", func() {
+
+	var (
+		repo repos.Locations
+	)
+
+	BeforeEach(func() {
+		clearDatabase("locations", "stock_levels", "products")
+
+		repo = gr.Locations()
+		Expect(repo).NotTo(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should fail with invalid locations", func() {
+			_, err := repo.Create(ctx, types.NewLocation{})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewLocation{Name: "Main"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should not allow duplicate codes", func() {
+			_, err := repo.Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewLocation{Name: "Other", Code: "MAIN"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Create(Tx) inside a warehouse", func() {
+		It("should only nest locations inside broader ones", func() {
+			main, err := repo.Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+			Expect(err).To(BeNil())
+			Expect(main.Kind).To(Equal(types.LocationKindWarehouse))
+
+			// a bin has to be inside something
+			_, err = repo.Create(ctx, types.NewLocation{Name: "Bin", Code: "BIN", Kind: types.LocationKindBin})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewLocation{Name: "Bin", Code: "BIN", Kind: types.LocationKindBin, ParentID: utils.Ref[int64](99999999)})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			shelf, err := repo.Create(ctx, types.NewLocation{Name: "Shelf 1", Code: "MAIN-A-01", Kind: types.LocationKindShelf, ParentID: &main.ID})
+			Expect(err).To(BeNil())
+
+			bin, err := repo.Create(ctx, types.NewLocation{Name: "Bin 3", Code: "MAIN-A-01-03", Kind: types.LocationKindBin, ParentID: &shelf.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewLocation{Name: "Zone", Code: "MAIN-Z", Kind: types.LocationKindZone, ParentID: &bin.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			children, count, err := repo.Find(ctx, &repos.LocationsFind{ParentIDs: []int64{main.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(children[0].ID).To(Equal(shelf.ID))
+		})
+	})
+
+	Context("location data creation", func() {
+		var location *types.Location
+
+		BeforeEach(func() {
+			var err error
+			location, err = repo.Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewLocation{Name: "Overflow", Code: "OVER"})
+			Expect(err).To(BeNil())
+		})
+
+		Context("Find(Tx)", func() {
+			It("should find by code", func() {
+				locations, count, err := repo.Find(ctx, &repos.LocationsFind{Codes: []string{"OVER"}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(locations[0].Name).To(Equal("Overflow"))
+			})
+		})
+
+		Context("Update(Tx)", func() {
+			It("should return not found for an unknown location", func() {
+				_, err := repo.Update(ctx, &types.UpdateLocation{ID: 99999999, Name: utils.Ref("x")})
+				Expect(types.IsNotFoundError(err)).To(BeTrue())
+			})
+
+			It("should successfully update", func() {
+				updated, err := repo.Update(ctx, &types.UpdateLocation{ID: location.ID, Name: utils.Ref("Main Warehouse")})
+				Expect(err).To(BeNil())
+				Expect(updated.Name).To(Equal("Main Warehouse"))
+				Expect(updated.Code).To(Equal("MAIN"))
+			})
+		})
+
+		Context("Destroy(Tx)", func() {
+			It("should not destroy a location holding stock", func() {
+				product, err := gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+				Expect(err).To(BeNil())
+
+				_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{
+					ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(5)),
+				})
+				Expect(err).To(BeNil())
+
+				Expect(types.IsBadRequestError(repo.Destroy(ctx, location.ID))).To(BeTrue())
+			})
+
+			It("should successfully destroy a location", func() {
+				Expect(repo.Destroy(ctx, location.ID)).To(Succeed())
+				Expect(types.IsNotFoundError(repo.Destroy(ctx, location.ID))).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,258 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type LotsFind struct {
+	Limit      int
+	Offset     int
+	IDs        []int64
+	ProductIDs []int64
+	LotNumbers []string
+	// InStock - only lots with units on hand
+	InStock bool
+}
+
+//go:generate mockgen -source=./lots.go -destination=./mocks/Lots.go -package=mock_repos Lots
+type Lots interface {
+	Find(ctx context.Context, opts *LotsFind) ([]*types.Lot, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *LotsFind) ([]*types.Lot, int64, error)
+	Get(ctx context.Context, id int64) (*types.Lot, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Lot, bool, error)
+	Receive(ctx context.Context, productID int64, newLot types.NewLot, qty int64) (*types.Lot, error)
+	ReceiveTx(ctx context.Context, tx *xorm.Session, productID int64, newLot types.NewLot, qty int64) (*types.Lot, error)
+	Adjust(ctx context.Context, id, delta int64) (*types.Lot, error)
+	AdjustTx(ctx context.Context, tx *xorm.Session, id, delta int64) (*types.Lot, error)
+}
+
+// NewLots - lots only hold the split of a product's stock by batch, whoever moves the stock
+// keeps its lots in step in the same transaction
+func NewLots(db *xorm.Engine) Lots {
+	return &lotsRepo{db}
+}
+
+type lotsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *lotsRepo) Find(ctx context.Context, opts *LotsFind) ([]*types.Lot, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return l, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Lot), count, nil
+}
+
+// FindTx - lots come back first expiry first, the order they're picked in
+func (r *lotsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *LotsFind) ([]*types.Lot, int64, error) {
+	if opts == nil {
+		opts = &LotsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.LotNumbers) > 0 {
+		lotNumbers := []interface{}{}
+		for _, lotNumber := range opts.LotNumbers {
+			lotNumbers = append(lotNumbers, lotNumber)
+		}
+		tx = tx.In("lot_number", lotNumbers...)
+	}
+
+	if opts.InStock {
+		tx = tx.Where("qty > 0")
+	}
+
+	objs := []*types.Lot{}
+	count, err := tx.OrderBy("expires_at NULLS LAST, id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("lots", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *lotsRepo) Get(ctx context.Context, id int64) (*types.Lot, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return l, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Lot), exists, nil
+}
+
+func (r *lotsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Lot, bool, error) {
+	obj := &types.Lot{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("lots", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *lotsRepo) Receive(ctx context.Context, productID int64, newLot types.NewLot, qty int64) (*types.Lot, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReceiveTx(ctx, tx, productID, newLot, qty)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Lot), nil
+}
+
+// ReceiveTx - adds qty to the product's lot with this number, creating it the first time it's
+// seen. A lot's dates can't change once it exists, later receipts can leave them out.
+func (r *lotsRepo) ReceiveTx(ctx context.Context, tx *xorm.Session, productID int64, newLot types.NewLot, qty int64) (*types.Lot, error) {
+	if err := types.Validate(newLot); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if newLot.ManufacturedAt != nil && newLot.ExpiresAt != nil && !newLot.ExpiresAt.After(*newLot.ManufacturedAt) {
+		return nil, types.NewBadRequestError("lot can not expire before it was manufactured")
+	}
+
+	obj := &types.Lot{}
+	exists, err := tx.Where("product_id = ? AND lot_number = ?", productID, newLot.LotNumber).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("lots", err)
+	}
+
+	if !exists {
+		obj = &types.Lot{
+			ProductID:      productID,
+			LotNumber:      newLot.LotNumber,
+			ManufacturedAt: newLot.ManufacturedAt,
+			ExpiresAt:      newLot.ExpiresAt,
+			Qty:            qty,
+			CreatedAt:      time.Now(),
+		}
+
+		if _, err := tx.Insert(obj); err != nil {
+			return nil, normalizeErr("lots", err)
+		}
+		return obj, nil
+	}
+
+	if datesConflict(obj.ExpiresAt, newLot.ExpiresAt) || datesConflict(obj.ManufacturedAt, newLot.ManufacturedAt) {
+		return nil, types.NewBadRequestError("lot " + obj.LotNumber + " was already received with different dates")
+	}
+
+	return adjustLot(tx, obj, qty)
+}
+
+func (r *lotsRepo) Adjust(ctx context.Context, id, delta int64) (*types.Lot, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.AdjustTx(ctx, tx, id, delta)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Lot), nil
+}
+
+func (r *lotsRepo) AdjustTx(ctx context.Context, tx *xorm.Session, id, delta int64) (*types.Lot, error) {
+	obj := &types.Lot{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("lots", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("lot not found by id")
+	}
+
+	return adjustLot(tx, obj, delta)
+}
+
+// keepLotTx - keeps a lot tracked product's lots in step when its stock changes other than by a
+// receipt or shipment, those see to their own lots. The lot the units went into or came out of is
+// needed, products that don't track lots can't be given one.
+func keepLotTx(tx *xorm.Session, product *types.Product, lotID *int64, delta int64) error {
+	if !product.LotTracked {
+		if lotID != nil {
+			return types.NewBadRequestError("product " + product.Sku + " doesn't track lots")
+		}
+		return nil
+	}
+
+	if delta == 0 {
+		return nil
+	}
+
+	if lotID == nil {
+		return types.NewBadRequestError("a lot is required for lot tracked product " + product.Sku)
+	}
+
+	obj := &types.Lot{}
+	exists, err := tx.Where("id = ? AND product_id = ?", *lotID, product.ID).ForUpdate().Get(obj)
+	if err != nil {
+		return normalizeErr("lots", err)
+	}
+	if !exists {
+		return types.NewNotFoundError("lot not found for product " + product.Sku)
+	}
+
+	_, err = adjustLot(tx, obj, delta)
+	return err
+}
+
+func adjustLot(tx *xorm.Session, obj *types.Lot, delta int64) (*types.Lot, error) {
+	if obj.Qty+delta < 0 {
+		return nil, types.NewBadRequestError("not enough stock in lot " + obj.LotNumber)
+	}
+
+	obj.Qty += delta
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols("qty", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("lots", err)
+	}
+
+	return obj, nil
+}
+
+// datesConflict - whether a date given on a later receipt differs from the lot's
+func datesConflict(existing, given *time.Time) bool {
+	return given != nil && (existing == nil || !existing.Equal(*given))
+}
@@ -0,0 +1,94 @@
+package repos_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Lots", func() {
+
+	var (
+		repo    repos.Lots
+		product *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("lots", "products")
+
+		repo = gr.Lots()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", LotTracked: true})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Receive(Tx)", func() {
+		It("should not allow a lot to expire before it was made", func() {
+			now := time.Now()
+			_, err := repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "L-1", ManufacturedAt: &now, ExpiresAt: &now}, 1)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Receive(ctx, product.ID, types.NewLot{}, 1)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should add to a lot it has already seen", func() {
+			lot, err := repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "L-1"}, 4)
+			Expect(err).To(BeNil())
+
+			again, err := repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "L-1"}, 3)
+			Expect(err).To(BeNil())
+			Expect(again.ID).To(Equal(lot.ID))
+			Expect(again.Qty).To(BeNumerically("==", 7))
+		})
+	})
+
+	Context("Adjust(Tx)", func() {
+		It("should not take a lot below zero", func() {
+			lot, err := repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "L-1"}, 2)
+			Expect(err).To(BeNil())
+
+			_, err = repo.Adjust(ctx, lot.ID, -3)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			lot, err = repo.Adjust(ctx, lot.ID, -2)
+			Expect(err).To(BeNil())
+			Expect(lot.Qty).To(BeNumerically("==", 0))
+
+			_, err = repo.Adjust(ctx, 99999999, 1)
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Find(Tx)", func() {
+		It("should put the soonest to expire first and lots without a date last", func() {
+			soon := time.Now().Add(24 * time.Hour)
+			later := time.Now().Add(48 * time.Hour)
+
+			_, err := repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "NEVER"}, 1)
+			Expect(err).To(BeNil())
+			_, err = repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "LATER", ExpiresAt: &later}, 1)
+			Expect(err).To(BeNil())
+			_, err = repo.Receive(ctx, product.ID, types.NewLot{LotNumber: "SOON", ExpiresAt: &soon}, 1)
+			Expect(err).To(BeNil())
+
+			lots, count, err := repo.Find(ctx, &repos.LotsFind{ProductIDs: []int64{product.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 3))
+			Expect(lots[0].LotNumber).To(Equal("SOON"))
+			Expect(lots[1].LotNumber).To(Equal("LATER"))
+			Expect(lots[2].LotNumber).To(Equal("NEVER"))
+
+			expiring, err := gr.Reports().Expiring(ctx, &repos.ExpiringFind{Before: time.Now().Add(36 * time.Hour)})
+			Expect(err).To(BeNil())
+			Expect(expiring).To(HaveLen(1))
+			Expect(expiring[0].LotNumber).To(Equal("SOON"))
+			Expect(expiring[0].DaysLeft).To(BeNumerically("==", 0))
+		})
+	})
+})
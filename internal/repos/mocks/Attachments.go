@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./attachments.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./attachments.go -destination=./mocks/Attachments.go -package=mock_repos Attachments
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockAttachments is a mock of Attachments interface.
+type MockAttachments struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentsMockRecorder
+}
+
+// MockAttachmentsMockRecorder is the mock recorder for MockAttachments.
+type MockAttachmentsMockRecorder struct {
+	mock *MockAttachments
+}
+
+// NewMockAttachments creates a new mock instance.
+func NewMockAttachments(ctrl *gomock.Controller) *MockAttachments {
+	mock := &MockAttachments{ctrl: ctrl}
+	mock.recorder = &MockAttachmentsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachments) EXPECT() *MockAttachmentsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAttachments) Create(ctx context.Context, newAttachment types.NewAttachment) (*types.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newAttachment)
+	ret0, _ := ret[0].(*types.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentsMockRecorder) Create(ctx, newAttachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachments)(nil).Create), ctx, newAttachment)
+}
+
+// CreateTx mocks base method.
+func (m *MockAttachments) CreateTx(ctx context.Context, tx *xorm.Session, newAttachment types.NewAttachment) (*types.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newAttachment)
+	ret0, _ := ret[0].(*types.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockAttachmentsMockRecorder) CreateTx(ctx, tx, newAttachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockAttachments)(nil).CreateTx), ctx, tx, newAttachment)
+}
+
+// Destroy mocks base method.
+func (m *MockAttachments) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockAttachmentsMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockAttachments)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockAttachments) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockAttachmentsMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockAttachments)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockAttachments) Find(ctx context.Context, opts *repos.AttachmentsFind) ([]*types.Attachment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Attachment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAttachmentsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAttachments)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockAttachments) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.AttachmentsFind) ([]*types.Attachment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Attachment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockAttachmentsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockAttachments)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockAttachments) Get(ctx context.Context, id int64) (*types.Attachment, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Attachment)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAttachmentsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAttachments)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockAttachments) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Attachment, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Attachment)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockAttachmentsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockAttachments)(nil).GetTx), ctx, tx, id)
+}
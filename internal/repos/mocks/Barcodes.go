@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./barcodes.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./barcodes.go -destination=./mocks/Barcodes.go -package=mock_repos Barcodes
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockBarcodes is a mock of Barcodes interface.
+type MockBarcodes struct {
+	ctrl     *gomock.Controller
+	recorder *MockBarcodesMockRecorder
+}
+
+// MockBarcodesMockRecorder is the mock recorder for MockBarcodes.
+type MockBarcodesMockRecorder struct {
+	mock *MockBarcodes
+}
+
+// NewMockBarcodes creates a new mock instance.
+func NewMockBarcodes(ctrl *gomock.Controller) *MockBarcodes {
+	mock := &MockBarcodes{ctrl: ctrl}
+	mock.recorder = &MockBarcodesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBarcodes) EXPECT() *MockBarcodesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBarcodes) Create(ctx context.Context, newBarcode types.NewBarcode) (*types.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newBarcode)
+	ret0, _ := ret[0].(*types.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBarcodesMockRecorder) Create(ctx, newBarcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBarcodes)(nil).Create), ctx, newBarcode)
+}
+
+// CreateTx mocks base method.
+func (m *MockBarcodes) CreateTx(ctx context.Context, tx *xorm.Session, newBarcode types.NewBarcode) (*types.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newBarcode)
+	ret0, _ := ret[0].(*types.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockBarcodesMockRecorder) CreateTx(ctx, tx, newBarcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockBarcodes)(nil).CreateTx), ctx, tx, newBarcode)
+}
+
+// Destroy mocks base method.
+func (m *MockBarcodes) Destroy(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockBarcodesMockRecorder) Destroy(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockBarcodes)(nil).Destroy), ctx, code)
+}
+
+// DestroyTx mocks base method.
+func (m *MockBarcodes) DestroyTx(ctx context.Context, tx *xorm.Session, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockBarcodesMockRecorder) DestroyTx(ctx, tx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockBarcodes)(nil).DestroyTx), ctx, tx, code)
+}
+
+// Find mocks base method.
+func (m *MockBarcodes) Find(ctx context.Context, opts *repos.BarcodesFind) ([]*types.Barcode, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Barcode)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockBarcodesMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockBarcodes)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockBarcodes) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.BarcodesFind) ([]*types.Barcode, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Barcode)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockBarcodesMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockBarcodes)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockBarcodes) Get(ctx context.Context, code string) (*types.Barcode, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, code)
+	ret0, _ := ret[0].(*types.Barcode)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBarcodesMockRecorder) Get(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBarcodes)(nil).Get), ctx, code)
+}
+
+// GetTx mocks base method.
+func (m *MockBarcodes) GetTx(ctx context.Context, tx *xorm.Session, code string) (*types.Barcode, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, code)
+	ret0, _ := ret[0].(*types.Barcode)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockBarcodesMockRecorder) GetTx(ctx, tx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockBarcodes)(nil).GetTx), ctx, tx, code)
+}
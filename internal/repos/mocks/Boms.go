@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./boms.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./boms.go -destination=./mocks/Boms.go -package=mock_repos Boms
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockBoms is a mock of Boms interface.
+type MockBoms struct {
+	ctrl     *gomock.Controller
+	recorder *MockBomsMockRecorder
+}
+
+// MockBomsMockRecorder is the mock recorder for MockBoms.
+type MockBomsMockRecorder struct {
+	mock *MockBoms
+}
+
+// NewMockBoms creates a new mock instance.
+func NewMockBoms(ctrl *gomock.Controller) *MockBoms {
+	mock := &MockBoms{ctrl: ctrl}
+	mock.recorder = &MockBomsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoms) EXPECT() *MockBomsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockBoms) Get(ctx context.Context, productID int64) ([]*types.BomLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, productID)
+	ret0, _ := ret[0].([]*types.BomLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBomsMockRecorder) Get(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBoms)(nil).Get), ctx, productID)
+}
+
+// GetTx mocks base method.
+func (m *MockBoms) GetTx(ctx context.Context, tx *xorm.Session, productID int64) ([]*types.BomLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, productID)
+	ret0, _ := ret[0].([]*types.BomLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockBomsMockRecorder) GetTx(ctx, tx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockBoms)(nil).GetTx), ctx, tx, productID)
+}
+
+// Set mocks base method.
+func (m *MockBoms) Set(ctx context.Context, set *types.SetBom) ([]*types.BomLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, set)
+	ret0, _ := ret[0].([]*types.BomLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockBomsMockRecorder) Set(ctx, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockBoms)(nil).Set), ctx, set)
+}
+
+// SetTx mocks base method.
+func (m *MockBoms) SetTx(ctx context.Context, tx *xorm.Session, set *types.SetBom) ([]*types.BomLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTx", ctx, tx, set)
+	ret0, _ := ret[0].([]*types.BomLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTx indicates an expected call of SetTx.
+func (mr *MockBomsMockRecorder) SetTx(ctx, tx, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTx", reflect.TypeOf((*MockBoms)(nil).SetTx), ctx, tx, set)
+}
@@ -0,0 +1,287 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./countSessions.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./countSessions.go -destination=./mocks/CountSessions.go -package=mock_repos CountSessions
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockCountSessions is a mock of CountSessions interface.
+type MockCountSessions struct {
+	ctrl     *gomock.Controller
+	recorder *MockCountSessionsMockRecorder
+}
+
+// MockCountSessionsMockRecorder is the mock recorder for MockCountSessions.
+type MockCountSessionsMockRecorder struct {
+	mock *MockCountSessions
+}
+
+// NewMockCountSessions creates a new mock instance.
+func NewMockCountSessions(ctrl *gomock.Controller) *MockCountSessions {
+	mock := &MockCountSessions{ctrl: ctrl}
+	mock.recorder = &MockCountSessionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCountSessions) EXPECT() *MockCountSessionsMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockCountSessions) Cancel(ctx context.Context, id int64) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockCountSessionsMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockCountSessions)(nil).Cancel), ctx, id)
+}
+
+// CancelTx mocks base method.
+func (m *MockCountSessions) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTx indicates an expected call of CancelTx.
+func (mr *MockCountSessionsMockRecorder) CancelTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockCountSessions)(nil).CancelTx), ctx, tx, id)
+}
+
+// Count mocks base method.
+func (m *MockCountSessions) Count(ctx context.Context, count *types.CountCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, count)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockCountSessionsMockRecorder) Count(ctx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockCountSessions)(nil).Count), ctx, count)
+}
+
+// CountTx mocks base method.
+func (m *MockCountSessions) CountTx(ctx context.Context, tx *xorm.Session, count *types.CountCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTx", ctx, tx, count)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTx indicates an expected call of CountTx.
+func (mr *MockCountSessionsMockRecorder) CountTx(ctx, tx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTx", reflect.TypeOf((*MockCountSessions)(nil).CountTx), ctx, tx, count)
+}
+
+// Create mocks base method.
+func (m *MockCountSessions) Create(ctx context.Context, newSession types.NewCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newSession)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCountSessionsMockRecorder) Create(ctx, newSession any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCountSessions)(nil).Create), ctx, newSession)
+}
+
+// CreateTx mocks base method.
+func (m *MockCountSessions) CreateTx(ctx context.Context, tx *xorm.Session, newSession types.NewCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newSession)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockCountSessionsMockRecorder) CreateTx(ctx, tx, newSession any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockCountSessions)(nil).CreateTx), ctx, tx, newSession)
+}
+
+// Find mocks base method.
+func (m *MockCountSessions) Find(ctx context.Context, opts *repos.CountSessionsFind) ([]*types.CountSession, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.CountSession)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockCountSessionsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCountSessions)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockCountSessions) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.CountSessionsFind) ([]*types.CountSession, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.CountSession)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockCountSessionsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockCountSessions)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockCountSessions) Get(ctx context.Context, id int64) (*types.CountSession, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCountSessionsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCountSessions)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockCountSessions) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockCountSessionsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockCountSessions)(nil).GetTx), ctx, tx, id)
+}
+
+// Post mocks base method.
+func (m *MockCountSessions) Post(ctx context.Context, post *types.PostCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, post)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockCountSessionsMockRecorder) Post(ctx, post any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockCountSessions)(nil).Post), ctx, post)
+}
+
+// PostTx mocks base method.
+func (m *MockCountSessions) PostTx(ctx context.Context, tx *xorm.Session, post *types.PostCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostTx", ctx, tx, post)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostTx indicates an expected call of PostTx.
+func (mr *MockCountSessionsMockRecorder) PostTx(ctx, tx, post any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostTx", reflect.TypeOf((*MockCountSessions)(nil).PostTx), ctx, tx, post)
+}
+
+// Reopen mocks base method.
+func (m *MockCountSessions) Reopen(ctx context.Context, id int64) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, id)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockCountSessionsMockRecorder) Reopen(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockCountSessions)(nil).Reopen), ctx, id)
+}
+
+// ReopenTx mocks base method.
+func (m *MockCountSessions) ReopenTx(ctx context.Context, tx *xorm.Session, id int64) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenTx indicates an expected call of ReopenTx.
+func (mr *MockCountSessionsMockRecorder) ReopenTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenTx", reflect.TypeOf((*MockCountSessions)(nil).ReopenTx), ctx, tx, id)
+}
+
+// Submit mocks base method.
+func (m *MockCountSessions) Submit(ctx context.Context, submit *types.SubmitCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, submit)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockCountSessionsMockRecorder) Submit(ctx, submit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockCountSessions)(nil).Submit), ctx, submit)
+}
+
+// SubmitTx mocks base method.
+func (m *MockCountSessions) SubmitTx(ctx context.Context, tx *xorm.Session, submit *types.SubmitCountSession) (*types.CountSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitTx", ctx, tx, submit)
+	ret0, _ := ret[0].(*types.CountSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitTx indicates an expected call of SubmitTx.
+func (mr *MockCountSessionsMockRecorder) SubmitTx(ctx, tx, submit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTx", reflect.TypeOf((*MockCountSessions)(nil).SubmitTx), ctx, tx, submit)
+}
@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./customers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./customers.go -destination=./mocks/Customers.go -package=mock_repos Customers
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockCustomers is a mock of Customers interface.
+type MockCustomers struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomersMockRecorder
+}
+
+// MockCustomersMockRecorder is the mock recorder for MockCustomers.
+type MockCustomersMockRecorder struct {
+	mock *MockCustomers
+}
+
+// NewMockCustomers creates a new mock instance.
+func NewMockCustomers(ctrl *gomock.Controller) *MockCustomers {
+	mock := &MockCustomers{ctrl: ctrl}
+	mock.recorder = &MockCustomersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomers) EXPECT() *MockCustomersMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCustomers) Create(ctx context.Context, newCustomer types.NewCustomer) (*types.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newCustomer)
+	ret0, _ := ret[0].(*types.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomersMockRecorder) Create(ctx, newCustomer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomers)(nil).Create), ctx, newCustomer)
+}
+
+// CreateTx mocks base method.
+func (m *MockCustomers) CreateTx(ctx context.Context, tx *xorm.Session, newCustomer types.NewCustomer) (*types.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newCustomer)
+	ret0, _ := ret[0].(*types.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockCustomersMockRecorder) CreateTx(ctx, tx, newCustomer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockCustomers)(nil).CreateTx), ctx, tx, newCustomer)
+}
+
+// Destroy mocks base method.
+func (m *MockCustomers) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockCustomersMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockCustomers)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockCustomers) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockCustomersMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockCustomers)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockCustomers) Find(ctx context.Context, opts *repos.CustomersFind) ([]*types.Customer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Customer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockCustomersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCustomers)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockCustomers) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.CustomersFind) ([]*types.Customer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Customer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockCustomersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockCustomers)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockCustomers) Get(ctx context.Context, id int64) (*types.Customer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Customer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCustomersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCustomers)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockCustomers) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Customer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Customer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockCustomersMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockCustomers)(nil).GetTx), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockCustomers) Update(ctx context.Context, diff *types.UpdateCustomer) (*types.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomersMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomers)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockCustomers) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateCustomer) (*types.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockCustomersMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockCustomers)(nil).UpdateTx), ctx, tx, diff)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./forecasts.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./forecasts.go -destination=./mocks/Forecasts.go -package=mock_repos Forecasts
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockForecasts is a mock of Forecasts interface.
+type MockForecasts struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastsMockRecorder
+}
+
+// MockForecastsMockRecorder is the mock recorder for MockForecasts.
+type MockForecastsMockRecorder struct {
+	mock *MockForecasts
+}
+
+// NewMockForecasts creates a new mock instance.
+func NewMockForecasts(ctrl *gomock.Controller) *MockForecasts {
+	mock := &MockForecasts{ctrl: ctrl}
+	mock.recorder = &MockForecastsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecasts) EXPECT() *MockForecastsMockRecorder {
+	return m.recorder
+}
+
+// Draft mocks base method.
+func (m *MockForecasts) Draft(ctx context.Context, opts *repos.ForecastsFind) ([]*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Draft", ctx, opts)
+	ret0, _ := ret[0].([]*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Draft indicates an expected call of Draft.
+func (mr *MockForecastsMockRecorder) Draft(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draft", reflect.TypeOf((*MockForecasts)(nil).Draft), ctx, opts)
+}
+
+// DraftTx mocks base method.
+func (m *MockForecasts) DraftTx(ctx context.Context, tx *xorm.Session, opts *repos.ForecastsFind) ([]*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DraftTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DraftTx indicates an expected call of DraftTx.
+func (mr *MockForecastsMockRecorder) DraftTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DraftTx", reflect.TypeOf((*MockForecasts)(nil).DraftTx), ctx, tx, opts)
+}
+
+// Suggest mocks base method.
+func (m *MockForecasts) Suggest(ctx context.Context, opts *repos.ForecastsFind) ([]*types.SuggestedPurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, opts)
+	ret0, _ := ret[0].([]*types.SuggestedPurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockForecastsMockRecorder) Suggest(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockForecasts)(nil).Suggest), ctx, opts)
+}
+
+// SuggestTx mocks base method.
+func (m *MockForecasts) SuggestTx(ctx context.Context, tx *xorm.Session, opts *repos.ForecastsFind) ([]*types.SuggestedPurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.SuggestedPurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestTx indicates an expected call of SuggestTx.
+func (mr *MockForecastsMockRecorder) SuggestTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTx", reflect.TypeOf((*MockForecasts)(nil).SuggestTx), ctx, tx, opts)
+}
@@ -40,6 +40,76 @@ func (m *MockGlobalRepo) EXPECT() *MockGlobalRepoMockRecorder {
 	return m.recorder
 }
 
+// Attachments mocks base method.
+func (m *MockGlobalRepo) Attachments() repos.Attachments {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attachments")
+	ret0, _ := ret[0].(repos.Attachments)
+	return ret0
+}
+
+// Attachments indicates an expected call of Attachments.
+func (mr *MockGlobalRepoMockRecorder) Attachments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attachments", reflect.TypeOf((*MockGlobalRepo)(nil).Attachments))
+}
+
+// Barcodes mocks base method.
+func (m *MockGlobalRepo) Barcodes() repos.Barcodes {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Barcodes")
+	ret0, _ := ret[0].(repos.Barcodes)
+	return ret0
+}
+
+// Barcodes indicates an expected call of Barcodes.
+func (mr *MockGlobalRepoMockRecorder) Barcodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Barcodes", reflect.TypeOf((*MockGlobalRepo)(nil).Barcodes))
+}
+
+// Boms mocks base method.
+func (m *MockGlobalRepo) Boms() repos.Boms {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Boms")
+	ret0, _ := ret[0].(repos.Boms)
+	return ret0
+}
+
+// Boms indicates an expected call of Boms.
+func (mr *MockGlobalRepoMockRecorder) Boms() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Boms", reflect.TypeOf((*MockGlobalRepo)(nil).Boms))
+}
+
+// CountSessions mocks base method.
+func (m *MockGlobalRepo) CountSessions() repos.CountSessions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSessions")
+	ret0, _ := ret[0].(repos.CountSessions)
+	return ret0
+}
+
+// CountSessions indicates an expected call of CountSessions.
+func (mr *MockGlobalRepoMockRecorder) CountSessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSessions", reflect.TypeOf((*MockGlobalRepo)(nil).CountSessions))
+}
+
+// Customers mocks base method.
+func (m *MockGlobalRepo) Customers() repos.Customers {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Customers")
+	ret0, _ := ret[0].(repos.Customers)
+	return ret0
+}
+
+// Customers indicates an expected call of Customers.
+func (mr *MockGlobalRepoMockRecorder) Customers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Customers", reflect.TypeOf((*MockGlobalRepo)(nil).Customers))
+}
+
 // DB mocks base method.
 func (m *MockGlobalRepo) DB() *xorm.Engine {
 	m.ctrl.T.Helper()
@@ -54,6 +124,104 @@ func (mr *MockGlobalRepoMockRecorder) DB() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DB", reflect.TypeOf((*MockGlobalRepo)(nil).DB))
 }
 
+// Forecasts mocks base method.
+func (m *MockGlobalRepo) Forecasts() repos.Forecasts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Forecasts")
+	ret0, _ := ret[0].(repos.Forecasts)
+	return ret0
+}
+
+// Forecasts indicates an expected call of Forecasts.
+func (mr *MockGlobalRepoMockRecorder) Forecasts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Forecasts", reflect.TypeOf((*MockGlobalRepo)(nil).Forecasts))
+}
+
+// Invoices mocks base method.
+func (m *MockGlobalRepo) Invoices() repos.Invoices {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invoices")
+	ret0, _ := ret[0].(repos.Invoices)
+	return ret0
+}
+
+// Invoices indicates an expected call of Invoices.
+func (mr *MockGlobalRepoMockRecorder) Invoices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invoices", reflect.TypeOf((*MockGlobalRepo)(nil).Invoices))
+}
+
+// Kits mocks base method.
+func (m *MockGlobalRepo) Kits() repos.Kits {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Kits")
+	ret0, _ := ret[0].(repos.Kits)
+	return ret0
+}
+
+// Kits indicates an expected call of Kits.
+func (mr *MockGlobalRepoMockRecorder) Kits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kits", reflect.TypeOf((*MockGlobalRepo)(nil).Kits))
+}
+
+// Locations mocks base method.
+func (m *MockGlobalRepo) Locations() repos.Locations {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locations")
+	ret0, _ := ret[0].(repos.Locations)
+	return ret0
+}
+
+// Locations indicates an expected call of Locations.
+func (mr *MockGlobalRepoMockRecorder) Locations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locations", reflect.TypeOf((*MockGlobalRepo)(nil).Locations))
+}
+
+// Lots mocks base method.
+func (m *MockGlobalRepo) Lots() repos.Lots {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lots")
+	ret0, _ := ret[0].(repos.Lots)
+	return ret0
+}
+
+// Lots indicates an expected call of Lots.
+func (mr *MockGlobalRepoMockRecorder) Lots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lots", reflect.TypeOf((*MockGlobalRepo)(nil).Lots))
+}
+
+// PickLists mocks base method.
+func (m *MockGlobalRepo) PickLists() repos.PickLists {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickLists")
+	ret0, _ := ret[0].(repos.PickLists)
+	return ret0
+}
+
+// PickLists indicates an expected call of PickLists.
+func (mr *MockGlobalRepoMockRecorder) PickLists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickLists", reflect.TypeOf((*MockGlobalRepo)(nil).PickLists))
+}
+
+// ProductSuppliers mocks base method.
+func (m *MockGlobalRepo) ProductSuppliers() repos.ProductSuppliers {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductSuppliers")
+	ret0, _ := ret[0].(repos.ProductSuppliers)
+	return ret0
+}
+
+// ProductSuppliers indicates an expected call of ProductSuppliers.
+func (mr *MockGlobalRepoMockRecorder) ProductSuppliers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductSuppliers", reflect.TypeOf((*MockGlobalRepo)(nil).ProductSuppliers))
+}
+
 // Products mocks base method.
 func (m *MockGlobalRepo) Products() repos.Products {
 	m.ctrl.T.Helper()
@@ -67,3 +235,213 @@ func (mr *MockGlobalRepoMockRecorder) Products() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Products", reflect.TypeOf((*MockGlobalRepo)(nil).Products))
 }
+
+// PurchaseOrders mocks base method.
+func (m *MockGlobalRepo) PurchaseOrders() repos.PurchaseOrders {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurchaseOrders")
+	ret0, _ := ret[0].(repos.PurchaseOrders)
+	return ret0
+}
+
+// PurchaseOrders indicates an expected call of PurchaseOrders.
+func (mr *MockGlobalRepoMockRecorder) PurchaseOrders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurchaseOrders", reflect.TypeOf((*MockGlobalRepo)(nil).PurchaseOrders))
+}
+
+// Putaway mocks base method.
+func (m *MockGlobalRepo) Putaway() repos.Putaway {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Putaway")
+	ret0, _ := ret[0].(repos.Putaway)
+	return ret0
+}
+
+// Putaway indicates an expected call of Putaway.
+func (mr *MockGlobalRepoMockRecorder) Putaway() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Putaway", reflect.TypeOf((*MockGlobalRepo)(nil).Putaway))
+}
+
+// Receipts mocks base method.
+func (m *MockGlobalRepo) Receipts() repos.Receipts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receipts")
+	ret0, _ := ret[0].(repos.Receipts)
+	return ret0
+}
+
+// Receipts indicates an expected call of Receipts.
+func (mr *MockGlobalRepoMockRecorder) Receipts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receipts", reflect.TypeOf((*MockGlobalRepo)(nil).Receipts))
+}
+
+// Reports mocks base method.
+func (m *MockGlobalRepo) Reports() repos.Reports {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reports")
+	ret0, _ := ret[0].(repos.Reports)
+	return ret0
+}
+
+// Reports indicates an expected call of Reports.
+func (mr *MockGlobalRepoMockRecorder) Reports() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reports", reflect.TypeOf((*MockGlobalRepo)(nil).Reports))
+}
+
+// Returns mocks base method.
+func (m *MockGlobalRepo) Returns() repos.Returns {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Returns")
+	ret0, _ := ret[0].(repos.Returns)
+	return ret0
+}
+
+// Returns indicates an expected call of Returns.
+func (mr *MockGlobalRepoMockRecorder) Returns() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Returns", reflect.TypeOf((*MockGlobalRepo)(nil).Returns))
+}
+
+// SalesOrders mocks base method.
+func (m *MockGlobalRepo) SalesOrders() repos.SalesOrders {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesOrders")
+	ret0, _ := ret[0].(repos.SalesOrders)
+	return ret0
+}
+
+// SalesOrders indicates an expected call of SalesOrders.
+func (mr *MockGlobalRepoMockRecorder) SalesOrders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesOrders", reflect.TypeOf((*MockGlobalRepo)(nil).SalesOrders))
+}
+
+// Serials mocks base method.
+func (m *MockGlobalRepo) Serials() repos.Serials {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Serials")
+	ret0, _ := ret[0].(repos.Serials)
+	return ret0
+}
+
+// Serials indicates an expected call of Serials.
+func (mr *MockGlobalRepoMockRecorder) Serials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serials", reflect.TypeOf((*MockGlobalRepo)(nil).Serials))
+}
+
+// Shipments mocks base method.
+func (m *MockGlobalRepo) Shipments() repos.Shipments {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shipments")
+	ret0, _ := ret[0].(repos.Shipments)
+	return ret0
+}
+
+// Shipments indicates an expected call of Shipments.
+func (mr *MockGlobalRepoMockRecorder) Shipments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shipments", reflect.TypeOf((*MockGlobalRepo)(nil).Shipments))
+}
+
+// Snapshots mocks base method.
+func (m *MockGlobalRepo) Snapshots() repos.Snapshots {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots")
+	ret0, _ := ret[0].(repos.Snapshots)
+	return ret0
+}
+
+// Snapshots indicates an expected call of Snapshots.
+func (mr *MockGlobalRepoMockRecorder) Snapshots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockGlobalRepo)(nil).Snapshots))
+}
+
+// StockLevels mocks base method.
+func (m *MockGlobalRepo) StockLevels() repos.StockLevels {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StockLevels")
+	ret0, _ := ret[0].(repos.StockLevels)
+	return ret0
+}
+
+// StockLevels indicates an expected call of StockLevels.
+func (mr *MockGlobalRepoMockRecorder) StockLevels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockLevels", reflect.TypeOf((*MockGlobalRepo)(nil).StockLevels))
+}
+
+// StockMovements mocks base method.
+func (m *MockGlobalRepo) StockMovements() repos.StockMovements {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StockMovements")
+	ret0, _ := ret[0].(repos.StockMovements)
+	return ret0
+}
+
+// StockMovements indicates an expected call of StockMovements.
+func (mr *MockGlobalRepoMockRecorder) StockMovements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockMovements", reflect.TypeOf((*MockGlobalRepo)(nil).StockMovements))
+}
+
+// Suppliers mocks base method.
+func (m *MockGlobalRepo) Suppliers() repos.Suppliers {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suppliers")
+	ret0, _ := ret[0].(repos.Suppliers)
+	return ret0
+}
+
+// Suppliers indicates an expected call of Suppliers.
+func (mr *MockGlobalRepoMockRecorder) Suppliers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suppliers", reflect.TypeOf((*MockGlobalRepo)(nil).Suppliers))
+}
+
+// TaxRates mocks base method.
+func (m *MockGlobalRepo) TaxRates() repos.TaxRates {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaxRates")
+	ret0, _ := ret[0].(repos.TaxRates)
+	return ret0
+}
+
+// TaxRates indicates an expected call of TaxRates.
+func (mr *MockGlobalRepoMockRecorder) TaxRates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaxRates", reflect.TypeOf((*MockGlobalRepo)(nil).TaxRates))
+}
+
+// Transfers mocks base method.
+func (m *MockGlobalRepo) Transfers() repos.Transfers {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfers")
+	ret0, _ := ret[0].(repos.Transfers)
+	return ret0
+}
+
+// Transfers indicates an expected call of Transfers.
+func (mr *MockGlobalRepoMockRecorder) Transfers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfers", reflect.TypeOf((*MockGlobalRepo)(nil).Transfers))
+}
+
+// WorkOrders mocks base method.
+func (m *MockGlobalRepo) WorkOrders() repos.WorkOrders {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkOrders")
+	ret0, _ := ret[0].(repos.WorkOrders)
+	return ret0
+}
+
+// WorkOrders indicates an expected call of WorkOrders.
+func (mr *MockGlobalRepoMockRecorder) WorkOrders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkOrders", reflect.TypeOf((*MockGlobalRepo)(nil).WorkOrders))
+}
@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./invoices.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./invoices.go -destination=./mocks/Invoices.go -package=mock_repos Invoices
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockInvoices is a mock of Invoices interface.
+type MockInvoices struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoicesMockRecorder
+}
+
+// MockInvoicesMockRecorder is the mock recorder for MockInvoices.
+type MockInvoicesMockRecorder struct {
+	mock *MockInvoices
+}
+
+// NewMockInvoices creates a new mock instance.
+func NewMockInvoices(ctrl *gomock.Controller) *MockInvoices {
+	mock := &MockInvoices{ctrl: ctrl}
+	mock.recorder = &MockInvoicesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoices) EXPECT() *MockInvoicesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInvoices) Create(ctx context.Context, shipmentID int64) (*types.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, shipmentID)
+	ret0, _ := ret[0].(*types.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvoicesMockRecorder) Create(ctx, shipmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvoices)(nil).Create), ctx, shipmentID)
+}
+
+// CreateTx mocks base method.
+func (m *MockInvoices) CreateTx(ctx context.Context, tx *xorm.Session, shipmentID int64) (*types.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, shipmentID)
+	ret0, _ := ret[0].(*types.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockInvoicesMockRecorder) CreateTx(ctx, tx, shipmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockInvoices)(nil).CreateTx), ctx, tx, shipmentID)
+}
+
+// Find mocks base method.
+func (m *MockInvoices) Find(ctx context.Context, opts *repos.InvoicesFind) ([]*types.Invoice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Invoice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockInvoicesMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockInvoices)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockInvoices) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.InvoicesFind) ([]*types.Invoice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Invoice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockInvoicesMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockInvoices)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockInvoices) Get(ctx context.Context, id int64) (*types.Invoice, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Invoice)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvoicesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoices)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockInvoices) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Invoice, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Invoice)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockInvoicesMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockInvoices)(nil).GetTx), ctx, tx, id)
+}
+
+// Pay mocks base method.
+func (m *MockInvoices) Pay(ctx context.Context, payment types.NewInvoicePayment) (*types.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pay", ctx, payment)
+	ret0, _ := ret[0].(*types.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pay indicates an expected call of Pay.
+func (mr *MockInvoicesMockRecorder) Pay(ctx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pay", reflect.TypeOf((*MockInvoices)(nil).Pay), ctx, payment)
+}
+
+// PayTx mocks base method.
+func (m *MockInvoices) PayTx(ctx context.Context, tx *xorm.Session, payment types.NewInvoicePayment) (*types.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayTx", ctx, tx, payment)
+	ret0, _ := ret[0].(*types.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayTx indicates an expected call of PayTx.
+func (mr *MockInvoicesMockRecorder) PayTx(ctx, tx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayTx", reflect.TypeOf((*MockInvoices)(nil).PayTx), ctx, tx, payment)
+}
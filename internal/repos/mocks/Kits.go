@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./kits.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./kits.go -destination=./mocks/Kits.go -package=mock_repos Kits
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockKits is a mock of Kits interface.
+type MockKits struct {
+	ctrl     *gomock.Controller
+	recorder *MockKitsMockRecorder
+}
+
+// MockKitsMockRecorder is the mock recorder for MockKits.
+type MockKitsMockRecorder struct {
+	mock *MockKits
+}
+
+// NewMockKits creates a new mock instance.
+func NewMockKits(ctrl *gomock.Controller) *MockKits {
+	mock := &MockKits{ctrl: ctrl}
+	mock.recorder = &MockKitsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKits) EXPECT() *MockKitsMockRecorder {
+	return m.recorder
+}
+
+// Assemble mocks base method.
+func (m *MockKits) Assemble(ctx context.Context, assemble *types.AssembleKit) (*types.Kit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assemble", ctx, assemble)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assemble indicates an expected call of Assemble.
+func (mr *MockKitsMockRecorder) Assemble(ctx, assemble any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assemble", reflect.TypeOf((*MockKits)(nil).Assemble), ctx, assemble)
+}
+
+// AssembleTx mocks base method.
+func (m *MockKits) AssembleTx(ctx context.Context, tx *xorm.Session, assemble *types.AssembleKit) (*types.Kit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssembleTx", ctx, tx, assemble)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssembleTx indicates an expected call of AssembleTx.
+func (mr *MockKitsMockRecorder) AssembleTx(ctx, tx, assemble any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssembleTx", reflect.TypeOf((*MockKits)(nil).AssembleTx), ctx, tx, assemble)
+}
+
+// Create mocks base method.
+func (m *MockKits) Create(ctx context.Context, newKit types.NewKit) (*types.Kit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newKit)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKitsMockRecorder) Create(ctx, newKit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKits)(nil).Create), ctx, newKit)
+}
+
+// CreateTx mocks base method.
+func (m *MockKits) CreateTx(ctx context.Context, tx *xorm.Session, newKit types.NewKit) (*types.Kit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newKit)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockKitsMockRecorder) CreateTx(ctx, tx, newKit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockKits)(nil).CreateTx), ctx, tx, newKit)
+}
+
+// Find mocks base method.
+func (m *MockKits) Find(ctx context.Context, opts *repos.KitsFind) ([]*types.Kit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Kit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockKitsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockKits)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockKits) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.KitsFind) ([]*types.Kit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Kit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockKitsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockKits)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockKits) Get(ctx context.Context, id int64) (*types.Kit, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKitsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKits)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockKits) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Kit, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockKitsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockKits)(nil).GetTx), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockKits) Update(ctx context.Context, diff *types.UpdateKit) (*types.Kit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKitsMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKits)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockKits) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateKit) (*types.Kit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.Kit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockKitsMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockKits)(nil).UpdateTx), ctx, tx, diff)
+}
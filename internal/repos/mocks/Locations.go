@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./locations.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./locations.go -destination=./mocks/Locations.go -package=mock_repos Locations
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockLocations is a mock of Locations interface.
+type MockLocations struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationsMockRecorder
+}
+
+// MockLocationsMockRecorder is the mock recorder for MockLocations.
+type MockLocationsMockRecorder struct {
+	mock *MockLocations
+}
+
+// NewMockLocations creates a new mock instance.
+func NewMockLocations(ctrl *gomock.Controller) *MockLocations {
+	mock := &MockLocations{ctrl: ctrl}
+	mock.recorder = &MockLocationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocations) EXPECT() *MockLocationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLocations) Create(ctx context.Context, newLocation types.NewLocation) (*types.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newLocation)
+	ret0, _ := ret[0].(*types.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLocationsMockRecorder) Create(ctx, newLocation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLocations)(nil).Create), ctx, newLocation)
+}
+
+// CreateTx mocks base method.
+func (m *MockLocations) CreateTx(ctx context.Context, tx *xorm.Session, newLocation types.NewLocation) (*types.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newLocation)
+	ret0, _ := ret[0].(*types.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockLocationsMockRecorder) CreateTx(ctx, tx, newLocation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockLocations)(nil).CreateTx), ctx, tx, newLocation)
+}
+
+// Destroy mocks base method.
+func (m *MockLocations) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockLocationsMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockLocations)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockLocations) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockLocationsMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockLocations)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockLocations) Find(ctx context.Context, opts *repos.LocationsFind) ([]*types.Location, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Location)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockLocationsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockLocations)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockLocations) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.LocationsFind) ([]*types.Location, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Location)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockLocationsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockLocations)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockLocations) Get(ctx context.Context, id int64) (*types.Location, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Location)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLocationsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLocations)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockLocations) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Location, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Location)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockLocationsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockLocations)(nil).GetTx), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockLocations) Update(ctx context.Context, diff *types.UpdateLocation) (*types.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLocationsMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLocations)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockLocations) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateLocation) (*types.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockLocationsMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockLocations)(nil).UpdateTx), ctx, tx, diff)
+}
@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./lots.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./lots.go -destination=./mocks/Lots.go -package=mock_repos Lots
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockLots is a mock of Lots interface.
+type MockLots struct {
+	ctrl     *gomock.Controller
+	recorder *MockLotsMockRecorder
+}
+
+// MockLotsMockRecorder is the mock recorder for MockLots.
+type MockLotsMockRecorder struct {
+	mock *MockLots
+}
+
+// NewMockLots creates a new mock instance.
+func NewMockLots(ctrl *gomock.Controller) *MockLots {
+	mock := &MockLots{ctrl: ctrl}
+	mock.recorder = &MockLotsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLots) EXPECT() *MockLotsMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockLots) Adjust(ctx context.Context, id, delta int64) (*types.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, id, delta)
+	ret0, _ := ret[0].(*types.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockLotsMockRecorder) Adjust(ctx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockLots)(nil).Adjust), ctx, id, delta)
+}
+
+// AdjustTx mocks base method.
+func (m *MockLots) AdjustTx(ctx context.Context, tx *xorm.Session, id, delta int64) (*types.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustTx", ctx, tx, id, delta)
+	ret0, _ := ret[0].(*types.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustTx indicates an expected call of AdjustTx.
+func (mr *MockLotsMockRecorder) AdjustTx(ctx, tx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustTx", reflect.TypeOf((*MockLots)(nil).AdjustTx), ctx, tx, id, delta)
+}
+
+// Find mocks base method.
+func (m *MockLots) Find(ctx context.Context, opts *repos.LotsFind) ([]*types.Lot, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Lot)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockLotsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockLots)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockLots) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.LotsFind) ([]*types.Lot, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Lot)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockLotsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockLots)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockLots) Get(ctx context.Context, id int64) (*types.Lot, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Lot)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLotsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLots)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockLots) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Lot, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Lot)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockLotsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockLots)(nil).GetTx), ctx, tx, id)
+}
+
+// Receive mocks base method.
+func (m *MockLots) Receive(ctx context.Context, productID int64, newLot types.NewLot, qty int64) (*types.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, productID, newLot, qty)
+	ret0, _ := ret[0].(*types.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockLotsMockRecorder) Receive(ctx, productID, newLot, qty any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockLots)(nil).Receive), ctx, productID, newLot, qty)
+}
+
+// ReceiveTx mocks base method.
+func (m *MockLots) ReceiveTx(ctx context.Context, tx *xorm.Session, productID int64, newLot types.NewLot, qty int64) (*types.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTx", ctx, tx, productID, newLot, qty)
+	ret0, _ := ret[0].(*types.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTx indicates an expected call of ReceiveTx.
+func (mr *MockLotsMockRecorder) ReceiveTx(ctx, tx, productID, newLot, qty any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTx", reflect.TypeOf((*MockLots)(nil).ReceiveTx), ctx, tx, productID, newLot, qty)
+}
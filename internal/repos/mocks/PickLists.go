@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pickLists.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pickLists.go -destination=./mocks/PickLists.go -package=mock_repos PickLists
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockPickLists is a mock of PickLists interface.
+type MockPickLists struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickListsMockRecorder
+}
+
+// MockPickListsMockRecorder is the mock recorder for MockPickLists.
+type MockPickListsMockRecorder struct {
+	mock *MockPickLists
+}
+
+// NewMockPickLists creates a new mock instance.
+func NewMockPickLists(ctrl *gomock.Controller) *MockPickLists {
+	mock := &MockPickLists{ctrl: ctrl}
+	mock.recorder = &MockPickListsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickLists) EXPECT() *MockPickListsMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockPickLists) Cancel(ctx context.Context, id int64) (*types.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPickListsMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPickLists)(nil).Cancel), ctx, id)
+}
+
+// CancelTx mocks base method.
+func (m *MockPickLists) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTx indicates an expected call of CancelTx.
+func (mr *MockPickListsMockRecorder) CancelTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockPickLists)(nil).CancelTx), ctx, tx, id)
+}
+
+// Create mocks base method.
+func (m *MockPickLists) Create(ctx context.Context, newList types.NewPickList) (*types.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newList)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPickListsMockRecorder) Create(ctx, newList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPickLists)(nil).Create), ctx, newList)
+}
+
+// CreateTx mocks base method.
+func (m *MockPickLists) CreateTx(ctx context.Context, tx *xorm.Session, newList types.NewPickList) (*types.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newList)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockPickListsMockRecorder) CreateTx(ctx, tx, newList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockPickLists)(nil).CreateTx), ctx, tx, newList)
+}
+
+// Find mocks base method.
+func (m *MockPickLists) Find(ctx context.Context, opts *repos.PickListsFind) ([]*types.PickList, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.PickList)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPickListsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPickLists)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockPickLists) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.PickListsFind) ([]*types.PickList, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.PickList)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockPickListsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockPickLists)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockPickLists) Get(ctx context.Context, id int64) (*types.PickList, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPickListsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPickLists)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockPickLists) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PickList, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockPickListsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockPickLists)(nil).GetTx), ctx, tx, id)
+}
+
+// Pick mocks base method.
+func (m *MockPickLists) Pick(ctx context.Context, pick *types.PickPickList) (*types.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pick", ctx, pick)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pick indicates an expected call of Pick.
+func (mr *MockPickListsMockRecorder) Pick(ctx, pick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockPickLists)(nil).Pick), ctx, pick)
+}
+
+// PickTx mocks base method.
+func (m *MockPickLists) PickTx(ctx context.Context, tx *xorm.Session, pick *types.PickPickList) (*types.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickTx", ctx, tx, pick)
+	ret0, _ := ret[0].(*types.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickTx indicates an expected call of PickTx.
+func (mr *MockPickListsMockRecorder) PickTx(ctx, tx, pick any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickTx", reflect.TypeOf((*MockPickLists)(nil).PickTx), ctx, tx, pick)
+}
@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./productSuppliers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./productSuppliers.go -destination=./mocks/ProductSuppliers.go -package=mock_repos ProductSuppliers
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockProductSuppliers is a mock of ProductSuppliers interface.
+type MockProductSuppliers struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductSuppliersMockRecorder
+}
+
+// MockProductSuppliersMockRecorder is the mock recorder for MockProductSuppliers.
+type MockProductSuppliersMockRecorder struct {
+	mock *MockProductSuppliers
+}
+
+// NewMockProductSuppliers creates a new mock instance.
+func NewMockProductSuppliers(ctrl *gomock.Controller) *MockProductSuppliers {
+	mock := &MockProductSuppliers{ctrl: ctrl}
+	mock.recorder = &MockProductSuppliersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductSuppliers) EXPECT() *MockProductSuppliersMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProductSuppliers) Create(ctx context.Context, newLink types.NewProductSupplier) (*types.ProductSupplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newLink)
+	ret0, _ := ret[0].(*types.ProductSupplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProductSuppliersMockRecorder) Create(ctx, newLink any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductSuppliers)(nil).Create), ctx, newLink)
+}
+
+// CreateTx mocks base method.
+func (m *MockProductSuppliers) CreateTx(ctx context.Context, tx *xorm.Session, newLink types.NewProductSupplier) (*types.ProductSupplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newLink)
+	ret0, _ := ret[0].(*types.ProductSupplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockProductSuppliersMockRecorder) CreateTx(ctx, tx, newLink any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockProductSuppliers)(nil).CreateTx), ctx, tx, newLink)
+}
+
+// Destroy mocks base method.
+func (m *MockProductSuppliers) Destroy(ctx context.Context, productID, supplierID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, productID, supplierID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockProductSuppliersMockRecorder) Destroy(ctx, productID, supplierID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockProductSuppliers)(nil).Destroy), ctx, productID, supplierID)
+}
+
+// DestroyTx mocks base method.
+func (m *MockProductSuppliers) DestroyTx(ctx context.Context, tx *xorm.Session, productID, supplierID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, productID, supplierID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockProductSuppliersMockRecorder) DestroyTx(ctx, tx, productID, supplierID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockProductSuppliers)(nil).DestroyTx), ctx, tx, productID, supplierID)
+}
+
+// Find mocks base method.
+func (m *MockProductSuppliers) Find(ctx context.Context, opts *repos.ProductSuppliersFind) ([]*types.ProductSupplier, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.ProductSupplier)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockProductSuppliersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockProductSuppliers)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockProductSuppliers) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.ProductSuppliersFind) ([]*types.ProductSupplier, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ProductSupplier)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockProductSuppliersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockProductSuppliers)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockProductSuppliers) Get(ctx context.Context, productID, supplierID int64) (*types.ProductSupplier, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, productID, supplierID)
+	ret0, _ := ret[0].(*types.ProductSupplier)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProductSuppliersMockRecorder) Get(ctx, productID, supplierID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProductSuppliers)(nil).Get), ctx, productID, supplierID)
+}
+
+// GetTx mocks base method.
+func (m *MockProductSuppliers) GetTx(ctx context.Context, tx *xorm.Session, productID, supplierID int64) (*types.ProductSupplier, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, productID, supplierID)
+	ret0, _ := ret[0].(*types.ProductSupplier)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockProductSuppliersMockRecorder) GetTx(ctx, tx, productID, supplierID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockProductSuppliers)(nil).GetTx), ctx, tx, productID, supplierID)
+}
+
+// Update mocks base method.
+func (m *MockProductSuppliers) Update(ctx context.Context, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.ProductSupplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProductSuppliersMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductSuppliers)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockProductSuppliers) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.ProductSupplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockProductSuppliersMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockProductSuppliers)(nil).UpdateTx), ctx, tx, diff)
+}
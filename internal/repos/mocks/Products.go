@@ -42,6 +42,36 @@ func (m *MockProducts) EXPECT() *MockProductsMockRecorder {
 	return m.recorder
 }
 
+// Adjust mocks base method.
+func (m *MockProducts) Adjust(ctx context.Context, id int64, adj types.ProductAdjustment) (*types.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, id, adj)
+	ret0, _ := ret[0].(*types.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockProductsMockRecorder) Adjust(ctx, id, adj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockProducts)(nil).Adjust), ctx, id, adj)
+}
+
+// AdjustTx mocks base method.
+func (m *MockProducts) AdjustTx(ctx context.Context, tx *xorm.Session, id int64, adj types.ProductAdjustment) (*types.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustTx", ctx, tx, id, adj)
+	ret0, _ := ret[0].(*types.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustTx indicates an expected call of AdjustTx.
+func (mr *MockProductsMockRecorder) AdjustTx(ctx, tx, id, adj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustTx", reflect.TypeOf((*MockProducts)(nil).AdjustTx), ctx, tx, id, adj)
+}
+
 // Create mocks base method.
 func (m *MockProducts) Create(ctx context.Context, newProduct types.NewProduct) (*types.Product, error) {
 	m.ctrl.T.Helper()
@@ -193,3 +223,38 @@ func (mr *MockProductsMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockProducts)(nil).UpdateTx), ctx, tx, diff)
 }
+
+// MockStockObserver is a mock of StockObserver interface.
+type MockStockObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockObserverMockRecorder
+}
+
+// MockStockObserverMockRecorder is the mock recorder for MockStockObserver.
+type MockStockObserverMockRecorder struct {
+	mock *MockStockObserver
+}
+
+// NewMockStockObserver creates a new mock instance.
+func NewMockStockObserver(ctrl *gomock.Controller) *MockStockObserver {
+	mock := &MockStockObserver{ctrl: ctrl}
+	mock.recorder = &MockStockObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockObserver) EXPECT() *MockStockObserverMockRecorder {
+	return m.recorder
+}
+
+// StockChanged mocks base method.
+func (m *MockStockObserver) StockChanged(ctx context.Context, change types.StockChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StockChanged", ctx, change)
+}
+
+// StockChanged indicates an expected call of StockChanged.
+func (mr *MockStockObserverMockRecorder) StockChanged(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockChanged", reflect.TypeOf((*MockStockObserver)(nil).StockChanged), ctx, change)
+}
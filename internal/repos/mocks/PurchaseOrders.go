@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./purchaseOrders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./purchaseOrders.go -destination=./mocks/PurchaseOrders.go -package=mock_repos PurchaseOrders
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockPurchaseOrders is a mock of PurchaseOrders interface.
+type MockPurchaseOrders struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseOrdersMockRecorder
+}
+
+// MockPurchaseOrdersMockRecorder is the mock recorder for MockPurchaseOrders.
+type MockPurchaseOrdersMockRecorder struct {
+	mock *MockPurchaseOrders
+}
+
+// NewMockPurchaseOrders creates a new mock instance.
+func NewMockPurchaseOrders(ctrl *gomock.Controller) *MockPurchaseOrders {
+	mock := &MockPurchaseOrders{ctrl: ctrl}
+	mock.recorder = &MockPurchaseOrdersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseOrders) EXPECT() *MockPurchaseOrdersMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPurchaseOrders) Create(ctx context.Context, newOrder types.NewPurchaseOrder) (*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newOrder)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPurchaseOrdersMockRecorder) Create(ctx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPurchaseOrders)(nil).Create), ctx, newOrder)
+}
+
+// CreateTx mocks base method.
+func (m *MockPurchaseOrders) CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewPurchaseOrder) (*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newOrder)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockPurchaseOrdersMockRecorder) CreateTx(ctx, tx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockPurchaseOrders)(nil).CreateTx), ctx, tx, newOrder)
+}
+
+// Destroy mocks base method.
+func (m *MockPurchaseOrders) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockPurchaseOrdersMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockPurchaseOrders)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockPurchaseOrders) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockPurchaseOrdersMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockPurchaseOrders)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockPurchaseOrders) Find(ctx context.Context, opts *repos.PurchaseOrdersFind) ([]*types.PurchaseOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.PurchaseOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPurchaseOrdersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPurchaseOrders)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockPurchaseOrders) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.PurchaseOrdersFind) ([]*types.PurchaseOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.PurchaseOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockPurchaseOrdersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockPurchaseOrders)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockPurchaseOrders) Get(ctx context.Context, id int64) (*types.PurchaseOrder, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPurchaseOrdersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPurchaseOrders)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockPurchaseOrders) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PurchaseOrder, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockPurchaseOrdersMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockPurchaseOrders)(nil).GetTx), ctx, tx, id)
+}
+
+// Transition mocks base method.
+func (m *MockPurchaseOrders) Transition(ctx context.Context, id int64, to types.PurchaseOrderStatus) (*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, id, to)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockPurchaseOrdersMockRecorder) Transition(ctx, id, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockPurchaseOrders)(nil).Transition), ctx, id, to)
+}
+
+// TransitionTx mocks base method.
+func (m *MockPurchaseOrders) TransitionTx(ctx context.Context, tx *xorm.Session, id int64, to types.PurchaseOrderStatus) (*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionTx", ctx, tx, id, to)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionTx indicates an expected call of TransitionTx.
+func (mr *MockPurchaseOrdersMockRecorder) TransitionTx(ctx, tx, id, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionTx", reflect.TypeOf((*MockPurchaseOrders)(nil).TransitionTx), ctx, tx, id, to)
+}
+
+// Update mocks base method.
+func (m *MockPurchaseOrders) Update(ctx context.Context, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPurchaseOrdersMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPurchaseOrders)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockPurchaseOrders) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockPurchaseOrdersMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockPurchaseOrders)(nil).UpdateTx), ctx, tx, diff)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./putaway.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./putaway.go -destination=./mocks/Putaway.go -package=mock_repos Putaway
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockPutaway is a mock of Putaway interface.
+type MockPutaway struct {
+	ctrl     *gomock.Controller
+	recorder *MockPutawayMockRecorder
+}
+
+// MockPutawayMockRecorder is the mock recorder for MockPutaway.
+type MockPutawayMockRecorder struct {
+	mock *MockPutaway
+}
+
+// NewMockPutaway creates a new mock instance.
+func NewMockPutaway(ctrl *gomock.Controller) *MockPutaway {
+	mock := &MockPutaway{ctrl: ctrl}
+	mock.recorder = &MockPutawayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPutaway) EXPECT() *MockPutawayMockRecorder {
+	return m.recorder
+}
+
+// Move mocks base method.
+func (m *MockPutaway) Move(ctx context.Context, move types.MoveStock) ([]*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, move)
+	ret0, _ := ret[0].([]*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockPutawayMockRecorder) Move(ctx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockPutaway)(nil).Move), ctx, move)
+}
+
+// MoveTx mocks base method.
+func (m *MockPutaway) MoveTx(ctx context.Context, tx *xorm.Session, move types.MoveStock) ([]*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTx", ctx, tx, move)
+	ret0, _ := ret[0].([]*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTx indicates an expected call of MoveTx.
+func (mr *MockPutawayMockRecorder) MoveTx(ctx, tx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTx", reflect.TypeOf((*MockPutaway)(nil).MoveTx), ctx, tx, move)
+}
+
+// Suggest mocks base method.
+func (m *MockPutaway) Suggest(ctx context.Context, receiptID int64) ([]*types.PutawaySuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, receiptID)
+	ret0, _ := ret[0].([]*types.PutawaySuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockPutawayMockRecorder) Suggest(ctx, receiptID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockPutaway)(nil).Suggest), ctx, receiptID)
+}
+
+// SuggestTx mocks base method.
+func (m *MockPutaway) SuggestTx(ctx context.Context, tx *xorm.Session, receiptID int64) ([]*types.PutawaySuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTx", ctx, tx, receiptID)
+	ret0, _ := ret[0].([]*types.PutawaySuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestTx indicates an expected call of SuggestTx.
+func (mr *MockPutawayMockRecorder) SuggestTx(ctx, tx, receiptID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTx", reflect.TypeOf((*MockPutaway)(nil).SuggestTx), ctx, tx, receiptID)
+}
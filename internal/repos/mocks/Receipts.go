@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./receipts.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./receipts.go -destination=./mocks/Receipts.go -package=mock_repos Receipts
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockReceipts is a mock of Receipts interface.
+type MockReceipts struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptsMockRecorder
+}
+
+// MockReceiptsMockRecorder is the mock recorder for MockReceipts.
+type MockReceiptsMockRecorder struct {
+	mock *MockReceipts
+}
+
+// NewMockReceipts creates a new mock instance.
+func NewMockReceipts(ctrl *gomock.Controller) *MockReceipts {
+	mock := &MockReceipts{ctrl: ctrl}
+	mock.recorder = &MockReceiptsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceipts) EXPECT() *MockReceiptsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReceipts) Create(ctx context.Context, newReceipt types.NewReceipt) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newReceipt)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReceiptsMockRecorder) Create(ctx, newReceipt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReceipts)(nil).Create), ctx, newReceipt)
+}
+
+// CreateTx mocks base method.
+func (m *MockReceipts) CreateTx(ctx context.Context, tx *xorm.Session, newReceipt types.NewReceipt) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newReceipt)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockReceiptsMockRecorder) CreateTx(ctx, tx, newReceipt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockReceipts)(nil).CreateTx), ctx, tx, newReceipt)
+}
+
+// Find mocks base method.
+func (m *MockReceipts) Find(ctx context.Context, opts *repos.ReceiptsFind) ([]*types.Receipt, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Receipt)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReceiptsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReceipts)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockReceipts) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.ReceiptsFind) ([]*types.Receipt, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Receipt)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockReceiptsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockReceipts)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockReceipts) Get(ctx context.Context, id int64) (*types.Receipt, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReceiptsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReceipts)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockReceipts) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Receipt, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockReceiptsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockReceipts)(nil).GetTx), ctx, tx, id)
+}
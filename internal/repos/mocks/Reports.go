@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./reports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./reports.go -destination=./mocks/Reports.go -package=mock_repos Reports
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockReports is a mock of Reports interface.
+type MockReports struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportsMockRecorder
+}
+
+// MockReportsMockRecorder is the mock recorder for MockReports.
+type MockReportsMockRecorder struct {
+	mock *MockReports
+}
+
+// NewMockReports creates a new mock instance.
+func NewMockReports(ctrl *gomock.Controller) *MockReports {
+	mock := &MockReports{ctrl: ctrl}
+	mock.recorder = &MockReportsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReports) EXPECT() *MockReportsMockRecorder {
+	return m.recorder
+}
+
+// ABC mocks base method.
+func (m *MockReports) ABC(ctx context.Context, opts *repos.ABCFind) ([]*types.ABCLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ABC", ctx, opts)
+	ret0, _ := ret[0].([]*types.ABCLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ABC indicates an expected call of ABC.
+func (mr *MockReportsMockRecorder) ABC(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ABC", reflect.TypeOf((*MockReports)(nil).ABC), ctx, opts)
+}
+
+// ABCTx mocks base method.
+func (m *MockReports) ABCTx(ctx context.Context, tx *xorm.Session, opts *repos.ABCFind) ([]*types.ABCLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ABCTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ABCLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ABCTx indicates an expected call of ABCTx.
+func (mr *MockReportsMockRecorder) ABCTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ABCTx", reflect.TypeOf((*MockReports)(nil).ABCTx), ctx, tx, opts)
+}
+
+// Aging mocks base method.
+func (m *MockReports) Aging(ctx context.Context, opts *repos.AgingFind) ([]*types.AgingLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aging", ctx, opts)
+	ret0, _ := ret[0].([]*types.AgingLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aging indicates an expected call of Aging.
+func (mr *MockReportsMockRecorder) Aging(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aging", reflect.TypeOf((*MockReports)(nil).Aging), ctx, opts)
+}
+
+// AgingTx mocks base method.
+func (m *MockReports) AgingTx(ctx context.Context, tx *xorm.Session, opts *repos.AgingFind) ([]*types.AgingLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AgingTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.AgingLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgingTx indicates an expected call of AgingTx.
+func (mr *MockReportsMockRecorder) AgingTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgingTx", reflect.TypeOf((*MockReports)(nil).AgingTx), ctx, tx, opts)
+}
+
+// DeadStock mocks base method.
+func (m *MockReports) DeadStock(ctx context.Context, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadStock", ctx, opts)
+	ret0, _ := ret[0].([]*types.ProductUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadStock indicates an expected call of DeadStock.
+func (mr *MockReportsMockRecorder) DeadStock(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadStock", reflect.TypeOf((*MockReports)(nil).DeadStock), ctx, opts)
+}
+
+// DeadStockTx mocks base method.
+func (m *MockReports) DeadStockTx(ctx context.Context, tx *xorm.Session, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadStockTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ProductUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadStockTx indicates an expected call of DeadStockTx.
+func (mr *MockReportsMockRecorder) DeadStockTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadStockTx", reflect.TypeOf((*MockReports)(nil).DeadStockTx), ctx, tx, opts)
+}
+
+// Expiring mocks base method.
+func (m *MockReports) Expiring(ctx context.Context, opts *repos.ExpiringFind) ([]*types.ExpiringLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expiring", ctx, opts)
+	ret0, _ := ret[0].([]*types.ExpiringLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expiring indicates an expected call of Expiring.
+func (mr *MockReportsMockRecorder) Expiring(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expiring", reflect.TypeOf((*MockReports)(nil).Expiring), ctx, opts)
+}
+
+// ExpiringTx mocks base method.
+func (m *MockReports) ExpiringTx(ctx context.Context, tx *xorm.Session, opts *repos.ExpiringFind) ([]*types.ExpiringLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpiringTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ExpiringLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpiringTx indicates an expected call of ExpiringTx.
+func (mr *MockReportsMockRecorder) ExpiringTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpiringTx", reflect.TypeOf((*MockReports)(nil).ExpiringTx), ctx, tx, opts)
+}
+
+// LowStock mocks base method.
+func (m *MockReports) LowStock(ctx context.Context, opts *repos.LowStockFind) ([]*types.LowStockItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LowStock", ctx, opts)
+	ret0, _ := ret[0].([]*types.LowStockItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LowStock indicates an expected call of LowStock.
+func (mr *MockReportsMockRecorder) LowStock(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LowStock", reflect.TypeOf((*MockReports)(nil).LowStock), ctx, opts)
+}
+
+// LowStockTx mocks base method.
+func (m *MockReports) LowStockTx(ctx context.Context, tx *xorm.Session, opts *repos.LowStockFind) ([]*types.LowStockItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LowStockTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.LowStockItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LowStockTx indicates an expected call of LowStockTx.
+func (mr *MockReportsMockRecorder) LowStockTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LowStockTx", reflect.TypeOf((*MockReports)(nil).LowStockTx), ctx, tx, opts)
+}
+
+// SlowMoving mocks base method.
+func (m *MockReports) SlowMoving(ctx context.Context, opts *repos.SlowMovingFind) ([]*types.ProductUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlowMoving", ctx, opts)
+	ret0, _ := ret[0].([]*types.ProductUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlowMoving indicates an expected call of SlowMoving.
+func (mr *MockReportsMockRecorder) SlowMoving(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlowMoving", reflect.TypeOf((*MockReports)(nil).SlowMoving), ctx, opts)
+}
+
+// SlowMovingTx mocks base method.
+func (m *MockReports) SlowMovingTx(ctx context.Context, tx *xorm.Session, opts *repos.SlowMovingFind) ([]*types.ProductUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlowMovingTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ProductUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlowMovingTx indicates an expected call of SlowMovingTx.
+func (mr *MockReportsMockRecorder) SlowMovingTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlowMovingTx", reflect.TypeOf((*MockReports)(nil).SlowMovingTx), ctx, tx, opts)
+}
+
+// Turnover mocks base method.
+func (m *MockReports) Turnover(ctx context.Context, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Turnover", ctx, opts)
+	ret0, _ := ret[0].([]*types.ProductUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Turnover indicates an expected call of Turnover.
+func (mr *MockReportsMockRecorder) Turnover(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Turnover", reflect.TypeOf((*MockReports)(nil).Turnover), ctx, opts)
+}
+
+// TurnoverTx mocks base method.
+func (m *MockReports) TurnoverTx(ctx context.Context, tx *xorm.Session, opts *repos.UsageFind) ([]*types.ProductUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TurnoverTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ProductUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TurnoverTx indicates an expected call of TurnoverTx.
+func (mr *MockReportsMockRecorder) TurnoverTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TurnoverTx", reflect.TypeOf((*MockReports)(nil).TurnoverTx), ctx, tx, opts)
+}
+
+// Valuation mocks base method.
+func (m *MockReports) Valuation(ctx context.Context, opts *repos.ValuationFind) ([]*types.ValuationLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Valuation", ctx, opts)
+	ret0, _ := ret[0].([]*types.ValuationLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Valuation indicates an expected call of Valuation.
+func (mr *MockReportsMockRecorder) Valuation(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Valuation", reflect.TypeOf((*MockReports)(nil).Valuation), ctx, opts)
+}
+
+// ValuationTx mocks base method.
+func (m *MockReports) ValuationTx(ctx context.Context, tx *xorm.Session, opts *repos.ValuationFind) ([]*types.ValuationLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValuationTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.ValuationLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValuationTx indicates an expected call of ValuationTx.
+func (mr *MockReportsMockRecorder) ValuationTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValuationTx", reflect.TypeOf((*MockReports)(nil).ValuationTx), ctx, tx, opts)
+}
@@ -0,0 +1,227 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./returns.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./returns.go -destination=./mocks/Returns.go -package=mock_repos Returns
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockReturns is a mock of Returns interface.
+type MockReturns struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnsMockRecorder
+}
+
+// MockReturnsMockRecorder is the mock recorder for MockReturns.
+type MockReturnsMockRecorder struct {
+	mock *MockReturns
+}
+
+// NewMockReturns creates a new mock instance.
+func NewMockReturns(ctrl *gomock.Controller) *MockReturns {
+	mock := &MockReturns{ctrl: ctrl}
+	mock.recorder = &MockReturnsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturns) EXPECT() *MockReturnsMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockReturns) Cancel(ctx context.Context, id int64) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockReturnsMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockReturns)(nil).Cancel), ctx, id)
+}
+
+// CancelTx mocks base method.
+func (m *MockReturns) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTx indicates an expected call of CancelTx.
+func (mr *MockReturnsMockRecorder) CancelTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockReturns)(nil).CancelTx), ctx, tx, id)
+}
+
+// Create mocks base method.
+func (m *MockReturns) Create(ctx context.Context, newReturn types.NewReturn) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newReturn)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReturnsMockRecorder) Create(ctx, newReturn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReturns)(nil).Create), ctx, newReturn)
+}
+
+// CreateTx mocks base method.
+func (m *MockReturns) CreateTx(ctx context.Context, tx *xorm.Session, newReturn types.NewReturn) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newReturn)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockReturnsMockRecorder) CreateTx(ctx, tx, newReturn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockReturns)(nil).CreateTx), ctx, tx, newReturn)
+}
+
+// Find mocks base method.
+func (m *MockReturns) Find(ctx context.Context, opts *repos.ReturnsFind) ([]*types.Return, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Return)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReturnsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReturns)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockReturns) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.ReturnsFind) ([]*types.Return, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Return)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockReturnsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockReturns)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockReturns) Get(ctx context.Context, id int64) (*types.Return, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReturnsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReturns)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockReturns) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Return, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockReturnsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockReturns)(nil).GetTx), ctx, tx, id)
+}
+
+// Receive mocks base method.
+func (m *MockReturns) Receive(ctx context.Context, receive *types.DisposeReturn) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, receive)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockReturnsMockRecorder) Receive(ctx, receive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockReturns)(nil).Receive), ctx, receive)
+}
+
+// ReceiveTx mocks base method.
+func (m *MockReturns) ReceiveTx(ctx context.Context, tx *xorm.Session, receive *types.DisposeReturn) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTx", ctx, tx, receive)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTx indicates an expected call of ReceiveTx.
+func (mr *MockReturnsMockRecorder) ReceiveTx(ctx, tx, receive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTx", reflect.TypeOf((*MockReturns)(nil).ReceiveTx), ctx, tx, receive)
+}
+
+// Release mocks base method.
+func (m *MockReturns) Release(ctx context.Context, release *types.DisposeReturn) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, release)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReturnsMockRecorder) Release(ctx, release any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReturns)(nil).Release), ctx, release)
+}
+
+// ReleaseTx mocks base method.
+func (m *MockReturns) ReleaseTx(ctx context.Context, tx *xorm.Session, release *types.DisposeReturn) (*types.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTx", ctx, tx, release)
+	ret0, _ := ret[0].(*types.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTx indicates an expected call of ReleaseTx.
+func (mr *MockReturnsMockRecorder) ReleaseTx(ctx, tx, release any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTx", reflect.TypeOf((*MockReturns)(nil).ReleaseTx), ctx, tx, release)
+}
@@ -0,0 +1,265 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./salesOrders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./salesOrders.go -destination=./mocks/SalesOrders.go -package=mock_repos SalesOrders
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockSalesOrders is a mock of SalesOrders interface.
+type MockSalesOrders struct {
+	ctrl     *gomock.Controller
+	recorder *MockSalesOrdersMockRecorder
+}
+
+// MockSalesOrdersMockRecorder is the mock recorder for MockSalesOrders.
+type MockSalesOrdersMockRecorder struct {
+	mock *MockSalesOrders
+}
+
+// NewMockSalesOrders creates a new mock instance.
+func NewMockSalesOrders(ctrl *gomock.Controller) *MockSalesOrders {
+	mock := &MockSalesOrders{ctrl: ctrl}
+	mock.recorder = &MockSalesOrdersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSalesOrders) EXPECT() *MockSalesOrdersMockRecorder {
+	return m.recorder
+}
+
+// Allocate mocks base method.
+func (m *MockSalesOrders) Allocate(ctx context.Context, productIDs ...int64) ([]*types.SalesOrderLine, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range productIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Allocate", varargs...)
+	ret0, _ := ret[0].([]*types.SalesOrderLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allocate indicates an expected call of Allocate.
+func (mr *MockSalesOrdersMockRecorder) Allocate(ctx any, productIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, productIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allocate", reflect.TypeOf((*MockSalesOrders)(nil).Allocate), varargs...)
+}
+
+// AllocateTx mocks base method.
+func (m *MockSalesOrders) AllocateTx(ctx context.Context, tx *xorm.Session, productIDs ...int64) ([]*types.SalesOrderLine, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx}
+	for _, a := range productIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AllocateTx", varargs...)
+	ret0, _ := ret[0].([]*types.SalesOrderLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllocateTx indicates an expected call of AllocateTx.
+func (mr *MockSalesOrdersMockRecorder) AllocateTx(ctx, tx any, productIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx}, productIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateTx", reflect.TypeOf((*MockSalesOrders)(nil).AllocateTx), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockSalesOrders) Create(ctx context.Context, newOrder types.NewSalesOrder) (*types.SalesOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newOrder)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSalesOrdersMockRecorder) Create(ctx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSalesOrders)(nil).Create), ctx, newOrder)
+}
+
+// CreateTx mocks base method.
+func (m *MockSalesOrders) CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewSalesOrder) (*types.SalesOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newOrder)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockSalesOrdersMockRecorder) CreateTx(ctx, tx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockSalesOrders)(nil).CreateTx), ctx, tx, newOrder)
+}
+
+// Destroy mocks base method.
+func (m *MockSalesOrders) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockSalesOrdersMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockSalesOrders)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockSalesOrders) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockSalesOrdersMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockSalesOrders)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockSalesOrders) Find(ctx context.Context, opts *repos.SalesOrdersFind) ([]*types.SalesOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.SalesOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSalesOrdersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSalesOrders)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockSalesOrders) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.SalesOrdersFind) ([]*types.SalesOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.SalesOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockSalesOrdersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockSalesOrders)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockSalesOrders) Get(ctx context.Context, id int64) (*types.SalesOrder, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSalesOrdersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSalesOrders)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockSalesOrders) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.SalesOrder, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockSalesOrdersMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockSalesOrders)(nil).GetTx), ctx, tx, id)
+}
+
+// Transition mocks base method.
+func (m *MockSalesOrders) Transition(ctx context.Context, id int64, to types.SalesOrderStatus) (*types.SalesOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, id, to)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockSalesOrdersMockRecorder) Transition(ctx, id, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockSalesOrders)(nil).Transition), ctx, id, to)
+}
+
+// TransitionTx mocks base method.
+func (m *MockSalesOrders) TransitionTx(ctx context.Context, tx *xorm.Session, id int64, to types.SalesOrderStatus) (*types.SalesOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionTx", ctx, tx, id, to)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionTx indicates an expected call of TransitionTx.
+func (mr *MockSalesOrdersMockRecorder) TransitionTx(ctx, tx, id, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionTx", reflect.TypeOf((*MockSalesOrders)(nil).TransitionTx), ctx, tx, id, to)
+}
+
+// Update mocks base method.
+func (m *MockSalesOrders) Update(ctx context.Context, diff *types.UpdateSalesOrder) (*types.SalesOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSalesOrdersMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSalesOrders)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockSalesOrders) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateSalesOrder) (*types.SalesOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.SalesOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockSalesOrdersMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockSalesOrders)(nil).UpdateTx), ctx, tx, diff)
+}
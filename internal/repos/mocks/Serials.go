@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./serials.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./serials.go -destination=./mocks/Serials.go -package=mock_repos Serials
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockSerials is a mock of Serials interface.
+type MockSerials struct {
+	ctrl     *gomock.Controller
+	recorder *MockSerialsMockRecorder
+}
+
+// MockSerialsMockRecorder is the mock recorder for MockSerials.
+type MockSerialsMockRecorder struct {
+	mock *MockSerials
+}
+
+// NewMockSerials creates a new mock instance.
+func NewMockSerials(ctrl *gomock.Controller) *MockSerials {
+	mock := &MockSerials{ctrl: ctrl}
+	mock.recorder = &MockSerialsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSerials) EXPECT() *MockSerialsMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockSerials) Find(ctx context.Context, opts *repos.SerialsFind) ([]*types.Serial, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Serial)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSerialsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSerials)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockSerials) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.SerialsFind) ([]*types.Serial, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Serial)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockSerialsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockSerials)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockSerials) Get(ctx context.Context, id int64) (*types.Serial, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Serial)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSerialsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSerials)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockSerials) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Serial, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Serial)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockSerialsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockSerials)(nil).GetTx), ctx, tx, id)
+}
+
+// History mocks base method.
+func (m *MockSerials) History(ctx context.Context, serialNumber string) (*types.SerialHistory, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, serialNumber)
+	ret0, _ := ret[0].(*types.SerialHistory)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// History indicates an expected call of History.
+func (mr *MockSerialsMockRecorder) History(ctx, serialNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockSerials)(nil).History), ctx, serialNumber)
+}
+
+// HistoryTx mocks base method.
+func (m *MockSerials) HistoryTx(ctx context.Context, tx *xorm.Session, serialNumber string) (*types.SerialHistory, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoryTx", ctx, tx, serialNumber)
+	ret0, _ := ret[0].(*types.SerialHistory)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HistoryTx indicates an expected call of HistoryTx.
+func (mr *MockSerialsMockRecorder) HistoryTx(ctx, tx, serialNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoryTx", reflect.TypeOf((*MockSerials)(nil).HistoryTx), ctx, tx, serialNumber)
+}
+
+// Move mocks base method.
+func (m *MockSerials) Move(ctx context.Context, move types.SerialMove) ([]*types.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, move)
+	ret0, _ := ret[0].([]*types.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockSerialsMockRecorder) Move(ctx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockSerials)(nil).Move), ctx, move)
+}
+
+// MoveTx mocks base method.
+func (m *MockSerials) MoveTx(ctx context.Context, tx *xorm.Session, move types.SerialMove) ([]*types.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTx", ctx, tx, move)
+	ret0, _ := ret[0].([]*types.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTx indicates an expected call of MoveTx.
+func (mr *MockSerialsMockRecorder) MoveTx(ctx, tx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTx", reflect.TypeOf((*MockSerials)(nil).MoveTx), ctx, tx, move)
+}
+
+// Receive mocks base method.
+func (m *MockSerials) Receive(ctx context.Context, move types.SerialMove) ([]*types.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, move)
+	ret0, _ := ret[0].([]*types.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockSerialsMockRecorder) Receive(ctx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockSerials)(nil).Receive), ctx, move)
+}
+
+// ReceiveTx mocks base method.
+func (m *MockSerials) ReceiveTx(ctx context.Context, tx *xorm.Session, move types.SerialMove) ([]*types.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTx", ctx, tx, move)
+	ret0, _ := ret[0].([]*types.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTx indicates an expected call of ReceiveTx.
+func (mr *MockSerialsMockRecorder) ReceiveTx(ctx, tx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTx", reflect.TypeOf((*MockSerials)(nil).ReceiveTx), ctx, tx, move)
+}
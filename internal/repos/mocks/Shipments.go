@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./shipments.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./shipments.go -destination=./mocks/Shipments.go -package=mock_repos Shipments
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockShipments is a mock of Shipments interface.
+type MockShipments struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentsMockRecorder
+}
+
+// MockShipmentsMockRecorder is the mock recorder for MockShipments.
+type MockShipmentsMockRecorder struct {
+	mock *MockShipments
+}
+
+// NewMockShipments creates a new mock instance.
+func NewMockShipments(ctrl *gomock.Controller) *MockShipments {
+	mock := &MockShipments{ctrl: ctrl}
+	mock.recorder = &MockShipmentsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipments) EXPECT() *MockShipmentsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShipments) Create(ctx context.Context, newShipment types.NewShipment) (*types.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newShipment)
+	ret0, _ := ret[0].(*types.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShipmentsMockRecorder) Create(ctx, newShipment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShipments)(nil).Create), ctx, newShipment)
+}
+
+// CreateTx mocks base method.
+func (m *MockShipments) CreateTx(ctx context.Context, tx *xorm.Session, newShipment types.NewShipment) (*types.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newShipment)
+	ret0, _ := ret[0].(*types.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockShipmentsMockRecorder) CreateTx(ctx, tx, newShipment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockShipments)(nil).CreateTx), ctx, tx, newShipment)
+}
+
+// Find mocks base method.
+func (m *MockShipments) Find(ctx context.Context, opts *repos.ShipmentsFind) ([]*types.Shipment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Shipment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockShipmentsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockShipments)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockShipments) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.ShipmentsFind) ([]*types.Shipment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Shipment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockShipmentsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockShipments)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockShipments) Get(ctx context.Context, id int64) (*types.Shipment, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Shipment)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockShipmentsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockShipments)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockShipments) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Shipment, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Shipment)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockShipmentsMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockShipments)(nil).GetTx), ctx, tx, id)
+}
+
+// Ship mocks base method.
+func (m *MockShipments) Ship(ctx context.Context, ship *types.ShipShipment) (*types.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ship", ctx, ship)
+	ret0, _ := ret[0].(*types.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ship indicates an expected call of Ship.
+func (mr *MockShipmentsMockRecorder) Ship(ctx, ship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ship", reflect.TypeOf((*MockShipments)(nil).Ship), ctx, ship)
+}
+
+// ShipTx mocks base method.
+func (m *MockShipments) ShipTx(ctx context.Context, tx *xorm.Session, ship *types.ShipShipment) (*types.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipTx", ctx, tx, ship)
+	ret0, _ := ret[0].(*types.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShipTx indicates an expected call of ShipTx.
+func (mr *MockShipmentsMockRecorder) ShipTx(ctx, tx, ship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipTx", reflect.TypeOf((*MockShipments)(nil).ShipTx), ctx, tx, ship)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./snapshots.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./snapshots.go -destination=./mocks/Snapshots.go -package=mock_repos Snapshots
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockSnapshots is a mock of Snapshots interface.
+type MockSnapshots struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotsMockRecorder
+}
+
+// MockSnapshotsMockRecorder is the mock recorder for MockSnapshots.
+type MockSnapshotsMockRecorder struct {
+	mock *MockSnapshots
+}
+
+// NewMockSnapshots creates a new mock instance.
+func NewMockSnapshots(ctrl *gomock.Controller) *MockSnapshots {
+	mock := &MockSnapshots{ctrl: ctrl}
+	mock.recorder = &MockSnapshotsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshots) EXPECT() *MockSnapshotsMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockSnapshots) Find(ctx context.Context, opts *repos.SnapshotsFind) ([]*types.StockSnapshot, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.StockSnapshot)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSnapshotsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSnapshots)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockSnapshots) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.SnapshotsFind) ([]*types.StockSnapshot, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.StockSnapshot)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockSnapshotsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockSnapshots)(nil).FindTx), ctx, tx, opts)
+}
+
+// Take mocks base method.
+func (m *MockSnapshots) Take(ctx context.Context) (*types.StockSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx)
+	ret0, _ := ret[0].(*types.StockSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockSnapshotsMockRecorder) Take(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockSnapshots)(nil).Take), ctx)
+}
+
+// TakeTx mocks base method.
+func (m *MockSnapshots) TakeTx(ctx context.Context, tx *xorm.Session) (*types.StockSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeTx", ctx, tx)
+	ret0, _ := ret[0].(*types.StockSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeTx indicates an expected call of TakeTx.
+func (mr *MockSnapshotsMockRecorder) TakeTx(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeTx", reflect.TypeOf((*MockSnapshots)(nil).TakeTx), ctx, tx)
+}
@@ -0,0 +1,227 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./stockLevels.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./stockLevels.go -destination=./mocks/StockLevels.go -package=mock_repos StockLevels
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockStockLevels is a mock of StockLevels interface.
+type MockStockLevels struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockLevelsMockRecorder
+}
+
+// MockStockLevelsMockRecorder is the mock recorder for MockStockLevels.
+type MockStockLevelsMockRecorder struct {
+	mock *MockStockLevels
+}
+
+// NewMockStockLevels creates a new mock instance.
+func NewMockStockLevels(ctrl *gomock.Controller) *MockStockLevels {
+	mock := &MockStockLevels{ctrl: ctrl}
+	mock.recorder = &MockStockLevelsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockLevels) EXPECT() *MockStockLevelsMockRecorder {
+	return m.recorder
+}
+
+// Adjust mocks base method.
+func (m *MockStockLevels) Adjust(ctx context.Context, productID, locationID, delta int64, lotID *int64) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Adjust", ctx, productID, locationID, delta, lotID)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Adjust indicates an expected call of Adjust.
+func (mr *MockStockLevelsMockRecorder) Adjust(ctx, productID, locationID, delta, lotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Adjust", reflect.TypeOf((*MockStockLevels)(nil).Adjust), ctx, productID, locationID, delta, lotID)
+}
+
+// AdjustTx mocks base method.
+func (m *MockStockLevels) AdjustTx(ctx context.Context, tx *xorm.Session, productID, locationID, delta int64, lotID *int64) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustTx", ctx, tx, productID, locationID, delta, lotID)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustTx indicates an expected call of AdjustTx.
+func (mr *MockStockLevelsMockRecorder) AdjustTx(ctx, tx, productID, locationID, delta, lotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustTx", reflect.TypeOf((*MockStockLevels)(nil).AdjustTx), ctx, tx, productID, locationID, delta, lotID)
+}
+
+// Find mocks base method.
+func (m *MockStockLevels) Find(ctx context.Context, opts *repos.StockLevelsFind) ([]*types.StockLevel, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.StockLevel)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockStockLevelsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockStockLevels)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockStockLevels) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.StockLevelsFind) ([]*types.StockLevel, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.StockLevel)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockStockLevelsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockStockLevels)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockStockLevels) Get(ctx context.Context, productID, locationID int64) (*types.StockLevel, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, productID, locationID)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStockLevelsMockRecorder) Get(ctx, productID, locationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStockLevels)(nil).Get), ctx, productID, locationID)
+}
+
+// GetTx mocks base method.
+func (m *MockStockLevels) GetTx(ctx context.Context, tx *xorm.Session, productID, locationID int64) (*types.StockLevel, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, productID, locationID)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockStockLevelsMockRecorder) GetTx(ctx, tx, productID, locationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockStockLevels)(nil).GetTx), ctx, tx, productID, locationID)
+}
+
+// Move mocks base method.
+func (m *MockStockLevels) Move(ctx context.Context, productID, locationID, delta int64) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, productID, locationID, delta)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockStockLevelsMockRecorder) Move(ctx, productID, locationID, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockStockLevels)(nil).Move), ctx, productID, locationID, delta)
+}
+
+// MoveTx mocks base method.
+func (m *MockStockLevels) MoveTx(ctx context.Context, tx *xorm.Session, productID, locationID, delta int64) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTx", ctx, tx, productID, locationID, delta)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTx indicates an expected call of MoveTx.
+func (mr *MockStockLevelsMockRecorder) MoveTx(ctx, tx, productID, locationID, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTx", reflect.TypeOf((*MockStockLevels)(nil).MoveTx), ctx, tx, productID, locationID, delta)
+}
+
+// Receive mocks base method.
+func (m *MockStockLevels) Receive(ctx context.Context, productID, locationID, qty, unitCost int64) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, productID, locationID, qty, unitCost)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockStockLevelsMockRecorder) Receive(ctx, productID, locationID, qty, unitCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockStockLevels)(nil).Receive), ctx, productID, locationID, qty, unitCost)
+}
+
+// ReceiveTx mocks base method.
+func (m *MockStockLevels) ReceiveTx(ctx context.Context, tx *xorm.Session, productID, locationID, qty, unitCost int64) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTx", ctx, tx, productID, locationID, qty, unitCost)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTx indicates an expected call of ReceiveTx.
+func (mr *MockStockLevelsMockRecorder) ReceiveTx(ctx, tx, productID, locationID, qty, unitCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTx", reflect.TypeOf((*MockStockLevels)(nil).ReceiveTx), ctx, tx, productID, locationID, qty, unitCost)
+}
+
+// Set mocks base method.
+func (m *MockStockLevels) Set(ctx context.Context, set types.SetStockLevel) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, set)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockStockLevelsMockRecorder) Set(ctx, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockStockLevels)(nil).Set), ctx, set)
+}
+
+// SetTx mocks base method.
+func (m *MockStockLevels) SetTx(ctx context.Context, tx *xorm.Session, set types.SetStockLevel) (*types.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTx", ctx, tx, set)
+	ret0, _ := ret[0].(*types.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTx indicates an expected call of SetTx.
+func (mr *MockStockLevelsMockRecorder) SetTx(ctx, tx, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTx", reflect.TypeOf((*MockStockLevels)(nil).SetTx), ctx, tx, set)
+}
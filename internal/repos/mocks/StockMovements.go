@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./stockMovements.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./stockMovements.go -destination=./mocks/StockMovements.go -package=mock_repos StockMovements
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockStockMovements is a mock of StockMovements interface.
+type MockStockMovements struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockMovementsMockRecorder
+}
+
+// MockStockMovementsMockRecorder is the mock recorder for MockStockMovements.
+type MockStockMovementsMockRecorder struct {
+	mock *MockStockMovements
+}
+
+// NewMockStockMovements creates a new mock instance.
+func NewMockStockMovements(ctrl *gomock.Controller) *MockStockMovements {
+	mock := &MockStockMovements{ctrl: ctrl}
+	mock.recorder = &MockStockMovementsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockMovements) EXPECT() *MockStockMovementsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStockMovements) Create(ctx context.Context, newMovement types.NewStockMovement) (*types.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newMovement)
+	ret0, _ := ret[0].(*types.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStockMovementsMockRecorder) Create(ctx, newMovement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStockMovements)(nil).Create), ctx, newMovement)
+}
+
+// CreateTx mocks base method.
+func (m *MockStockMovements) CreateTx(ctx context.Context, tx *xorm.Session, newMovement types.NewStockMovement) (*types.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newMovement)
+	ret0, _ := ret[0].(*types.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockStockMovementsMockRecorder) CreateTx(ctx, tx, newMovement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockStockMovements)(nil).CreateTx), ctx, tx, newMovement)
+}
+
+// Find mocks base method.
+func (m *MockStockMovements) Find(ctx context.Context, opts *repos.StockMovementsFind) ([]*types.StockMovement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.StockMovement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockStockMovementsMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockStockMovements)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockStockMovements) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.StockMovementsFind) ([]*types.StockMovement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.StockMovement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockStockMovementsMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockStockMovements)(nil).FindTx), ctx, tx, opts)
+}
@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./suppliers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./suppliers.go -destination=./mocks/Suppliers.go -package=mock_repos Suppliers
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockSuppliers is a mock of Suppliers interface.
+type MockSuppliers struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuppliersMockRecorder
+}
+
+// MockSuppliersMockRecorder is the mock recorder for MockSuppliers.
+type MockSuppliersMockRecorder struct {
+	mock *MockSuppliers
+}
+
+// NewMockSuppliers creates a new mock instance.
+func NewMockSuppliers(ctrl *gomock.Controller) *MockSuppliers {
+	mock := &MockSuppliers{ctrl: ctrl}
+	mock.recorder = &MockSuppliersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuppliers) EXPECT() *MockSuppliersMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSuppliers) Create(ctx context.Context, newSupplier types.NewSupplier) (*types.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newSupplier)
+	ret0, _ := ret[0].(*types.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSuppliersMockRecorder) Create(ctx, newSupplier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSuppliers)(nil).Create), ctx, newSupplier)
+}
+
+// CreateTx mocks base method.
+func (m *MockSuppliers) CreateTx(ctx context.Context, tx *xorm.Session, newSupplier types.NewSupplier) (*types.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newSupplier)
+	ret0, _ := ret[0].(*types.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockSuppliersMockRecorder) CreateTx(ctx, tx, newSupplier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockSuppliers)(nil).CreateTx), ctx, tx, newSupplier)
+}
+
+// Destroy mocks base method.
+func (m *MockSuppliers) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockSuppliersMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockSuppliers)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockSuppliers) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockSuppliersMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockSuppliers)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockSuppliers) Find(ctx context.Context, opts *repos.SuppliersFind) ([]*types.Supplier, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Supplier)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSuppliersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSuppliers)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockSuppliers) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.SuppliersFind) ([]*types.Supplier, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Supplier)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockSuppliersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockSuppliers)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockSuppliers) Get(ctx context.Context, id int64) (*types.Supplier, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Supplier)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSuppliersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSuppliers)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockSuppliers) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Supplier, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Supplier)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockSuppliersMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockSuppliers)(nil).GetTx), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockSuppliers) Update(ctx context.Context, diff *types.UpdateSupplier) (*types.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSuppliersMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSuppliers)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockSuppliers) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateSupplier) (*types.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockSuppliersMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockSuppliers)(nil).UpdateTx), ctx, tx, diff)
+}
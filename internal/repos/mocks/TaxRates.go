@@ -0,0 +1,255 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./taxRates.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./taxRates.go -destination=./mocks/TaxRates.go -package=mock_repos TaxRates
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockTaxRates is a mock of TaxRates interface.
+type MockTaxRates struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRatesMockRecorder
+}
+
+// MockTaxRatesMockRecorder is the mock recorder for MockTaxRates.
+type MockTaxRatesMockRecorder struct {
+	mock *MockTaxRates
+}
+
+// NewMockTaxRates creates a new mock instance.
+func NewMockTaxRates(ctrl *gomock.Controller) *MockTaxRates {
+	mock := &MockTaxRates{ctrl: ctrl}
+	mock.recorder = &MockTaxRatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRates) EXPECT() *MockTaxRatesMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockTaxRates) Calculate(ctx context.Context, salesOrderID int64) (*types.TaxBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, salesOrderID)
+	ret0, _ := ret[0].(*types.TaxBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockTaxRatesMockRecorder) Calculate(ctx, salesOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockTaxRates)(nil).Calculate), ctx, salesOrderID)
+}
+
+// CalculateQtys mocks base method.
+func (m *MockTaxRates) CalculateQtys(ctx context.Context, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateQtys", ctx, salesOrderID, qtys)
+	ret0, _ := ret[0].(*types.TaxBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateQtys indicates an expected call of CalculateQtys.
+func (mr *MockTaxRatesMockRecorder) CalculateQtys(ctx, salesOrderID, qtys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateQtys", reflect.TypeOf((*MockTaxRates)(nil).CalculateQtys), ctx, salesOrderID, qtys)
+}
+
+// CalculateQtysTx mocks base method.
+func (m *MockTaxRates) CalculateQtysTx(ctx context.Context, tx *xorm.Session, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateQtysTx", ctx, tx, salesOrderID, qtys)
+	ret0, _ := ret[0].(*types.TaxBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateQtysTx indicates an expected call of CalculateQtysTx.
+func (mr *MockTaxRatesMockRecorder) CalculateQtysTx(ctx, tx, salesOrderID, qtys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateQtysTx", reflect.TypeOf((*MockTaxRates)(nil).CalculateQtysTx), ctx, tx, salesOrderID, qtys)
+}
+
+// CalculateTx mocks base method.
+func (m *MockTaxRates) CalculateTx(ctx context.Context, tx *xorm.Session, salesOrderID int64) (*types.TaxBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateTx", ctx, tx, salesOrderID)
+	ret0, _ := ret[0].(*types.TaxBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateTx indicates an expected call of CalculateTx.
+func (mr *MockTaxRatesMockRecorder) CalculateTx(ctx, tx, salesOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateTx", reflect.TypeOf((*MockTaxRates)(nil).CalculateTx), ctx, tx, salesOrderID)
+}
+
+// Create mocks base method.
+func (m *MockTaxRates) Create(ctx context.Context, newRate types.NewTaxRate) (*types.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newRate)
+	ret0, _ := ret[0].(*types.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaxRatesMockRecorder) Create(ctx, newRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaxRates)(nil).Create), ctx, newRate)
+}
+
+// CreateTx mocks base method.
+func (m *MockTaxRates) CreateTx(ctx context.Context, tx *xorm.Session, newRate types.NewTaxRate) (*types.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newRate)
+	ret0, _ := ret[0].(*types.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockTaxRatesMockRecorder) CreateTx(ctx, tx, newRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockTaxRates)(nil).CreateTx), ctx, tx, newRate)
+}
+
+// Destroy mocks base method.
+func (m *MockTaxRates) Destroy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *MockTaxRatesMockRecorder) Destroy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockTaxRates)(nil).Destroy), ctx, id)
+}
+
+// DestroyTx mocks base method.
+func (m *MockTaxRates) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyTx indicates an expected call of DestroyTx.
+func (mr *MockTaxRatesMockRecorder) DestroyTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyTx", reflect.TypeOf((*MockTaxRates)(nil).DestroyTx), ctx, tx, id)
+}
+
+// Find mocks base method.
+func (m *MockTaxRates) Find(ctx context.Context, opts *repos.TaxRatesFind) ([]*types.TaxRate, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.TaxRate)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTaxRatesMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTaxRates)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockTaxRates) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.TaxRatesFind) ([]*types.TaxRate, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.TaxRate)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockTaxRatesMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockTaxRates)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockTaxRates) Get(ctx context.Context, id int64) (*types.TaxRate, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.TaxRate)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaxRatesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaxRates)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockTaxRates) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.TaxRate, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.TaxRate)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockTaxRatesMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockTaxRates)(nil).GetTx), ctx, tx, id)
+}
+
+// Update mocks base method.
+func (m *MockTaxRates) Update(ctx context.Context, diff *types.UpdateTaxRate) (*types.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, diff)
+	ret0, _ := ret[0].(*types.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaxRatesMockRecorder) Update(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaxRates)(nil).Update), ctx, diff)
+}
+
+// UpdateTx mocks base method.
+func (m *MockTaxRates) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateTaxRate) (*types.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", ctx, tx, diff)
+	ret0, _ := ret[0].(*types.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockTaxRatesMockRecorder) UpdateTx(ctx, tx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockTaxRates)(nil).UpdateTx), ctx, tx, diff)
+}
@@ -0,0 +1,227 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./transfers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./transfers.go -destination=./mocks/Transfers.go -package=mock_repos Transfers
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockTransfers is a mock of Transfers interface.
+type MockTransfers struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransfersMockRecorder
+}
+
+// MockTransfersMockRecorder is the mock recorder for MockTransfers.
+type MockTransfersMockRecorder struct {
+	mock *MockTransfers
+}
+
+// NewMockTransfers creates a new mock instance.
+func NewMockTransfers(ctrl *gomock.Controller) *MockTransfers {
+	mock := &MockTransfers{ctrl: ctrl}
+	mock.recorder = &MockTransfersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransfers) EXPECT() *MockTransfersMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockTransfers) Cancel(ctx context.Context, id int64) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockTransfersMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockTransfers)(nil).Cancel), ctx, id)
+}
+
+// CancelTx mocks base method.
+func (m *MockTransfers) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTx indicates an expected call of CancelTx.
+func (mr *MockTransfersMockRecorder) CancelTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockTransfers)(nil).CancelTx), ctx, tx, id)
+}
+
+// Create mocks base method.
+func (m *MockTransfers) Create(ctx context.Context, newTransfer types.NewTransfer) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newTransfer)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransfersMockRecorder) Create(ctx, newTransfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransfers)(nil).Create), ctx, newTransfer)
+}
+
+// CreateTx mocks base method.
+func (m *MockTransfers) CreateTx(ctx context.Context, tx *xorm.Session, newTransfer types.NewTransfer) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newTransfer)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockTransfersMockRecorder) CreateTx(ctx, tx, newTransfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockTransfers)(nil).CreateTx), ctx, tx, newTransfer)
+}
+
+// Find mocks base method.
+func (m *MockTransfers) Find(ctx context.Context, opts *repos.TransfersFind) ([]*types.Transfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.Transfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTransfersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTransfers)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockTransfers) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.TransfersFind) ([]*types.Transfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.Transfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockTransfersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockTransfers)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockTransfers) Get(ctx context.Context, id int64) (*types.Transfer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTransfersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransfers)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockTransfers) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Transfer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockTransfersMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockTransfers)(nil).GetTx), ctx, tx, id)
+}
+
+// Receive mocks base method.
+func (m *MockTransfers) Receive(ctx context.Context, receive *types.ReceiveTransfer) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, receive)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockTransfersMockRecorder) Receive(ctx, receive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockTransfers)(nil).Receive), ctx, receive)
+}
+
+// ReceiveTx mocks base method.
+func (m *MockTransfers) ReceiveTx(ctx context.Context, tx *xorm.Session, receive *types.ReceiveTransfer) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTx", ctx, tx, receive)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTx indicates an expected call of ReceiveTx.
+func (mr *MockTransfersMockRecorder) ReceiveTx(ctx, tx, receive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTx", reflect.TypeOf((*MockTransfers)(nil).ReceiveTx), ctx, tx, receive)
+}
+
+// Ship mocks base method.
+func (m *MockTransfers) Ship(ctx context.Context, ship *types.ShipTransfer) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ship", ctx, ship)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ship indicates an expected call of Ship.
+func (mr *MockTransfersMockRecorder) Ship(ctx, ship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ship", reflect.TypeOf((*MockTransfers)(nil).Ship), ctx, ship)
+}
+
+// ShipTx mocks base method.
+func (m *MockTransfers) ShipTx(ctx context.Context, tx *xorm.Session, ship *types.ShipTransfer) (*types.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipTx", ctx, tx, ship)
+	ret0, _ := ret[0].(*types.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShipTx indicates an expected call of ShipTx.
+func (mr *MockTransfersMockRecorder) ShipTx(ctx, tx, ship any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipTx", reflect.TypeOf((*MockTransfers)(nil).ShipTx), ctx, tx, ship)
+}
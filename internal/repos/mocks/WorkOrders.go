@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./workOrders.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./workOrders.go -destination=./mocks/WorkOrders.go -package=mock_repos WorkOrders
+//
+
+// Package mock_repos is a generated GoMock package.
+package mock_repos
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repos "github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	types "github.com/happilymarrieddad/product-inventory-management-system/types"
+	gomock "go.uber.org/mock/gomock"
+	xorm "xorm.io/xorm"
+)
+
+// MockWorkOrders is a mock of WorkOrders interface.
+type MockWorkOrders struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkOrdersMockRecorder
+}
+
+// MockWorkOrdersMockRecorder is the mock recorder for MockWorkOrders.
+type MockWorkOrdersMockRecorder struct {
+	mock *MockWorkOrders
+}
+
+// NewMockWorkOrders creates a new mock instance.
+func NewMockWorkOrders(ctrl *gomock.Controller) *MockWorkOrders {
+	mock := &MockWorkOrders{ctrl: ctrl}
+	mock.recorder = &MockWorkOrdersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkOrders) EXPECT() *MockWorkOrdersMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockWorkOrders) Cancel(ctx context.Context, id int64) (*types.WorkOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockWorkOrdersMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockWorkOrders)(nil).Cancel), ctx, id)
+}
+
+// CancelTx mocks base method.
+func (m *MockWorkOrders) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.WorkOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTx indicates an expected call of CancelTx.
+func (mr *MockWorkOrdersMockRecorder) CancelTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockWorkOrders)(nil).CancelTx), ctx, tx, id)
+}
+
+// Complete mocks base method.
+func (m *MockWorkOrders) Complete(ctx context.Context, complete *types.CompleteWorkOrder) (*types.WorkOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, complete)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockWorkOrdersMockRecorder) Complete(ctx, complete any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockWorkOrders)(nil).Complete), ctx, complete)
+}
+
+// CompleteTx mocks base method.
+func (m *MockWorkOrders) CompleteTx(ctx context.Context, tx *xorm.Session, complete *types.CompleteWorkOrder) (*types.WorkOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTx", ctx, tx, complete)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTx indicates an expected call of CompleteTx.
+func (mr *MockWorkOrdersMockRecorder) CompleteTx(ctx, tx, complete any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTx", reflect.TypeOf((*MockWorkOrders)(nil).CompleteTx), ctx, tx, complete)
+}
+
+// Create mocks base method.
+func (m *MockWorkOrders) Create(ctx context.Context, newOrder types.NewWorkOrder) (*types.WorkOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newOrder)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkOrdersMockRecorder) Create(ctx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkOrders)(nil).Create), ctx, newOrder)
+}
+
+// CreateTx mocks base method.
+func (m *MockWorkOrders) CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewWorkOrder) (*types.WorkOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTx", ctx, tx, newOrder)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTx indicates an expected call of CreateTx.
+func (mr *MockWorkOrdersMockRecorder) CreateTx(ctx, tx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTx", reflect.TypeOf((*MockWorkOrders)(nil).CreateTx), ctx, tx, newOrder)
+}
+
+// Find mocks base method.
+func (m *MockWorkOrders) Find(ctx context.Context, opts *repos.WorkOrdersFind) ([]*types.WorkOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, opts)
+	ret0, _ := ret[0].([]*types.WorkOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockWorkOrdersMockRecorder) Find(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockWorkOrders)(nil).Find), ctx, opts)
+}
+
+// FindTx mocks base method.
+func (m *MockWorkOrders) FindTx(ctx context.Context, tx *xorm.Session, opts *repos.WorkOrdersFind) ([]*types.WorkOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTx", ctx, tx, opts)
+	ret0, _ := ret[0].([]*types.WorkOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTx indicates an expected call of FindTx.
+func (mr *MockWorkOrdersMockRecorder) FindTx(ctx, tx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTx", reflect.TypeOf((*MockWorkOrders)(nil).FindTx), ctx, tx, opts)
+}
+
+// Get mocks base method.
+func (m *MockWorkOrders) Get(ctx context.Context, id int64) (*types.WorkOrder, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWorkOrdersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkOrders)(nil).Get), ctx, id)
+}
+
+// GetTx mocks base method.
+func (m *MockWorkOrders) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.WorkOrder, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, tx, id)
+	ret0, _ := ret[0].(*types.WorkOrder)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockWorkOrdersMockRecorder) GetTx(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockWorkOrders)(nil).GetTx), ctx, tx, id)
+}
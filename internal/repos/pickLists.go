@@ -0,0 +1,508 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type PickListsFind struct {
+	Limit         int
+	Offset        int
+	IDs           []int64
+	SalesOrderIDs []int64
+	Statuses      []types.PickListStatus
+}
+
+//go:generate mockgen -source=./pickLists.go -destination=./mocks/PickLists.go -package=mock_repos PickLists
+type PickLists interface {
+	Find(ctx context.Context, opts *PickListsFind) ([]*types.PickList, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *PickListsFind) ([]*types.PickList, int64, error)
+	Get(ctx context.Context, id int64) (*types.PickList, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PickList, bool, error)
+	Create(ctx context.Context, newList types.NewPickList) (*types.PickList, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newList types.NewPickList) (*types.PickList, error)
+	Pick(ctx context.Context, pick *types.PickPickList) (*types.PickList, error)
+	PickTx(ctx context.Context, tx *xorm.Session, pick *types.PickPickList) (*types.PickList, error)
+	Cancel(ctx context.Context, id int64) (*types.PickList, error)
+	CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PickList, error)
+}
+
+// NewPickLists - picked serials are allocated through the serials repo in the same transaction
+func NewPickLists(db *xorm.Engine, serials Serials) PickLists {
+	return &pickListsRepo{db, serials}
+}
+
+type pickListsRepo struct {
+	db      *xorm.Engine
+	serials Serials
+}
+
+// pickListReference - the reference type pick lists write to serial histories
+var pickListReference = "pick_list"
+
+// pendingQty - what in progress pick list lines are holding, open lists hold everything they ask
+// for and once picked only what actually came off the shelf
+const pendingQty = `COALESCE(SUM(CASE WHEN pl.status = 'open' THEN pll.qty ELSE pll.qty_picked END), 0)`
+
+func (r *pickListsRepo) Find(ctx context.Context, opts *PickListsFind) ([]*types.PickList, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		p, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return p, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.PickList), count, nil
+}
+
+func (r *pickListsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *PickListsFind) ([]*types.PickList, int64, error) {
+	if opts == nil {
+		opts = &PickListsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.SalesOrderIDs) > 0 {
+		tx = tx.In("sales_order_id", utils.Int64ArrToInterfaceArr(opts.SalesOrderIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.PickList{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("pick_lists", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *pickListsRepo) Get(ctx context.Context, id int64) (*types.PickList, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		p, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return p, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.PickList), exists, nil
+}
+
+func (r *pickListsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PickList, bool, error) {
+	obj := &types.PickList{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("pick_lists", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *pickListsRepo) Create(ctx context.Context, newList types.NewPickList) (*types.PickList, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newList)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.PickList), nil
+}
+
+// CreateTx - builds a pick list for everything allocated to an open sales order that isn't already
+// on another pick list. Each line is sent to the locations with the most free stock first and
+// anything that isn't held at a location is picked from the unassigned stock. Lot tracked
+// products are split across their unexpired lots, first to expire first, and whatever those
+// lots can't cover stays allocated but off the list.
+func (r *pickListsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newList types.NewPickList) (*types.PickList, error) {
+	if err := types.Validate(newList); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	order := &types.SalesOrder{}
+	exists, err := tx.Where("id = ?", newList.SalesOrderID).ForUpdate().Get(order)
+	if err != nil {
+		return nil, normalizeErr("pick_lists", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("sales order not found by id")
+	}
+
+	if !order.Status.Open() {
+		return nil, types.NewBadRequestError("sales order is not open for picking")
+	}
+
+	orderLines := []*types.SalesOrderLine{}
+	if err := tx.Where("sales_order_id = ? AND qty_allocated > 0", order.ID).OrderBy("id").Find(&orderLines); err != nil {
+		return nil, normalizeErr("sales_order_lines", err)
+	}
+
+	obj := &types.PickList{
+		SalesOrderID: order.ID,
+		Status:       types.PickListStatusOpen,
+		CreatedAt:    time.Now(),
+		Lines:        []*types.PickListLine{},
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("pick_lists", err)
+	}
+
+	for _, orderLine := range orderLines {
+		var pending int64
+		if _, err := tx.SQL(`
+			SELECT `+pendingQty+` FROM pick_list_lines pll
+			INNER JOIN pick_lists pl ON pl.id = pll.pick_list_id
+			WHERE pll.sales_order_line_id = ? AND pl.status IN (?, ?, ?)`,
+			orderLine.ID, types.PickListStatusOpen, types.PickListStatusPicked, types.PickListStatusPacked,
+		).Get(&pending); err != nil {
+			return nil, normalizeErr("pick_list_lines", err)
+		}
+
+		remaining := orderLine.QtyAllocated - pending
+		if remaining <= 0 {
+			continue
+		}
+
+		product := &types.Product{}
+		if _, err := tx.ID(orderLine.ProductID).Get(product); err != nil {
+			return nil, normalizeErr("products", err)
+		}
+
+		sources := []struct {
+			LocationID int64 `xorm:"location_id"`
+			Free       int64 `xorm:"free"`
+		}{}
+		if err := tx.SQL(`
+			SELECT s.location_id, s.qty - (
+				SELECT `+pendingQty+` FROM pick_list_lines pll
+				INNER JOIN pick_lists pl ON pl.id = pll.pick_list_id
+				WHERE pll.product_id = s.product_id AND pll.location_id = s.location_id AND pl.status IN (?, ?, ?)
+			) AS free
+			FROM stock_levels s
+			WHERE s.product_id = ? AND s.qty > 0
+			ORDER BY free DESC, s.location_id`,
+			types.PickListStatusOpen, types.PickListStatusPicked, types.PickListStatusPacked, orderLine.ProductID,
+		).Find(&sources); err != nil {
+			return nil, normalizeErr("stock_levels", err)
+		}
+
+		for _, source := range sources {
+			if remaining == 0 || source.Free <= 0 {
+				break
+			}
+
+			placed, err := r.place(tx, obj, orderLine, product, utils.Ref(source.LocationID), min(remaining, source.Free))
+			if err != nil {
+				return nil, err
+			}
+			remaining -= placed
+		}
+
+		if remaining > 0 {
+			if _, err := r.place(tx, obj, orderLine, product, nil, remaining); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(obj.Lines) == 0 {
+		return nil, types.NewBadRequestError("nothing left to pick on this sales order")
+	}
+
+	return obj, nil
+}
+
+func (r *pickListsRepo) Pick(ctx context.Context, pick *types.PickPickList) (*types.PickList, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.PickTx(ctx, tx, pick)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.PickList), nil
+}
+
+// PickTx - records what came off the shelves. Short picks stay allocated to the order so they
+// can go on a later pick list.
+func (r *pickListsRepo) PickTx(ctx context.Context, tx *xorm.Session, pick *types.PickPickList) (*types.PickList, error) {
+	if err := types.Validate(pick); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, err := r.lockForTransition(tx, pick.ID, types.PickListStatusPicked)
+	if err != nil {
+		return nil, err
+	}
+
+	picked := map[int64]types.PickedLine{}
+	for _, line := range pick.Lines {
+		picked[line.PickListLineID] = line
+	}
+
+	for _, line := range obj.Lines {
+		line.QtyPicked = line.Qty
+		var serialNumbers []string
+		if pl, exists := picked[line.ID]; exists {
+			if pl.QtyPicked > line.Qty {
+				return nil, types.NewBadRequestError("can not pick more than the line asks for")
+			}
+			line.QtyPicked = pl.QtyPicked
+			serialNumbers = pl.SerialNumbers
+			delete(picked, line.ID)
+		}
+
+		if _, err := tx.ID(line.ID).Cols("qty_picked").Update(line); err != nil {
+			return nil, normalizeErr("pick_list_lines", err)
+		}
+
+		if _, err := r.serials.MoveTx(ctx, tx, types.SerialMove{
+			ProductID:      line.ProductID,
+			Qty:            line.QtyPicked,
+			SerialNumbers:  serialNumbers,
+			To:             types.SerialStatusAllocated,
+			PickListLineID: &line.ID,
+			ReferenceType:  &pickListReference,
+			ReferenceID:    &obj.ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(picked) > 0 {
+		return nil, types.NewNotFoundError("pick list line not found on this pick list")
+	}
+
+	obj.PickedAt = utils.Ref(time.Now())
+	if err := r.setStatus(tx, obj, types.PickListStatusPicked); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *pickListsRepo) Cancel(ctx context.Context, id int64) (*types.PickList, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CancelTx(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.PickList), nil
+}
+
+// CancelTx - gives up on a pick list that hasn't shipped, the stock stays allocated to the
+// order and a packed shipment for it is cancelled too. Any serials picked go back in stock.
+func (r *pickListsRepo) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PickList, error) {
+	obj, err := r.lockForTransition(tx, id, types.PickListStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Status == types.PickListStatusPacked {
+		if _, err := tx.Table("shipments").
+			Where("pick_list_id = ? AND status = ?", obj.ID, types.ShipmentStatusPacked).
+			Update(map[string]interface{}{"status": types.ShipmentStatusCancelled, "updated_at": time.Now()}); err != nil {
+			return nil, normalizeErr("shipments", err)
+		}
+	}
+
+	for _, line := range obj.Lines {
+		serials, _, err := r.serials.FindTx(ctx, tx, &SerialsFind{PickListLineIDs: []int64{line.ID}})
+		if err != nil {
+			return nil, err
+		}
+		if len(serials) == 0 {
+			continue
+		}
+
+		serialNumbers := []string{}
+		for _, serial := range serials {
+			serialNumbers = append(serialNumbers, serial.SerialNumber)
+		}
+
+		if _, err := r.serials.MoveTx(ctx, tx, types.SerialMove{
+			ProductID:     line.ProductID,
+			Qty:           int64(len(serialNumbers)),
+			SerialNumbers: serialNumbers,
+			To:            types.SerialStatusInStock,
+			LocationID:    line.LocationID,
+			ReferenceType: &pickListReference,
+			ReferenceID:   &obj.ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.setStatus(tx, obj, types.PickListStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// lockForTransition - locks the pick list and loads its lines if it's allowed to move to the status
+func (r *pickListsRepo) lockForTransition(tx *xorm.Session, id int64, to types.PickListStatus) (*types.PickList, error) {
+	obj := &types.PickList{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("pick_lists", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("pick list not found by id")
+	}
+
+	if !obj.Status.CanTransition(to) {
+		return nil, types.NewBadRequestError("pick list can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *pickListsRepo) setStatus(tx *xorm.Session, obj *types.PickList, to types.PickListStatus) error {
+	obj.Status = to
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).Cols("status", "picked_at", "updated_at").Update(obj); err != nil {
+		return normalizeErr("pick_lists", err)
+	}
+	return nil
+}
+
+// place - puts up to qty of the order line on the list, split across the product's lots when it
+// tracks them. It returns how much went on, whatever the lots can't cover stays allocated to the
+// order for a later list.
+func (r *pickListsRepo) place(tx *xorm.Session, obj *types.PickList, orderLine *types.SalesOrderLine, product *types.Product, locationID *int64, qty int64) (int64, error) {
+	if !product.LotTracked {
+		return qty, r.insertLine(tx, obj, orderLine, locationID, nil, qty)
+	}
+
+	lots := []struct {
+		ID   int64 `xorm:"id"`
+		Free int64 `xorm:"free"`
+	}{}
+	if err := tx.SQL(`
+		SELECT l.id, l.qty - (
+			SELECT `+pendingQty+` FROM pick_list_lines pll
+			INNER JOIN pick_lists pl ON pl.id = pll.pick_list_id
+			WHERE pll.lot_id = l.id AND pl.status IN (?, ?, ?)
+		) AS free
+		FROM lots l
+		WHERE l.product_id = ? AND l.qty > 0 AND (l.expires_at IS NULL OR l.expires_at > ?)
+		ORDER BY l.expires_at NULLS LAST, l.id`,
+		types.PickListStatusOpen, types.PickListStatusPicked, types.PickListStatusPacked, product.ID, time.Now(),
+	).Find(&lots); err != nil {
+		return 0, normalizeErr("lots", err)
+	}
+
+	var placed int64
+	for _, lot := range lots {
+		if placed == qty {
+			break
+		}
+		if lot.Free <= 0 {
+			continue
+		}
+
+		take := min(qty-placed, lot.Free)
+		if err := r.insertLine(tx, obj, orderLine, locationID, utils.Ref(lot.ID), take); err != nil {
+			return 0, err
+		}
+		placed += take
+	}
+
+	return placed, nil
+}
+
+func (r *pickListsRepo) insertLine(tx *xorm.Session, obj *types.PickList, orderLine *types.SalesOrderLine, locationID, lotID *int64, qty int64) error {
+	line := &types.PickListLine{
+		PickListID:       obj.ID,
+		SalesOrderLineID: orderLine.ID,
+		ProductID:        orderLine.ProductID,
+		LocationID:       locationID,
+		LotID:            lotID,
+		Qty:              qty,
+		CreatedAt:        time.Now(),
+	}
+
+	if _, err := tx.Insert(line); err != nil {
+		return normalizeErr("pick_list_lines", err)
+	}
+
+	obj.Lines = append(obj.Lines, line)
+	return nil
+}
+
+func (r *pickListsRepo) loadLines(tx *xorm.Session, lists ...*types.PickList) error {
+	if len(lists) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.PickList{}
+	ids := []int64{}
+	for _, list := range lists {
+		list.Lines = []*types.PickListLine{}
+		byID[list.ID] = list
+		ids = append(ids, list.ID)
+	}
+
+	lines := []*types.PickListLine{}
+	if err := tx.In("pick_list_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("pick_list_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.PickListID].Lines = append(byID[line.PickListID].Lines, line)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package repos_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: PickLists", func() {
+
+	var (
+		repo     repos.PickLists
+		product  *types.Product
+		location *types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("shipments", "pick_lists", "sales_orders", "stock_levels", "locations", "lots", "products")
+
+		repo = gr.PickLists()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 4})
+		Expect(err).To(BeNil())
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		// 6 at MAIN on top of the 4 that aren't at a location
+		_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(6))})
+		Expect(err).To(BeNil())
+	})
+
+	confirmedOrderOf := func(p *types.Product, qty int64) *types.SalesOrder {
+		order, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+			CustomerName: "Jane Doe",
+			Lines:        []types.NewSalesOrderLine{{ProductID: p.ID, Qty: qty, UnitPrice: 1999}},
+		})
+		Expect(err).To(BeNil())
+
+		order, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+		Expect(err).To(BeNil())
+		return order
+	}
+
+	confirmedOrder := func(qty int64) *types.SalesOrder {
+		return confirmedOrderOf(product, qty)
+	}
+
+	// lot tracking can only be switched on with nothing on hand so lot tracked tests start fresh
+	lotTracked := func() *types.Product {
+		p, err := gr.Products().Create(ctx, types.NewProduct{Name: "tracked", Sku: "tracked", LotTracked: true})
+		Expect(err).To(BeNil())
+		return p
+	}
+
+	newLot := func(p *types.Product, lotNumber string, expiresIn time.Duration) *types.Lot {
+		lot, err := gr.Lots().Receive(ctx, p.ID, types.NewLot{LotNumber: lotNumber, ExpiresAt: utils.Ref(time.Now().Add(expiresIn))}, 0)
+		Expect(err).To(BeNil())
+		return lot
+	}
+
+	// stockLot - adds qty of the lot at MAIN
+	stockLot := func(p *types.Product, lot *types.Lot, qty int64) {
+		level, _, err := gr.StockLevels().Get(ctx, p.ID, location.ID)
+		Expect(err).To(BeNil())
+		var held int64
+		if level != nil {
+			held = level.Qty
+		}
+
+		_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{
+			ProductID: p.ID, LocationID: location.ID, Qty: utils.Ref(held + qty), LotID: &lot.ID,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	Context("Create(Tx)", func() {
+		It("should fail for unknown or draft orders", func() {
+			_, err := repo.Create(ctx, types.NewPickList{SalesOrderID: 99999999})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			draft, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+				CustomerName: "Jane Doe",
+				Lines:        []types.NewSalesOrderLine{{ProductID: product.ID, Qty: 1}},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewPickList{SalesOrderID: draft.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should pick from the location first then the unassigned stock", func() {
+			list, err := repo.Create(ctx, types.NewPickList{SalesOrderID: confirmedOrder(8).ID})
+			Expect(err).To(BeNil())
+			Expect(list.Status).To(Equal(types.PickListStatusOpen))
+			Expect(list.Lines).To(HaveLen(2))
+			Expect(*list.Lines[0].LocationID).To(Equal(location.ID))
+			Expect(list.Lines[0].Qty).To(BeNumerically("==", 6))
+			Expect(list.Lines[1].LocationID).To(BeNil())
+			Expect(list.Lines[1].Qty).To(BeNumerically("==", 2))
+		})
+
+		It("should pick lot tracked products first expiry first, leaving out expired lots", func() {
+			tracked := lotTracked()
+
+			later := newLot(tracked, "LATER", 60*24*time.Hour)
+			stockLot(tracked, later, 3)
+			sooner := newLot(tracked, "SOONER", 10*24*time.Hour)
+			stockLot(tracked, sooner, 2)
+			stockLot(tracked, newLot(tracked, "EXPIRED", -24*time.Hour), 5)
+
+			list, err := repo.Create(ctx, types.NewPickList{SalesOrderID: confirmedOrderOf(tracked, 6).ID})
+			Expect(err).To(BeNil())
+			Expect(list.Lines).To(HaveLen(2))
+			Expect(*list.Lines[0].LotID).To(Equal(sooner.ID))
+			Expect(list.Lines[0].Qty).To(BeNumerically("==", 2))
+			Expect(*list.Lines[1].LotID).To(Equal(later.ID))
+			Expect(list.Lines[1].Qty).To(BeNumerically("==", 3))
+		})
+
+		It("should leave what the lots can't cover allocated for a later list", func() {
+			tracked := lotTracked()
+
+			// 5 at MAIN but only the 2 in GOOD can be picked
+			good := newLot(tracked, "GOOD", 30*24*time.Hour)
+			stockLot(tracked, good, 2)
+			stockLot(tracked, newLot(tracked, "EXPIRED", -24*time.Hour), 3)
+
+			order := confirmedOrderOf(tracked, 5)
+
+			list, err := repo.Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(err).To(BeNil())
+			Expect(list.Lines).To(HaveLen(1))
+			Expect(list.Lines[0].Qty).To(BeNumerically("==", 2))
+
+			// more of GOOD arrives, the next list picks the 3 the first one couldn't
+			stockLot(tracked, good, 3)
+
+			list, err = repo.Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(err).To(BeNil())
+			Expect(list.Lines).To(HaveLen(1))
+			Expect(*list.Lines[0].LotID).To(Equal(good.ID))
+			Expect(list.Lines[0].Qty).To(BeNumerically("==", 3))
+		})
+
+		It("should not put the same allocation on two lists", func() {
+			order := confirmedOrder(3)
+			_, err := repo.Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Pick(Tx)", func() {
+		It("should default to picking every line in full", func() {
+			list, err := repo.Create(ctx, types.NewPickList{SalesOrderID: confirmedOrder(3).ID})
+			Expect(err).To(BeNil())
+
+			list, err = repo.Pick(ctx, &types.PickPickList{ID: list.ID})
+			Expect(err).To(BeNil())
+			Expect(list.Status).To(Equal(types.PickListStatusPicked))
+			Expect(list.PickedAt).NotTo(BeNil())
+			Expect(list.Lines[0].QtyPicked).To(BeNumerically("==", 3))
+		})
+
+		It("should not pick more than the line asks for", func() {
+			list, err := repo.Create(ctx, types.NewPickList{SalesOrderID: confirmedOrder(3).ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Pick(ctx, &types.PickPickList{
+				ID: list.ID, Lines: []types.PickedLine{{PickListLineID: list.Lines[0].ID, QtyPicked: 4}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Cancel(Tx)", func() {
+		It("should hold up cancelling the order until the list is cancelled", func() {
+			order := confirmedOrder(3)
+			list, err := repo.Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(err).To(BeNil())
+
+			_, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusCancelled)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			list, err = repo.Cancel(ctx, list.ID)
+			Expect(err).To(BeNil())
+			Expect(list.Status).To(Equal(types.PickListStatusCancelled))
+
+			_, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusCancelled)
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,277 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type ProductSuppliersFind struct {
+	Limit       int
+	Offset      int
+	ProductIDs  []int64
+	SupplierIDs []int64
+	// PreferredOnly - just the preferred supplier of each product
+	PreferredOnly bool
+}
+
+//go:generate mockgen -source=./productSuppliers.go -destination=./mocks/ProductSuppliers.go -package=mock_repos ProductSuppliers
+type ProductSuppliers interface {
+	Find(ctx context.Context, opts *ProductSuppliersFind) ([]*types.ProductSupplier, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *ProductSuppliersFind) ([]*types.ProductSupplier, int64, error)
+	Get(ctx context.Context, productID, supplierID int64) (*types.ProductSupplier, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, productID, supplierID int64) (*types.ProductSupplier, bool, error)
+	Create(ctx context.Context, newLink types.NewProductSupplier) (*types.ProductSupplier, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newLink types.NewProductSupplier) (*types.ProductSupplier, error)
+	Update(ctx context.Context, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error)
+	Destroy(ctx context.Context, productID, supplierID int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, productID, supplierID int64) error
+}
+
+func NewProductSuppliers(db *xorm.Engine) ProductSuppliers {
+	return &productSuppliersRepo{db}
+}
+
+type productSuppliersRepo struct {
+	db *xorm.Engine
+}
+
+func (r *productSuppliersRepo) Find(ctx context.Context, opts *ProductSuppliersFind) ([]*types.ProductSupplier, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return l, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.ProductSupplier), count, nil
+}
+
+func (r *productSuppliersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *ProductSuppliersFind) ([]*types.ProductSupplier, int64, error) {
+	if opts == nil {
+		opts = &ProductSuppliersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.SupplierIDs) > 0 {
+		tx = tx.In("supplier_id", utils.Int64ArrToInterfaceArr(opts.SupplierIDs...)...)
+	}
+
+	if opts.PreferredOnly {
+		tx = tx.Where("preferred = ?", true)
+	}
+
+	objs := []*types.ProductSupplier{}
+	// preferred first so callers can take the top link as the default supplier
+	count, err := tx.OrderBy("product_id, preferred DESC, id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("product_suppliers", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *productSuppliersRepo) Get(ctx context.Context, productID, supplierID int64) (*types.ProductSupplier, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, ex, e := r.GetTx(ctx, tx, productID, supplierID)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return l, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.ProductSupplier), exists, nil
+}
+
+func (r *productSuppliersRepo) GetTx(ctx context.Context, tx *xorm.Session, productID, supplierID int64) (*types.ProductSupplier, bool, error) {
+	obj := &types.ProductSupplier{}
+	exists, err := tx.Where("product_id = ? AND supplier_id = ?", productID, supplierID).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("product_suppliers", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *productSuppliersRepo) Create(ctx context.Context, newLink types.NewProductSupplier) (*types.ProductSupplier, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newLink)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.ProductSupplier), nil
+}
+
+func (r *productSuppliersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newLink types.NewProductSupplier) (*types.ProductSupplier, error) {
+	if err := types.Validate(newLink); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	exists, err := tx.Table("products").Where("id = ?", newLink.ProductID).Exist()
+	if err != nil {
+		return nil, normalizeErr("product_suppliers", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	exists, err = tx.Table("suppliers").Where("id = ?", newLink.SupplierID).Exist()
+	if err != nil {
+		return nil, normalizeErr("product_suppliers", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("supplier not found by id")
+	}
+
+	obj := &types.ProductSupplier{
+		ProductID:    newLink.ProductID,
+		SupplierID:   newLink.SupplierID,
+		SupplierSku:  newLink.SupplierSku,
+		UnitCost:     newLink.UnitCost,
+		MinOrderQty:  newLink.MinOrderQty,
+		LeadTimeDays: newLink.LeadTimeDays,
+		Preferred:    newLink.Preferred,
+		CreatedAt:    time.Now(),
+	}
+
+	if obj.MinOrderQty == 0 {
+		obj.MinOrderQty = 1
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if obj.Preferred {
+		if err := r.clearPreferred(tx, obj.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("product_suppliers", err)
+	}
+
+	return obj, nil
+}
+
+func (r *productSuppliersRepo) Update(ctx context.Context, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.ProductSupplier), nil
+}
+
+func (r *productSuppliersRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateProductSupplier) (*types.ProductSupplier, error) {
+	obj, exists, err := r.GetTx(ctx, tx, diff.ProductID, diff.SupplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, types.NewNotFoundError("product supplier not found")
+	}
+
+	if diff.SupplierSku != nil {
+		obj.SupplierSku = diff.SupplierSku
+	}
+
+	if diff.UnitCost != nil {
+		obj.UnitCost = *diff.UnitCost
+	}
+
+	if diff.MinOrderQty != nil {
+		obj.MinOrderQty = *diff.MinOrderQty
+	}
+
+	if diff.LeadTimeDays != nil {
+		obj.LeadTimeDays = *diff.LeadTimeDays
+	}
+
+	if diff.Preferred != nil {
+		obj.Preferred = *diff.Preferred
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if diff.Preferred != nil && *diff.Preferred {
+		if err := r.clearPreferred(tx, obj.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).
+		Cols("supplier_sku", "unit_cost", "min_order_qty", "lead_time_days", "preferred", "updated_at").
+		Update(obj); err != nil {
+		return nil, normalizeErr("product_suppliers", err)
+	}
+
+	return obj, nil
+}
+
+func (r *productSuppliersRepo) Destroy(ctx context.Context, productID, supplierID int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, productID, supplierID)
+	})
+	return err
+}
+
+func (r *productSuppliersRepo) DestroyTx(ctx context.Context, tx *xorm.Session, productID, supplierID int64) error {
+	count, err := tx.Where("product_id = ? AND supplier_id = ?", productID, supplierID).Delete(&types.ProductSupplier{})
+	if err != nil {
+		return normalizeErr("product_suppliers", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("product supplier not found")
+	}
+	return nil
+}
+
+// clearPreferred - a product only has one preferred supplier
+func (r *productSuppliersRepo) clearPreferred(tx *xorm.Session, productID int64) error {
+	if _, err := tx.Table("product_suppliers").
+		Where("product_id = ? AND preferred = ?", productID, true).
+		Update(map[string]interface{}{"preferred": false, "updated_at": time.Now()}); err != nil {
+		return normalizeErr("product_suppliers", err)
+	}
+	return nil
+}
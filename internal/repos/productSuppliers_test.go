@@ -0,0 +1,103 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: ProductSuppliers", func() {
+
+	var (
+		repo    repos.ProductSuppliers
+		product *types.Product
+		acme    *types.Supplier
+		globex  *types.Supplier
+	)
+
+	BeforeEach(func() {
+		clearDatabase("product_suppliers", "suppliers", "products")
+
+		repo = gr.ProductSuppliers()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		acme, err = gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+		Expect(err).To(BeNil())
+
+		globex, err = gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Globex", Code: "GLOBEX"})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should fail for an unknown product or supplier", func() {
+			_, err := repo.Create(ctx, types.NewProductSupplier{ProductID: 99999999, SupplierID: acme.ID})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: 99999999})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should not link the same supplier twice", func() {
+			_, err := repo.Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: acme.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: acme.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should default the minimum order qty", func() {
+			link, err := repo.Create(ctx, types.NewProductSupplier{
+				ProductID: product.ID, SupplierID: acme.ID, SupplierSku: utils.Ref("ACME-100"), UnitCost: 250, LeadTimeDays: 14,
+			})
+			Expect(err).To(BeNil())
+			Expect(link.MinOrderQty).To(BeNumerically("==", 1))
+			Expect(link.LeadTimeDays).To(BeNumerically("==", 14))
+		})
+	})
+
+	Context("preferred supplier", func() {
+		BeforeEach(func() {
+			_, err := repo.Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: acme.ID, Preferred: true})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: globex.ID})
+			Expect(err).To(BeNil())
+		})
+
+		It("should list the preferred supplier first", func() {
+			links, count, err := repo.Find(ctx, &repos.ProductSuppliersFind{ProductIDs: []int64{product.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 2))
+			Expect(links[0].SupplierID).To(Equal(acme.ID))
+		})
+
+		It("should only keep one preferred supplier", func() {
+			link, err := repo.Update(ctx, &types.UpdateProductSupplier{
+				ProductID: product.ID, SupplierID: globex.ID, Preferred: utils.Ref(true),
+			})
+			Expect(err).To(BeNil())
+			Expect(link.Preferred).To(BeTrue())
+
+			links, count, err := repo.Find(ctx, &repos.ProductSuppliersFind{ProductIDs: []int64{product.ID}, PreferredOnly: true})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(links[0].SupplierID).To(Equal(globex.ID))
+		})
+	})
+
+	Context("Destroy(Tx)", func() {
+		It("should unlink a supplier", func() {
+			_, err := repo.Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: acme.ID})
+			Expect(err).To(BeNil())
+
+			Expect(repo.Destroy(ctx, product.ID, acme.ID)).To(Succeed())
+			Expect(types.IsNotFoundError(repo.Destroy(ctx, product.ID, acme.ID))).To(BeTrue())
+		})
+	})
+})
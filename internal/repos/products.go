@@ -16,6 +16,9 @@ type ProductsFind struct {
 	IDs    []int64
 	Names  []string
 	Skus   []string
+	// AsOf - products that existed by this time with Qty rebuilt to what was on hand then, see
+	// Snapshots. Everything else is as it is now.
+	AsOf *time.Time
 }
 
 //go:generate mockgen -source=./products.go -destination=./mocks/Products.go -package=mock_repos Products
@@ -30,14 +33,22 @@ type Products interface {
 	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateProduct) (*types.Product, error)
 	Destroy(ctx context.Context, id int64) error
 	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+	Adjust(ctx context.Context, id int64, adj types.ProductAdjustment) (*types.Product, error)
+	AdjustTx(ctx context.Context, tx *xorm.Session, id int64, adj types.ProductAdjustment) (*types.Product, error)
 }
 
-func NewProducts(db *xorm.Engine) Products {
-	return &productsRepo{db}
+// StockObserver - gets told about every committed change to a product's quantity
+type StockObserver interface {
+	StockChanged(ctx context.Context, change types.StockChange)
+}
+
+func NewProducts(db *xorm.Engine, observers ...StockObserver) Products {
+	return &productsRepo{db, observers}
 }
 
 type productsRepo struct {
-	db *xorm.Engine
+	db        *xorm.Engine
+	observers []StockObserver
 }
 
 func (r *productsRepo) Find(ctx context.Context, opts *ProductsFind) ([]*types.Product, int64, error) {
@@ -83,12 +94,36 @@ func (r *productsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *Produ
 		tx = tx.In("sku", utils.AnyArrToInterfaceArr(opts.Skus)...)
 	}
 
+	if opts.AsOf != nil {
+		tx = tx.Where("created_at <= ?", *opts.AsOf)
+	}
+
 	objs := []*types.Product{}
 	count, err := tx.OrderBy("id").FindAndCount(&objs)
 	if err != nil {
 		return nil, 0, normalizeErr("products", err)
 	}
 
+	if opts.AsOf != nil && len(objs) > 0 {
+		ids := make([]int64, len(objs))
+		qtys := map[int64]int64{}
+		for i, obj := range objs {
+			ids[i] = obj.ID
+		}
+
+		stock, err := stockAsOfTx(tx, *opts.AsOf, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, s := range stock {
+			qtys[s.ProductID] += s.Qty
+		}
+
+		for _, obj := range objs {
+			obj.Qty = qtys[obj.ID]
+		}
+	}
+
 	return objs, count, nil
 }
 
@@ -134,11 +169,33 @@ func (r *productsRepo) Create(ctx context.Context, newProduct types.NewProduct)
 }
 
 func (r *productsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newProduct types.NewProduct) (*types.Product, error) {
+	if err := types.Validate(newProduct); err != nil {
+		return nil, err
+	}
+
 	obj := &types.Product{
-		Name:      newProduct.Name,
-		Sku:       newProduct.Sku,
-		Qty:       newProduct.Qty,
-		CreatedAt: time.Now(),
+		Name:         newProduct.Name,
+		Sku:          newProduct.Sku,
+		Qty:          newProduct.Qty,
+		ReorderPoint: newProduct.ReorderPoint,
+		ReorderQty:   newProduct.ReorderQty,
+		LotTracked:   newProduct.LotTracked,
+		Serialized:   newProduct.Serialized,
+		Category:     newProduct.Category,
+		TaxCategory:  newProduct.TaxCategory,
+		CostMethod:   newProduct.CostMethod,
+		StandardCost: newProduct.StandardCost,
+		CreatedAt:    time.Now(),
+	}
+	if obj.CostMethod == "" {
+		obj.CostMethod = types.CostMethodFIFO
+	}
+
+	if obj.LotTracked && obj.Qty != 0 {
+		return nil, types.NewBadRequestError("lot tracked products start with nothing on hand, their stock is received into lots")
+	}
+	if obj.Serialized && obj.Qty != 0 {
+		return nil, types.NewBadRequestError("serialized products start with nothing on hand, their stock is received with serials")
 	}
 
 	if err := types.Validate(obj); err != nil {
@@ -149,6 +206,10 @@ func (r *productsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newProduc
 		return nil, normalizeErr("products", err)
 	}
 
+	if err := valueTx(tx, obj, types.ProductAdjustment{Qty: obj.Qty, UnitCost: newProduct.UnitCost}); err != nil {
+		return nil, err
+	}
+
 	return obj, nil
 }
 
@@ -181,23 +242,188 @@ func (r *productsRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *typ
 		obj.Sku = *diff.Sku
 	}
 
+	before := obj.Qty
 	if diff.Qty != nil {
 		obj.Qty = *diff.Qty
 	}
 
+	if diff.ReorderPoint != nil {
+		obj.ReorderPoint = *diff.ReorderPoint
+	}
+
+	if diff.ReorderQty != nil {
+		obj.ReorderQty = *diff.ReorderQty
+	}
+
+	if diff.LotTracked != nil && *diff.LotTracked != obj.LotTracked {
+		if err := r.canTrack(tx, obj, before, "lot tracking"); err != nil {
+			return nil, err
+		}
+		obj.LotTracked = *diff.LotTracked
+	}
+
+	if diff.Serialized != nil && *diff.Serialized != obj.Serialized {
+		if err := r.canTrack(tx, obj, before, "serial tracking"); err != nil {
+			return nil, err
+		}
+		obj.Serialized = *diff.Serialized
+	}
+
+	if diff.Category != nil {
+		obj.Category = diff.Category
+	}
+
+	if diff.TaxCategory != nil {
+		obj.TaxCategory = diff.TaxCategory
+	}
+
+	if diff.CostMethod != nil && *diff.CostMethod != obj.CostMethod {
+		if before != 0 {
+			return nil, types.NewBadRequestError("cost method can only be changed while nothing is on hand")
+		}
+		obj.CostMethod = *diff.CostMethod
+	}
+
+	standardCost := obj.StandardCost
+	if diff.StandardCost != nil {
+		obj.StandardCost = *diff.StandardCost
+	}
+
 	if err := types.Validate(obj); err != nil {
 		return nil, err
 	}
 
+	if obj.Qty != before {
+		// the total can never be less than what's been put away at locations
+		located, err := tx.Where("product_id = ?", obj.ID).SumInt(&types.StockLevel{}, "qty")
+		if err != nil {
+			return nil, normalizeErr("products", err)
+		}
+		if obj.Qty < located+obj.InTransit {
+			return nil, types.NewBadRequestError("qty can not be less than the stock held at locations or in transit")
+		}
+		if obj.Qty < obj.Allocated {
+			return nil, types.NewBadRequestError("qty can not be less than the stock allocated to sales orders")
+		}
+	}
+
+	if err := keepLotTx(tx, obj, diff.LotID, obj.Qty-before); err != nil {
+		return nil, err
+	}
+
+	if obj.CostMethod == types.CostMethodStandard && obj.StandardCost != standardCost {
+		if err := revalueTx(tx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := valueTx(tx, obj, types.ProductAdjustment{Qty: obj.Qty - before, UnitCost: diff.UnitCost}); err != nil {
+		return nil, err
+	}
+
 	obj.UpdatedAt = utils.Ref(time.Now())
 
-	if _, err := tx.ID(diff.ID).Update(obj); err != nil {
+	if _, err := r.notifyAfter(ctx, tx, obj, before).ID(diff.ID).
+		Cols("name", "sku", "qty", "reorder_point", "reorder_qty", "lot_tracked", "serialized", "category", "tax_category",
+			"cost_method", "standard_cost", "updated_at").
+		Update(obj); err != nil {
 		return nil, normalizeErr("products", err)
 	}
 
 	return obj, nil
 }
 
+func (r *productsRepo) Adjust(ctx context.Context, id int64, adj types.ProductAdjustment) (*types.Product, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.AdjustTx(ctx, tx, id, adj)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Product), nil
+}
+
+// AdjustTx - applies relative changes to a product's stock counters, locking the row
+// so concurrent adjustments can't lose each other's writes
+func (r *productsRepo) AdjustTx(ctx context.Context, tx *xorm.Session, id int64, adj types.ProductAdjustment) (*types.Product, error) {
+	obj := &types.Product{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("products", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("product not found by id")
+	}
+
+	before := obj.Qty
+	obj.Qty += adj.Qty
+	if obj.Qty < 0 {
+		return nil, types.NewBadRequestError("not enough stock on hand")
+	}
+
+	obj.OnOrder += adj.OnOrder
+	if obj.OnOrder < 0 {
+		return nil, types.NewBadRequestError("on order can not go below zero")
+	}
+
+	obj.Allocated += adj.Allocated
+	if obj.Allocated < 0 {
+		return nil, types.NewBadRequestError("allocated can not go below zero")
+	}
+	if obj.Allocated > obj.Qty {
+		return nil, types.NewBadRequestError("not enough unallocated stock")
+	}
+
+	obj.InTransit += adj.InTransit
+	if obj.InTransit < 0 {
+		return nil, types.NewBadRequestError("in transit can not go below zero")
+	}
+	if obj.InTransit > obj.Qty {
+		return nil, types.NewBadRequestError("not enough stock on hand")
+	}
+
+	if err := valueTx(tx, obj, adj); err != nil {
+		return nil, err
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	// a move is matched by an opposite one before the transaction commits, the total only
+	// dips or peaks in between so observers aren't told about either side
+	if !adj.Move {
+		tx = r.notifyAfter(ctx, tx, obj, before)
+	}
+
+	if _, err := tx.ID(id).Cols("qty", "on_order", "allocated", "in_transit", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("products", err)
+	}
+
+	return obj, nil
+}
+
+// notifyAfter - queues the observers to hear about the change once the transaction
+// commits, nothing is sent if the qty did not move or the transaction rolls back
+func (r *productsRepo) notifyAfter(ctx context.Context, tx *xorm.Session, obj *types.Product, before int64) *xorm.Session {
+	if len(r.observers) == 0 || obj.Qty == before {
+		return tx
+	}
+
+	change := types.StockChange{
+		ProductID:    obj.ID,
+		Sku:          obj.Sku,
+		Name:         obj.Name,
+		Before:       before,
+		After:        obj.Qty,
+		ReorderPoint: obj.ReorderPoint,
+		ReorderQty:   obj.ReorderQty,
+	}
+
+	return tx.After(func(interface{}) {
+		notify(ctx, r.observers, change)
+	})
+}
+
 func (r *productsRepo) Destroy(ctx context.Context, id int64) error {
 	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
 		return nil, r.DestroyTx(ctx, tx, id)
@@ -215,3 +441,34 @@ func (r *productsRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64
 	}
 	return nil
 }
+
+// canTrack - lots and serials can only be switched on or off while nothing is on hand, so every
+// unit is in a lot or has a serial or none are. Kits, their components and anything on a bill of
+// materials don't keep lots or serials so they can't have either.
+func (r *productsRepo) canTrack(tx *xorm.Session, obj *types.Product, qty int64, what string) error {
+	if qty != 0 {
+		return types.NewBadRequestError(what + " can only be changed while nothing is on hand")
+	}
+
+	if obj.Kit {
+		return types.NewBadRequestError(what + " can not be changed on a kit")
+	}
+
+	used, err := tx.Where("component_id = ?", obj.ID).Exist(&types.KitComponent{})
+	if err != nil {
+		return normalizeErr("kit_components", err)
+	}
+	if used {
+		return types.NewBadRequestError(what + " can not be changed on a kit component")
+	}
+
+	used, err = tx.Where("product_id = ? OR component_id = ?", obj.ID, obj.ID).Exist(&types.BomLine{})
+	if err != nil {
+		return normalizeErr("bom_lines", err)
+	}
+	if used {
+		return types.NewBadRequestError(what + " can not be changed on a product with or on a bill of materials")
+	}
+
+	return nil
+}
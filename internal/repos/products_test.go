@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
 	"github.com/happilymarrieddad/product-inventory-management-system/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -39,6 +40,12 @@ var _ = Describe("REPOS: Products", func() {
 			Expect(err).NotTo(BeNil())
 			_, err = repo.Create(ctx, types.NewProduct{Qty: 50, Sku: "test"})
 			Expect(err).NotTo(BeNil())
+
+			// lot tracked stock has to be received into a lot
+			_, err = repo.Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 50, LotTracked: true})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+			_, err = repo.Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 50, Serialized: true})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
 		})
 
 		It("should successfully create a product", func() {
@@ -124,6 +131,16 @@ var _ = Describe("REPOS: Products", func() {
 				Expect(newProduct).To(BeNil())
 			})
 
+			It("should allow the qty to be set to zero", func() {
+				newProduct, err := repo.Update(ctx, &types.UpdateProduct{ID: ids[0], Qty: utils.Ref(int64(0))})
+				Expect(err).To(BeNil())
+				Expect(newProduct.Qty).To(BeNumerically("==", 0))
+
+				product, _, err := repo.Get(ctx, ids[0])
+				Expect(err).To(BeNil())
+				Expect(product.Qty).To(BeNumerically("==", 0))
+			})
+
 			It("should successfully update", func() {
 				product, exists, err := repo.Get(ctx, ids[0])
 				Expect(err).To(BeNil())
@@ -141,6 +158,99 @@ var _ = Describe("REPOS: Products", func() {
 				Expect(newProduct.Sku).To(Equal(product.Sku))
 				Expect(newProduct.Qty).To(Equal(product.Qty))
 			})
+
+			It("should only change the cost method while nothing is on hand", func() {
+				_, err := repo.Update(ctx, &types.UpdateProduct{ID: ids[0], CostMethod: utils.Ref(types.CostMethodAverage)})
+				Expect(err).NotTo(BeNil())
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				product, err := repo.Update(ctx, &types.UpdateProduct{
+					ID: ids[1], Qty: utils.Ref(int64(0)), CostMethod: utils.Ref(types.CostMethodAverage),
+				})
+				Expect(err).NotTo(BeNil())
+				Expect(product).To(BeNil())
+
+				_, err = repo.Update(ctx, &types.UpdateProduct{ID: ids[1], Qty: utils.Ref(int64(0))})
+				Expect(err).To(BeNil())
+
+				product, err = repo.Update(ctx, &types.UpdateProduct{ID: ids[1], CostMethod: utils.Ref(types.CostMethodAverage)})
+				Expect(err).To(BeNil())
+				Expect(product.CostMethod).To(Equal(types.CostMethodAverage))
+			})
+
+			It("should only switch lot tracking while nothing is on hand and off kits and bills of materials", func() {
+				_, err := repo.Update(ctx, &types.UpdateProduct{ID: ids[0], LotTracked: utils.Ref(true)})
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				for _, id := range ids[1:3] {
+					_, err = repo.Update(ctx, &types.UpdateProduct{ID: id, Qty: utils.Ref(int64(0))})
+					Expect(err).To(BeNil())
+				}
+
+				_, err = gr.Boms().Set(ctx, &types.SetBom{ProductID: ids[3], Lines: []types.NewBomLine{{ComponentID: ids[1], Qty: 1}}})
+				Expect(err).To(BeNil())
+
+				_, err = repo.Update(ctx, &types.UpdateProduct{ID: ids[1], LotTracked: utils.Ref(true)})
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				product, err := repo.Update(ctx, &types.UpdateProduct{ID: ids[2], LotTracked: utils.Ref(true)})
+				Expect(err).To(BeNil())
+				Expect(product.LotTracked).To(BeTrue())
+			})
+
+			It("should only switch serials while nothing is on hand and off kits and bills of materials", func() {
+				_, err := repo.Update(ctx, &types.UpdateProduct{ID: ids[0], Serialized: utils.Ref(true)})
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				for _, id := range ids[1:3] {
+					_, err = repo.Update(ctx, &types.UpdateProduct{ID: id, Qty: utils.Ref(int64(0))})
+					Expect(err).To(BeNil())
+				}
+
+				_, err = gr.Kits().Create(ctx, types.NewKit{
+					Name: "kit", Sku: "kit", Components: []types.NewKitComponent{{ComponentID: ids[1], Qty: 1}},
+				})
+				Expect(err).To(BeNil())
+
+				_, err = repo.Update(ctx, &types.UpdateProduct{ID: ids[1], Serialized: utils.Ref(true)})
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				product, err := repo.Update(ctx, &types.UpdateProduct{ID: ids[2], Serialized: utils.Ref(true)})
+				Expect(err).To(BeNil())
+				Expect(product.Serialized).To(BeTrue())
+			})
+		})
+
+		Context("Adjust(Tx)", func() {
+			It("should apply a relative change", func() {
+				product, err := repo.Adjust(ctx, ids[0], types.ProductAdjustment{Qty: -20})
+				Expect(err).To(BeNil())
+				Expect(product.Qty).To(BeNumerically("==", 30))
+			})
+
+			It("should not let the qty go negative", func() {
+				_, err := repo.Adjust(ctx, ids[0], types.ProductAdjustment{Qty: -51})
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+			})
+
+			It("should notify observers once committed", func() {
+				observer := &recordingObserver{}
+				_, err := repos.NewProducts(gr.DB(), observer).Update(ctx, &types.UpdateProduct{
+					ID: ids[0], ReorderPoint: utils.Ref(int64(40)),
+				})
+				Expect(err).To(BeNil())
+				// nothing moved
+				Expect(observer.Changes()).To(BeEmpty())
+
+				_, err = repos.NewProducts(gr.DB(), observer).Adjust(ctx, ids[0], types.ProductAdjustment{Qty: -15})
+				Expect(err).To(BeNil())
+
+				changes := observer.Changes()
+				Expect(changes).To(HaveLen(1))
+				Expect(changes[0].Before).To(BeNumerically("==", 50))
+				Expect(changes[0].After).To(BeNumerically("==", 35))
+				Expect(changes[0].CrossedReorderPoint()).To(BeTrue())
+			})
 		})
 
 		Context("Destroy(Tx)", func() {
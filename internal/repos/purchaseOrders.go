@@ -0,0 +1,405 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type PurchaseOrdersFind struct {
+	Limit       int
+	Offset      int
+	IDs         []int64
+	SupplierIDs []int64
+	Statuses    []types.PurchaseOrderStatus
+}
+
+//go:generate mockgen -source=./purchaseOrders.go -destination=./mocks/PurchaseOrders.go -package=mock_repos PurchaseOrders
+type PurchaseOrders interface {
+	Find(ctx context.Context, opts *PurchaseOrdersFind) ([]*types.PurchaseOrder, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *PurchaseOrdersFind) ([]*types.PurchaseOrder, int64, error)
+	Get(ctx context.Context, id int64) (*types.PurchaseOrder, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PurchaseOrder, bool, error)
+	Create(ctx context.Context, newOrder types.NewPurchaseOrder) (*types.PurchaseOrder, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewPurchaseOrder) (*types.PurchaseOrder, error)
+	Update(ctx context.Context, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error)
+	Transition(ctx context.Context, id int64, to types.PurchaseOrderStatus) (*types.PurchaseOrder, error)
+	TransitionTx(ctx context.Context, tx *xorm.Session, id int64, to types.PurchaseOrderStatus) (*types.PurchaseOrder, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+}
+
+// NewPurchaseOrders - products is used to keep each product's on order qty in step
+// with the open purchase orders
+func NewPurchaseOrders(db *xorm.Engine, products Products) PurchaseOrders {
+	return &purchaseOrdersRepo{db, products}
+}
+
+type purchaseOrdersRepo struct {
+	db       *xorm.Engine
+	products Products
+}
+
+func (r *purchaseOrdersRepo) Find(ctx context.Context, opts *PurchaseOrdersFind) ([]*types.PurchaseOrder, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		p, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return p, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.PurchaseOrder), count, nil
+}
+
+func (r *purchaseOrdersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *PurchaseOrdersFind) ([]*types.PurchaseOrder, int64, error) {
+	if opts == nil {
+		opts = &PurchaseOrdersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.SupplierIDs) > 0 {
+		tx = tx.In("supplier_id", utils.Int64ArrToInterfaceArr(opts.SupplierIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.PurchaseOrder{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("purchase_orders", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *purchaseOrdersRepo) Get(ctx context.Context, id int64) (*types.PurchaseOrder, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		p, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return p, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.PurchaseOrder), exists, nil
+}
+
+func (r *purchaseOrdersRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.PurchaseOrder, bool, error) {
+	obj := &types.PurchaseOrder{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("purchase_orders", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *purchaseOrdersRepo) Create(ctx context.Context, newOrder types.NewPurchaseOrder) (*types.PurchaseOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.PurchaseOrder), nil
+}
+
+// CreateTx - purchase orders always start out as drafts
+func (r *purchaseOrdersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewPurchaseOrder) (*types.PurchaseOrder, error) {
+	if err := types.Validate(newOrder); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	exists, err := tx.Table("suppliers").Where("id = ?", newOrder.SupplierID).Exist()
+	if err != nil {
+		return nil, normalizeErr("purchase_orders", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("supplier not found by id")
+	}
+
+	obj := &types.PurchaseOrder{
+		SupplierID: newOrder.SupplierID,
+		Status:     types.PurchaseOrderStatusDraft,
+		ExpectedAt: newOrder.ExpectedAt,
+		Notes:      newOrder.Notes,
+		CreatedAt:  time.Now(),
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("purchase_orders", err)
+	}
+
+	if err := r.insertLines(tx, obj, newOrder.Lines); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *purchaseOrdersRepo) Update(ctx context.Context, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.PurchaseOrder), nil
+}
+
+// UpdateTx - the expected date and notes can change until the order is closed, the
+// lines only while it's a draft
+func (r *purchaseOrdersRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdatePurchaseOrder) (*types.PurchaseOrder, error) {
+	if err := types.Validate(diff); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, exists, err := r.getForUpdate(tx, diff.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("purchase order not found by id")
+	}
+
+	if obj.Status != types.PurchaseOrderStatusDraft && !obj.Status.Open() {
+		return nil, types.NewBadRequestError("purchase order is closed")
+	}
+
+	if diff.ExpectedAt != nil {
+		obj.ExpectedAt = diff.ExpectedAt
+	}
+
+	if diff.Notes != nil {
+		obj.Notes = diff.Notes
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).Cols("expected_at", "notes", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("purchase_orders", err)
+	}
+
+	if len(diff.Lines) > 0 {
+		if obj.Status != types.PurchaseOrderStatusDraft {
+			return nil, types.NewBadRequestError("only draft purchase orders can change their lines")
+		}
+
+		if _, err := tx.Where("purchase_order_id = ?", obj.ID).Delete(&types.PurchaseOrderLine{}); err != nil {
+			return nil, normalizeErr("purchase_order_lines", err)
+		}
+
+		if err := r.insertLines(tx, obj, diff.Lines); err != nil {
+			return nil, err
+		}
+	} else if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *purchaseOrdersRepo) Transition(ctx context.Context, id int64, to types.PurchaseOrderStatus) (*types.PurchaseOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.TransitionTx(ctx, tx, id, to)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.PurchaseOrder), nil
+}
+
+// TransitionTx - moves a purchase order along its lifecycle. Submitting puts the ordered
+// qty on order for each product and cancelling takes back whatever hadn't arrived yet.
+// Receiving moves on order stock itself so the received statuses have no side effects here.
+func (r *purchaseOrdersRepo) TransitionTx(ctx context.Context, tx *xorm.Session, id int64, to types.PurchaseOrderStatus) (*types.PurchaseOrder, error) {
+	obj, exists, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("purchase order not found by id")
+	}
+
+	if !obj.Status.CanTransition(to) {
+		return nil, types.NewBadRequestError("purchase order can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case to == types.PurchaseOrderStatusSubmitted:
+		for _, line := range obj.Lines {
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{OnOrder: line.QtyOrdered}); err != nil {
+				return nil, err
+			}
+		}
+		obj.SubmittedAt = utils.Ref(time.Now())
+	case to == types.PurchaseOrderStatusCancelled && obj.Status.Open():
+		for _, line := range obj.Lines {
+			if line.Outstanding() == 0 {
+				continue
+			}
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{OnOrder: -line.Outstanding()}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	obj.Status = to
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).Cols("status", "submitted_at", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("purchase_orders", err)
+	}
+
+	return obj, nil
+}
+
+func (r *purchaseOrdersRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+// DestroyTx - only drafts can be deleted, anything sent to a supplier gets cancelled instead
+func (r *purchaseOrdersRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	obj, exists, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return types.NewNotFoundError("purchase order not found")
+	}
+
+	if obj.Status != types.PurchaseOrderStatusDraft {
+		return types.NewBadRequestError("only draft purchase orders can be deleted")
+	}
+
+	if _, err := tx.Where("id = ?", id).Delete(&types.PurchaseOrder{}); err != nil {
+		return normalizeErr("purchase_orders", err)
+	}
+	return nil
+}
+
+func (r *purchaseOrdersRepo) getForUpdate(tx *xorm.Session, id int64) (*types.PurchaseOrder, bool, error) {
+	obj := &types.PurchaseOrder{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("purchase_orders", err)
+	}
+	return obj, exists, nil
+}
+
+func (r *purchaseOrdersRepo) loadLines(tx *xorm.Session, orders ...*types.PurchaseOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.PurchaseOrder{}
+	ids := []int64{}
+	for _, order := range orders {
+		order.Lines = []*types.PurchaseOrderLine{}
+		byID[order.ID] = order
+		ids = append(ids, order.ID)
+	}
+
+	lines := []*types.PurchaseOrderLine{}
+	if err := tx.In("purchase_order_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("purchase_order_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.PurchaseOrderID].Lines = append(byID[line.PurchaseOrderID].Lines, line)
+	}
+
+	return nil
+}
+
+func (r *purchaseOrdersRepo) insertLines(tx *xorm.Session, order *types.PurchaseOrder, newLines []types.NewPurchaseOrderLine) error {
+	order.Lines = []*types.PurchaseOrderLine{}
+
+	for _, nl := range newLines {
+		exists, err := tx.Table("products").Where("id = ?", nl.ProductID).Exist()
+		if err != nil {
+			return normalizeErr("purchase_order_lines", err)
+		}
+		if !exists {
+			return types.NewNotFoundError("product not found by id")
+		}
+
+		line := &types.PurchaseOrderLine{
+			PurchaseOrderID: order.ID,
+			ProductID:       nl.ProductID,
+			QtyOrdered:      nl.Qty,
+			CreatedAt:       time.Now(),
+		}
+
+		if nl.UnitCost != nil {
+			line.UnitCost = *nl.UnitCost
+		} else {
+			link := &types.ProductSupplier{}
+			if _, err := tx.Where("product_id = ? AND supplier_id = ?", nl.ProductID, order.SupplierID).Get(link); err != nil {
+				return normalizeErr("purchase_order_lines", err)
+			}
+			line.UnitCost = link.UnitCost
+		}
+
+		if _, err := tx.Insert(line); err != nil {
+			return normalizeErr("purchase_order_lines", err)
+		}
+
+		order.Lines = append(order.Lines, line)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: PurchaseOrders", func() {
+
+	var (
+		repo     repos.PurchaseOrders
+		product  *types.Product
+		supplier *types.Supplier
+	)
+
+	BeforeEach(func() {
+		clearDatabase("purchase_orders", "purchase_order_lines", "product_suppliers", "suppliers", "products")
+
+		repo = gr.PurchaseOrders()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		supplier, err = gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+		Expect(err).To(BeNil())
+
+		_, err = gr.ProductSuppliers().Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: supplier.ID, UnitCost: 250})
+		Expect(err).To(BeNil())
+	})
+
+	newOrder := func() types.NewPurchaseOrder {
+		return types.NewPurchaseOrder{
+			SupplierID: supplier.ID,
+			Lines:      []types.NewPurchaseOrderLine{{ProductID: product.ID, Qty: 20}},
+		}
+	}
+
+	onOrder := func() int64 {
+		p, _, err := gr.Products().Get(ctx, product.ID)
+		Expect(err).To(BeNil())
+		return p.OnOrder
+	}
+
+	Context("Create(Tx)", func() {
+		It("should fail without lines or with unknown references", func() {
+			_, err := repo.Create(ctx, types.NewPurchaseOrder{SupplierID: supplier.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewPurchaseOrder{SupplierID: 99999999, Lines: newOrder().Lines})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewPurchaseOrder{
+				SupplierID: supplier.ID, Lines: []types.NewPurchaseOrderLine{{ProductID: 99999999, Qty: 1}},
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should create a draft using the supplier's cost", func() {
+			order, err := repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.PurchaseOrderStatusDraft))
+			Expect(order.Lines).To(HaveLen(1))
+			Expect(order.Lines[0].UnitCost).To(BeNumerically("==", 250))
+
+			// drafts aren't on order yet
+			Expect(onOrder()).To(BeNumerically("==", 0))
+		})
+	})
+
+	Context("Transition(Tx)", func() {
+		var order *types.PurchaseOrder
+
+		BeforeEach(func() {
+			var err error
+			order, err = repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+		})
+
+		It("should put the lines on order when submitted", func() {
+			order, err := repo.Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+			Expect(order.SubmittedAt).NotTo(BeNil())
+			Expect(onOrder()).To(BeNumerically("==", 20))
+		})
+
+		It("should take the lines off order when cancelled", func() {
+			_, err := repo.Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+
+			_, err = repo.Transition(ctx, order.ID, types.PurchaseOrderStatusCancelled)
+			Expect(err).To(BeNil())
+			Expect(onOrder()).To(BeNumerically("==", 0))
+		})
+
+		It("should enforce the lifecycle", func() {
+			_, err := repo.Transition(ctx, order.ID, types.PurchaseOrderStatusReceived)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Transition(ctx, order.ID, types.PurchaseOrderStatusCancelled)
+			Expect(err).To(BeNil())
+
+			_, err = repo.Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Update(Tx)", func() {
+		It("should only replace lines on a draft", func() {
+			order, err := repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+
+			order, err = repo.Update(ctx, &types.UpdatePurchaseOrder{
+				ID: order.ID, Lines: []types.NewPurchaseOrderLine{{ProductID: product.ID, Qty: 5, UnitCost: utils.Ref(int64(200))}},
+			})
+			Expect(err).To(BeNil())
+			Expect(order.Lines).To(HaveLen(1))
+			Expect(order.Lines[0].QtyOrdered).To(BeNumerically("==", 5))
+			Expect(order.Lines[0].UnitCost).To(BeNumerically("==", 200))
+
+			_, err = repo.Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+
+			_, err = repo.Update(ctx, &types.UpdatePurchaseOrder{ID: order.ID, Lines: newOrder().Lines})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			order, err = repo.Update(ctx, &types.UpdatePurchaseOrder{ID: order.ID, Notes: utils.Ref("call before delivery")})
+			Expect(err).To(BeNil())
+			Expect(*order.Notes).To(Equal("call before delivery"))
+			Expect(order.Lines).To(HaveLen(1))
+		})
+	})
+
+	Context("Destroy(Tx)", func() {
+		It("should only delete drafts", func() {
+			order, err := repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+
+			_, err = repo.Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+			Expect(types.IsBadRequestError(repo.Destroy(ctx, order.ID))).To(BeTrue())
+
+			draft, err := repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+			Expect(repo.Destroy(ctx, draft.ID)).To(Succeed())
+		})
+	})
+
+	Context("Find(Tx)", func() {
+		It("should filter by status and load the lines", func() {
+			order, err := repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+			_, err = repo.Create(ctx, newOrder())
+			Expect(err).To(BeNil())
+
+			_, err = repo.Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+
+			orders, count, err := repo.Find(ctx, &repos.PurchaseOrdersFind{Statuses: []types.PurchaseOrderStatus{types.PurchaseOrderStatusSubmitted}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(orders[0].Lines).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,171 @@
+package repos
+
+import (
+	"context"
+	"strings"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+//go:generate mockgen -source=./putaway.go -destination=./mocks/Putaway.go -package=mock_repos Putaway
+type Putaway interface {
+	Suggest(ctx context.Context, receiptID int64) ([]*types.PutawaySuggestion, error)
+	SuggestTx(ctx context.Context, tx *xorm.Session, receiptID int64) ([]*types.PutawaySuggestion, error)
+	Move(ctx context.Context, move types.MoveStock) ([]*types.StockLevel, error)
+	MoveTx(ctx context.Context, tx *xorm.Session, move types.MoveStock) ([]*types.StockLevel, error)
+}
+
+// NewPutaway - moves go through stock levels and are recorded on the stock movement ledger in
+// the same transaction
+func NewPutaway(db *xorm.Engine, stockLevels StockLevels, stockMovements StockMovements) Putaway {
+	return &putawayRepo{db, stockLevels, stockMovements}
+}
+
+type putawayRepo struct {
+	db             *xorm.Engine
+	stockLevels    StockLevels
+	stockMovements StockMovements
+}
+
+func (r *putawayRepo) Suggest(ctx context.Context, receiptID int64) ([]*types.PutawaySuggestion, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.SuggestTx(ctx, tx, receiptID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.PutawaySuggestion), nil
+}
+
+// SuggestTx - a bin for every line of the receipt that put stock on hand. Only bins inside the
+// receipt's location are considered when it has one and an empty bin is only suggested once.
+func (r *putawayRepo) SuggestTx(ctx context.Context, tx *xorm.Session, receiptID int64) ([]*types.PutawaySuggestion, error) {
+	receipt := &types.Receipt{}
+	exists, err := tx.Where("id = ?", receiptID).Get(receipt)
+	if err != nil {
+		return nil, normalizeErr("receipts", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("receipt not found by id")
+	}
+
+	lines := []*types.ReceiptLine{}
+	if err := tx.Where("receipt_id = ?", receipt.ID).OrderBy("id").Find(&lines); err != nil {
+		return nil, normalizeErr("receipt_lines", err)
+	}
+
+	within, withinArgs := "", []interface{}{}
+	if receipt.LocationID != nil {
+		within = " AND l.id IN (" + withinLocationsSQL(1) + ")"
+		withinArgs = append(withinArgs, *receipt.LocationID)
+	}
+
+	taken := []int64{}
+	objs := []*types.PutawaySuggestion{}
+	for _, line := range lines {
+		if line.Accepted() <= 0 {
+			continue
+		}
+
+		obj := &types.PutawaySuggestion{
+			ReceiptLineID: line.ID,
+			ProductID:     line.ProductID,
+			Qty:           line.Accepted(),
+			Reason:        "none",
+		}
+
+		bin := struct {
+			ID   int64  `xorm:"id"`
+			Code string `xorm:"code"`
+		}{}
+
+		args := append([]interface{}{line.ProductID, types.LocationKindBin}, withinArgs...)
+		found, err := tx.SQL(`
+			SELECT l.id, l.code FROM stock_levels s
+			INNER JOIN locations l ON l.id = s.location_id
+			WHERE s.product_id = ? AND s.qty > 0 AND l.kind = ?`+within+`
+			ORDER BY s.qty DESC, l.pick_sequence, l.code
+			LIMIT 1`, args...,
+		).Get(&bin)
+		if err != nil {
+			return nil, normalizeErr("stock_levels", err)
+		}
+
+		if found {
+			obj.Reason = "consolidate"
+		} else {
+			query := `
+				SELECT l.id, l.code FROM locations l
+				WHERE l.kind = ? AND NOT EXISTS (
+					SELECT 1 FROM stock_levels s WHERE s.location_id = l.id AND s.qty > 0
+				)` + within
+			args := append([]interface{}{types.LocationKindBin}, withinArgs...)
+			if len(taken) > 0 {
+				query += " AND l.id NOT IN (" + strings.TrimSuffix(strings.Repeat("?,", len(taken)), ",") + ")"
+				args = append(args, utils.Int64ArrToInterfaceArr(taken...)...)
+			}
+
+			found, err = tx.SQL(query+" ORDER BY l.pick_sequence, l.code LIMIT 1", args...).Get(&bin)
+			if err != nil {
+				return nil, normalizeErr("locations", err)
+			}
+			if found {
+				obj.Reason = "empty"
+				taken = append(taken, bin.ID)
+			}
+		}
+
+		if found {
+			obj.LocationID = utils.Ref(bin.ID)
+			obj.LocationCode = utils.Ref(bin.Code)
+		}
+
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+func (r *putawayRepo) Move(ctx context.Context, move types.MoveStock) ([]*types.StockLevel, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.MoveTx(ctx, tx, move)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.StockLevel), nil
+}
+
+// MoveTx - takes the stock off one location and puts it on another, the product's total doesn't
+// change. The from and to stock levels come back in that order.
+func (r *putawayRepo) MoveTx(ctx context.Context, tx *xorm.Session, move types.MoveStock) ([]*types.StockLevel, error) {
+	if err := types.Validate(move); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	// onto the destination first so the product total never dips below what's allocated
+	to, err := r.stockLevels.MoveTx(ctx, tx, move.ProductID, move.ToLocationID, move.Qty)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := r.stockLevels.MoveTx(ctx, tx, move.ProductID, move.FromLocationID, -move.Qty)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, movement := range []types.NewStockMovement{
+		{ProductID: move.ProductID, LocationID: &move.FromLocationID, Qty: -move.Qty, Reason: types.StockMovementReasonMove},
+		{ProductID: move.ProductID, LocationID: &move.ToLocationID, Qty: move.Qty, Reason: types.StockMovementReasonMove},
+	} {
+		if _, err := r.stockMovements.CreateTx(ctx, tx, movement); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*types.StockLevel{from, to}, nil
+}
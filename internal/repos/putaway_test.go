@@ -0,0 +1,144 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Putaway", func() {
+
+	var (
+		repo      repos.Putaway
+		product   *types.Product
+		warehouse *types.Location
+		bins      []*types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_movements", "receipts", "purchase_orders", "suppliers", "stock_levels", "locations", "products")
+
+		repo = gr.Putaway()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		warehouse, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		bins = []*types.Location{}
+		for i, code := range []string{"MAIN-B", "MAIN-A"} {
+			bin, err := gr.Locations().Create(ctx, types.NewLocation{
+				Name: code, Code: code, Kind: types.LocationKindBin, ParentID: &warehouse.ID, PickSequence: int64(i),
+			})
+			Expect(err).To(BeNil())
+			bins = append(bins, bin)
+		}
+	})
+
+	Context("Move(Tx)", func() {
+		It("should move stock between locations without changing the total", func() {
+			_, err := gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: warehouse.ID, Qty: utils.Ref[int64](6)})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Move(ctx, types.MoveStock{ProductID: product.ID, FromLocationID: warehouse.ID, ToLocationID: bins[0].ID, Qty: 7})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			levels, err := repo.Move(ctx, types.MoveStock{ProductID: product.ID, FromLocationID: warehouse.ID, ToLocationID: bins[0].ID, Qty: 4})
+			Expect(err).To(BeNil())
+			Expect(levels[0].Qty).To(BeNumerically("==", 2))
+			Expect(levels[1].Qty).To(BeNumerically("==", 4))
+
+			p, _, err := gr.Products().Get(ctx, product.ID)
+			Expect(err).To(BeNil())
+			Expect(p.Qty).To(BeNumerically("==", 16))
+
+			// the warehouse still sees everything in its bins
+			within, count, err := gr.StockLevels().Find(ctx, &repos.StockLevelsFind{WithinLocationIDs: []int64{warehouse.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 2))
+			Expect(within).To(HaveLen(2))
+		})
+
+		It("should move allocated stock without alerting on the product total", func() {
+			_, err := gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: warehouse.ID, Qty: utils.Ref[int64](6)})
+			Expect(err).To(BeNil())
+
+			// 14 of the 16 on hand are allocated and the total is at the reorder point
+			_, err = gr.Products().Adjust(ctx, product.ID, types.ProductAdjustment{Allocated: 14})
+			Expect(err).To(BeNil())
+			_, err = gr.Products().Update(ctx, &types.UpdateProduct{ID: product.ID, ReorderPoint: utils.Ref[int64](16)})
+			Expect(err).To(BeNil())
+
+			observer := &recordingObserver{}
+			repo := repos.NewPutaway(gr.DB(), repos.NewStockLevels(gr.DB(), repos.NewProducts(gr.DB(), observer), observer), gr.StockMovements())
+
+			levels, err := repo.Move(ctx, types.MoveStock{ProductID: product.ID, FromLocationID: warehouse.ID, ToLocationID: bins[0].ID, Qty: 4})
+			Expect(err).To(BeNil())
+			Expect(levels[0].Qty).To(BeNumerically("==", 2))
+			Expect(levels[1].Qty).To(BeNumerically("==", 4))
+
+			p, _, err := gr.Products().Get(ctx, product.ID)
+			Expect(err).To(BeNil())
+			Expect(p.Qty).To(BeNumerically("==", 16))
+			Expect(p.Allocated).To(BeNumerically("==", 14))
+
+			changes := observer.Changes()
+			Expect(changes).To(HaveLen(2))
+			for _, change := range changes {
+				Expect(change.LocationID).NotTo(BeNil())
+			}
+		})
+	})
+
+	Context("Suggest(Tx)", func() {
+		receive := func() *types.Receipt {
+			supplier, err := gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+			Expect(err).To(BeNil())
+
+			order, err := gr.PurchaseOrders().Create(ctx, types.NewPurchaseOrder{
+				SupplierID: supplier.ID,
+				Lines:      []types.NewPurchaseOrderLine{{ProductID: product.ID, Qty: 5, UnitCost: utils.Ref[int64](100)}},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = gr.PurchaseOrders().Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+
+			receipt, err := gr.Receipts().Create(ctx, types.NewReceipt{
+				PurchaseOrderID: order.ID,
+				LocationID:      &warehouse.ID,
+				Lines:           []types.NewReceiptLine{{PurchaseOrderLineID: order.Lines[0].ID, QtyReceived: 5}},
+			})
+			Expect(err).To(BeNil())
+			return receipt
+		}
+
+		It("should suggest the first empty bin in pick sequence", func() {
+			suggestions, err := repo.Suggest(ctx, receive().ID)
+			Expect(err).To(BeNil())
+			Expect(suggestions).To(HaveLen(1))
+			Expect(suggestions[0].Reason).To(Equal("empty"))
+			Expect(*suggestions[0].LocationID).To(Equal(bins[0].ID))
+		})
+
+		It("should keep a product together in the bin already holding it", func() {
+			_, err := gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: bins[1].ID, Qty: utils.Ref[int64](2)})
+			Expect(err).To(BeNil())
+
+			suggestions, err := repo.Suggest(ctx, receive().ID)
+			Expect(err).To(BeNil())
+			Expect(suggestions[0].Reason).To(Equal("consolidate"))
+			Expect(*suggestions[0].LocationID).To(Equal(bins[1].ID))
+		})
+
+		It("should return not found for an unknown receipt", func() {
+			_, err := repo.Suggest(ctx, 99999999)
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,339 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type ReceiptsFind struct {
+	Limit            int
+	Offset           int
+	IDs              []int64
+	PurchaseOrderIDs []int64
+}
+
+//go:generate mockgen -source=./receipts.go -destination=./mocks/Receipts.go -package=mock_repos Receipts
+type Receipts interface {
+	Find(ctx context.Context, opts *ReceiptsFind) ([]*types.Receipt, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *ReceiptsFind) ([]*types.Receipt, int64, error)
+	Get(ctx context.Context, id int64) (*types.Receipt, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Receipt, bool, error)
+	Create(ctx context.Context, newReceipt types.NewReceipt) (*types.Receipt, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newReceipt types.NewReceipt) (*types.Receipt, error)
+}
+
+// NewReceipts - receiving writes stock, moves the purchase order along and fills backorders
+// so it goes through those repos to keep everything in the same transaction
+func NewReceipts(db *xorm.Engine, products Products, stockLevels StockLevels, purchaseOrders PurchaseOrders, salesOrders SalesOrders, lots Lots, serials Serials) Receipts {
+	return &receiptsRepo{db, products, stockLevels, purchaseOrders, salesOrders, lots, serials}
+}
+
+type receiptsRepo struct {
+	db             *xorm.Engine
+	products       Products
+	stockLevels    StockLevels
+	purchaseOrders PurchaseOrders
+	salesOrders    SalesOrders
+	lots           Lots
+	serials        Serials
+}
+
+// receiptReference - the reference type receipts write to serial histories
+var receiptReference = "receipt"
+
+func (r *receiptsRepo) Find(ctx context.Context, opts *ReceiptsFind) ([]*types.Receipt, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		rc, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return rc, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Receipt), count, nil
+}
+
+func (r *receiptsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *ReceiptsFind) ([]*types.Receipt, int64, error) {
+	if opts == nil {
+		opts = &ReceiptsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.PurchaseOrderIDs) > 0 {
+		tx = tx.In("purchase_order_id", utils.Int64ArrToInterfaceArr(opts.PurchaseOrderIDs...)...)
+	}
+
+	objs := []*types.Receipt{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("receipts", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *receiptsRepo) Get(ctx context.Context, id int64) (*types.Receipt, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		rc, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return rc, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Receipt), exists, nil
+}
+
+func (r *receiptsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Receipt, bool, error) {
+	obj := &types.Receipt{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("receipts", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *receiptsRepo) Create(ctx context.Context, newReceipt types.NewReceipt) (*types.Receipt, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newReceipt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Receipt), nil
+}
+
+// CreateTx - records a delivery against an open purchase order. Accepted units go into stock,
+// damaged ones don't and stay outstanding. Anything received over what was ordered is still
+// stocked but only the outstanding qty comes off on order. Lines close once they are received
+// in full or closed short and the order becomes received when every line is closed. Accepted
+// units of lot tracked products go into the lot given on their line and serialized products
+// need a serial number for each of them. The new stock is then
+// allocated to any sales order backorders.
+func (r *receiptsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newReceipt types.NewReceipt) (*types.Receipt, error) {
+	if err := types.Validate(newReceipt); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	// lock the order first so two receipts against it can't interleave
+	order := &types.PurchaseOrder{}
+	exists, err := tx.Where("id = ?", newReceipt.PurchaseOrderID).ForUpdate().Get(order)
+	if err != nil {
+		return nil, normalizeErr("receipts", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("purchase order not found by id")
+	}
+
+	if !order.Status.Open() {
+		return nil, types.NewBadRequestError("purchase order is not open for receiving")
+	}
+
+	if newReceipt.LocationID != nil {
+		exists, err := tx.Table("locations").Where("id = ?", *newReceipt.LocationID).Exist()
+		if err != nil {
+			return nil, normalizeErr("receipts", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("location not found by id")
+		}
+	}
+
+	lines := []*types.PurchaseOrderLine{}
+	if err := tx.Where("purchase_order_id = ?", order.ID).ForUpdate().Find(&lines); err != nil {
+		return nil, normalizeErr("purchase_order_lines", err)
+	}
+
+	stocked := []int64{}
+	lineByID := map[int64]*types.PurchaseOrderLine{}
+	for _, line := range lines {
+		lineByID[line.ID] = line
+	}
+
+	obj := &types.Receipt{
+		PurchaseOrderID: order.ID,
+		LocationID:      newReceipt.LocationID,
+		Notes:           newReceipt.Notes,
+		ReceivedAt:      time.Now(),
+		CreatedAt:       time.Now(),
+		Lines:           []*types.ReceiptLine{},
+	}
+
+	if newReceipt.ReceivedAt != nil {
+		obj.ReceivedAt = *newReceipt.ReceivedAt
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("receipts", err)
+	}
+
+	for _, nl := range newReceipt.Lines {
+		line, exists := lineByID[nl.PurchaseOrderLineID]
+		if !exists {
+			return nil, types.NewNotFoundError("purchase order line not found on this order")
+		}
+
+		if line.Closed && nl.QtyReceived > 0 {
+			return nil, types.NewBadRequestError("purchase order line is already closed")
+		}
+
+		receiptLine := &types.ReceiptLine{
+			ReceiptID:           obj.ID,
+			PurchaseOrderLineID: line.ID,
+			ProductID:           line.ProductID,
+			QtyReceived:         nl.QtyReceived,
+			QtyDamaged:          nl.QtyDamaged,
+			CreatedAt:           time.Now(),
+		}
+
+		outstanding := line.Outstanding()
+		line.QtyReceived += receiptLine.Accepted()
+		if nl.CloseShort || line.QtyReceived >= line.QtyOrdered {
+			line.Closed = true
+		}
+
+		if _, err := tx.ID(line.ID).Cols("qty_received", "closed").Update(line); err != nil {
+			return nil, normalizeErr("purchase_order_lines", err)
+		}
+
+		product := &types.Product{}
+		if _, err := tx.ID(line.ProductID).Get(product); err != nil {
+			return nil, normalizeErr("products", err)
+		}
+
+		if nl.Lot != nil && !product.LotTracked {
+			return nil, types.NewBadRequestError("product " + product.Sku + " doesn't track lots")
+		}
+
+		if _, err := r.serials.ReceiveTx(ctx, tx, types.SerialMove{
+			ProductID:     line.ProductID,
+			Qty:           receiptLine.Accepted(),
+			SerialNumbers: nl.SerialNumbers,
+			LocationID:    newReceipt.LocationID,
+			ReferenceType: &receiptReference,
+			ReferenceID:   &obj.ID,
+		}); err != nil {
+			return nil, err
+		}
+
+		adjustment := types.ProductAdjustment{OnOrder: line.Outstanding() - outstanding, UnitCost: utils.Ref(line.UnitCost)}
+		if receiptLine.Accepted() > 0 {
+			stocked = append(stocked, line.ProductID)
+
+			if product.LotTracked {
+				if nl.Lot == nil {
+					return nil, types.NewBadRequestError("a lot is required for lot tracked product " + product.Sku)
+				}
+
+				lot, err := r.lots.ReceiveTx(ctx, tx, line.ProductID, *nl.Lot, receiptLine.Accepted())
+				if err != nil {
+					return nil, err
+				}
+				receiptLine.LotID = &lot.ID
+			}
+			if newReceipt.LocationID != nil {
+				if _, err := r.stockLevels.ReceiveTx(ctx, tx, line.ProductID, *newReceipt.LocationID, receiptLine.Accepted(), line.UnitCost); err != nil {
+					return nil, err
+				}
+			} else {
+				adjustment.Qty = receiptLine.Accepted()
+			}
+		}
+
+		if adjustment.Qty != 0 || adjustment.OnOrder != 0 {
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, adjustment); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := tx.Insert(receiptLine); err != nil {
+			return nil, normalizeErr("receipt_lines", err)
+		}
+
+		obj.Lines = append(obj.Lines, receiptLine)
+	}
+
+	status := types.PurchaseOrderStatusReceived
+	for _, line := range lines {
+		if line.Outstanding() > 0 {
+			status = types.PurchaseOrderStatusPartiallyReceived
+			break
+		}
+	}
+
+	if _, err := r.purchaseOrders.TransitionTx(ctx, tx, order.ID, status); err != nil {
+		return nil, err
+	}
+
+	// customers waiting on backorders get the new stock first
+	if len(stocked) > 0 {
+		if _, err := r.salesOrders.AllocateTx(ctx, tx, stocked...); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func (r *receiptsRepo) loadLines(tx *xorm.Session, receipts ...*types.Receipt) error {
+	if len(receipts) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.Receipt{}
+	ids := []int64{}
+	for _, receipt := range receipts {
+		receipt.Lines = []*types.ReceiptLine{}
+		byID[receipt.ID] = receipt
+		ids = append(ids, receipt.ID)
+	}
+
+	lines := []*types.ReceiptLine{}
+	if err := tx.In("receipt_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("receipt_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.ReceiptID].Lines = append(byID[line.ReceiptID].Lines, line)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package repos_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Receipts", func() {
+
+	var (
+		repo    repos.Receipts
+		product *types.Product
+		order   *types.PurchaseOrder
+	)
+
+	BeforeEach(func() {
+		clearDatabase("receipts", "receipt_lines", "purchase_orders", "purchase_order_lines", "stock_levels", "locations", "suppliers", "lots", "products")
+
+		repo = gr.Receipts()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		supplier, err := gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+		Expect(err).To(BeNil())
+
+		order, err = gr.PurchaseOrders().Create(ctx, types.NewPurchaseOrder{
+			SupplierID: supplier.ID,
+			Lines:      []types.NewPurchaseOrderLine{{ProductID: product.ID, Qty: 20, UnitCost: utils.Ref[int64](250)}},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	stock := func() (int64, int64) {
+		p, _, err := gr.Products().Get(ctx, product.ID)
+		Expect(err).To(BeNil())
+		return p.Qty, p.OnOrder
+	}
+
+	status := func() types.PurchaseOrderStatus {
+		o, _, err := gr.PurchaseOrders().Get(ctx, order.ID)
+		Expect(err).To(BeNil())
+		return o.Status
+	}
+
+	receive := func(qty, damaged int64, closeShort bool) (*types.Receipt, error) {
+		return repo.Create(ctx, types.NewReceipt{
+			PurchaseOrderID: order.ID,
+			Lines: []types.NewReceiptLine{{
+				PurchaseOrderLineID: order.Lines[0].ID, QtyReceived: qty, QtyDamaged: damaged, CloseShort: closeShort,
+			}},
+		})
+	}
+
+	Context("Create(Tx)", func() {
+		It("should not receive against a draft", func() {
+			_, err := receive(5, 0, false)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		Context("with a submitted order", func() {
+			BeforeEach(func() {
+				_, err := gr.PurchaseOrders().Transition(ctx, order.ID, types.PurchaseOrderStatusSubmitted)
+				Expect(err).To(BeNil())
+			})
+
+			It("should fail with bad input or unknown references", func() {
+				_, err := receive(5, 6, false)
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				_, err = repo.Create(ctx, types.NewReceipt{
+					PurchaseOrderID: order.ID,
+					Lines:           []types.NewReceiptLine{{PurchaseOrderLineID: 99999999, QtyReceived: 1}},
+				})
+				Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+				_, err = repo.Create(ctx, types.NewReceipt{
+					PurchaseOrderID: order.ID,
+					LocationID:      utils.Ref[int64](99999999),
+					Lines:           []types.NewReceiptLine{{PurchaseOrderLineID: order.Lines[0].ID, QtyReceived: 1}},
+				})
+				Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+				// nothing moved
+				qty, onOrder := stock()
+				Expect(qty).To(BeNumerically("==", 10))
+				Expect(onOrder).To(BeNumerically("==", 20))
+			})
+
+			It("should stock a short delivery and leave the rest on order", func() {
+				receipt, err := receive(8, 2, false)
+				Expect(err).To(BeNil())
+				Expect(receipt.Lines).To(HaveLen(1))
+				Expect(receipt.Lines[0].Accepted()).To(BeNumerically("==", 6))
+
+				qty, onOrder := stock()
+				Expect(qty).To(BeNumerically("==", 16))
+				Expect(onOrder).To(BeNumerically("==", 14))
+				Expect(status()).To(Equal(types.PurchaseOrderStatusPartiallyReceived))
+
+				_, err = receive(14, 0, false)
+				Expect(err).To(BeNil())
+
+				qty, onOrder = stock()
+				Expect(qty).To(BeNumerically("==", 30))
+				Expect(onOrder).To(BeNumerically("==", 0))
+				Expect(status()).To(Equal(types.PurchaseOrderStatusReceived))
+			})
+
+			It("should stock an over delivery without taking more than was ordered off on order", func() {
+				_, err := receive(25, 0, false)
+				Expect(err).To(BeNil())
+
+				qty, onOrder := stock()
+				Expect(qty).To(BeNumerically("==", 35))
+				Expect(onOrder).To(BeNumerically("==", 0))
+				Expect(status()).To(Equal(types.PurchaseOrderStatusReceived))
+
+				// the order is closed now
+				_, err = receive(1, 0, false)
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+			})
+
+			It("should close a line short", func() {
+				_, err := receive(5, 0, true)
+				Expect(err).To(BeNil())
+
+				qty, onOrder := stock()
+				Expect(qty).To(BeNumerically("==", 15))
+				Expect(onOrder).To(BeNumerically("==", 0))
+				Expect(status()).To(Equal(types.PurchaseOrderStatusReceived))
+			})
+
+			It("should put the goods at a location", func() {
+				location, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+				Expect(err).To(BeNil())
+
+				_, err = repo.Create(ctx, types.NewReceipt{
+					PurchaseOrderID: order.ID,
+					LocationID:      utils.Ref(location.ID),
+					Lines:           []types.NewReceiptLine{{PurchaseOrderLineID: order.Lines[0].ID, QtyReceived: 4}},
+				})
+				Expect(err).To(BeNil())
+
+				level, exists, err := gr.StockLevels().Get(ctx, product.ID, location.ID)
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeTrue())
+				Expect(level.Qty).To(BeNumerically("==", 4))
+
+				qty, onOrder := stock()
+				Expect(qty).To(BeNumerically("==", 14))
+				Expect(onOrder).To(BeNumerically("==", 16))
+			})
+
+			It("should receive lot tracked products into their lot", func() {
+				// lot tracking can only be switched on with nothing on hand
+				_, err := gr.Products().Update(ctx, &types.UpdateProduct{ID: product.ID, Qty: utils.Ref(int64(0))})
+				Expect(err).To(BeNil())
+				_, err = gr.Products().Update(ctx, &types.UpdateProduct{ID: product.ID, LotTracked: utils.Ref(true)})
+				Expect(err).To(BeNil())
+
+				// a lot is required
+				_, err = receive(5, 0, false)
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				expiresAt := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+				receiveLot := func(qty int64, lot types.NewLot) (*types.Receipt, error) {
+					return repo.Create(ctx, types.NewReceipt{
+						PurchaseOrderID: order.ID,
+						Lines: []types.NewReceiptLine{{
+							PurchaseOrderLineID: order.Lines[0].ID, QtyReceived: qty, Lot: &lot,
+						}},
+					})
+				}
+
+				receipt, err := receiveLot(5, types.NewLot{LotNumber: "L-100", ExpiresAt: &expiresAt})
+				Expect(err).To(BeNil())
+				Expect(receipt.Lines[0].LotID).NotTo(BeNil())
+
+				// the same lot again adds to it
+				_, err = receiveLot(3, types.NewLot{LotNumber: "L-100"})
+				Expect(err).To(BeNil())
+
+				lot, exists, err := gr.Lots().Get(ctx, *receipt.Lines[0].LotID)
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeTrue())
+				Expect(lot.Qty).To(BeNumerically("==", 8))
+
+				// but not with different dates
+				later := expiresAt.Add(time.Hour)
+				_, err = receiveLot(1, types.NewLot{LotNumber: "L-100", ExpiresAt: &later})
+				Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+				qty, onOrder := stock()
+				Expect(qty).To(BeNumerically("==", 8))
+				Expect(onOrder).To(BeNumerically("==", 12))
+			})
+
+			It("should find the receipts for an order", func() {
+				_, err := receive(5, 0, false)
+				Expect(err).To(BeNil())
+
+				receipts, count, err := repo.Find(ctx, &repos.ReceiptsFind{PurchaseOrderIDs: []int64{order.ID}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(receipts[0].Lines).To(HaveLen(1))
+
+				receipt, exists, err := repo.Get(ctx, receipts[0].ID)
+				Expect(err).To(BeNil())
+				Expect(exists).To(BeTrue())
+				Expect(receipt.Lines[0].QtyReceived).To(BeNumerically("==", 5))
+			})
+		})
+	})
+})
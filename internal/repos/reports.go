@@ -0,0 +1,659 @@
+package repos
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type LowStockFind struct {
+	// LocationIDs - only report on these locations, product totals are left out when set
+	LocationIDs []int64
+}
+
+type ExpiringFind struct {
+	// Before - lots expiring at or before this time are reported
+	Before     time.Time
+	ProductIDs []int64
+}
+
+type ValuationFind struct {
+	// AsOf - stock is valued as it stood at this time
+	AsOf    time.Time
+	GroupBy types.ValuationGroupBy
+}
+
+type UsageFind struct {
+	// Since - usage is counted from this time until now
+	Since time.Time
+}
+
+type ABCFind struct {
+	// Since - usage is counted from this time until now
+	Since time.Time
+	By    types.ABCBy
+	// A, B - the share of everything used that A and then B products make up, 0.8 and 0.95
+	// when they're left out
+	A float64
+	B float64
+}
+
+type AgingFind struct {
+	ProductIDs []int64
+	// LocationIDs - only report on these locations, stock outside of them is left out when set
+	LocationIDs []int64
+}
+
+type SlowMovingFind struct {
+	// Since - usage is counted from this time until now
+	Since time.Time
+	// DaysOfSupply - products with more days of supply on hand than this are slow, 180 when
+	// it's left out
+	DaysOfSupply float64
+}
+
+//go:generate mockgen -source=./reports.go -destination=./mocks/Reports.go -package=mock_repos Reports
+type Reports interface {
+	LowStock(ctx context.Context, opts *LowStockFind) ([]*types.LowStockItem, error)
+	LowStockTx(ctx context.Context, tx *xorm.Session, opts *LowStockFind) ([]*types.LowStockItem, error)
+	Expiring(ctx context.Context, opts *ExpiringFind) ([]*types.ExpiringLot, error)
+	ExpiringTx(ctx context.Context, tx *xorm.Session, opts *ExpiringFind) ([]*types.ExpiringLot, error)
+	Valuation(ctx context.Context, opts *ValuationFind) ([]*types.ValuationLine, error)
+	ValuationTx(ctx context.Context, tx *xorm.Session, opts *ValuationFind) ([]*types.ValuationLine, error)
+	ABC(ctx context.Context, opts *ABCFind) ([]*types.ABCLine, error)
+	ABCTx(ctx context.Context, tx *xorm.Session, opts *ABCFind) ([]*types.ABCLine, error)
+	Turnover(ctx context.Context, opts *UsageFind) ([]*types.ProductUsage, error)
+	TurnoverTx(ctx context.Context, tx *xorm.Session, opts *UsageFind) ([]*types.ProductUsage, error)
+	DeadStock(ctx context.Context, opts *UsageFind) ([]*types.ProductUsage, error)
+	DeadStockTx(ctx context.Context, tx *xorm.Session, opts *UsageFind) ([]*types.ProductUsage, error)
+	SlowMoving(ctx context.Context, opts *SlowMovingFind) ([]*types.ProductUsage, error)
+	SlowMovingTx(ctx context.Context, tx *xorm.Session, opts *SlowMovingFind) ([]*types.ProductUsage, error)
+	Aging(ctx context.Context, opts *AgingFind) ([]*types.AgingLine, error)
+	AgingTx(ctx context.Context, tx *xorm.Session, opts *AgingFind) ([]*types.AgingLine, error)
+}
+
+func NewReports(db *xorm.Engine) Reports {
+	return &reportsRepo{db}
+}
+
+type reportsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *reportsRepo) LowStock(ctx context.Context, opts *LowStockFind) ([]*types.LowStockItem, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.LowStockTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.LowStockItem), nil
+}
+
+// LowStockTx - every product, and product at a location, sitting at or below a non zero
+// reorder point, furthest below first
+func (r *reportsRepo) LowStockTx(ctx context.Context, tx *xorm.Session, opts *LowStockFind) ([]*types.LowStockItem, error) {
+	if opts == nil {
+		opts = &LowStockFind{}
+	}
+
+	locationSQL := `
+		SELECT p.id AS product_id, p.sku, p.name, l.id AS location_id, l.code AS location_code,
+			s.qty, s.reorder_point, s.reorder_qty, s.reorder_point - s.qty AS shortfall
+		FROM stock_levels s
+		JOIN products p ON p.id = s.product_id
+		JOIN locations l ON l.id = s.location_id
+		WHERE s.reorder_point > 0 AND s.qty <= s.reorder_point`
+
+	args := []interface{}{}
+	query := ""
+	if len(opts.LocationIDs) > 0 {
+		placeholders := make([]string, len(opts.LocationIDs))
+		for i, id := range opts.LocationIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		query = locationSQL + " AND s.location_id IN (" + strings.Join(placeholders, ",") + ")"
+	} else {
+		query = `
+			SELECT p.id AS product_id, p.sku, p.name, NULL AS location_id, NULL AS location_code,
+				p.qty, p.reorder_point, p.reorder_qty, p.reorder_point - p.qty AS shortfall
+			FROM products p
+			WHERE p.reorder_point > 0 AND p.qty <= p.reorder_point
+			UNION ALL` + locationSQL
+	}
+
+	objs := []*types.LowStockItem{}
+	if err := tx.SQL(query+" ORDER BY shortfall DESC, product_id, location_id NULLS FIRST", args...).Find(&objs); err != nil {
+		return nil, normalizeErr("products", err)
+	}
+
+	return objs, nil
+}
+
+func (r *reportsRepo) Expiring(ctx context.Context, opts *ExpiringFind) ([]*types.ExpiringLot, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ExpiringTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.ExpiringLot), nil
+}
+
+// ExpiringTx - every lot with stock on hand that expires by opts.Before, including those
+// that already have, soonest first
+func (r *reportsRepo) ExpiringTx(ctx context.Context, tx *xorm.Session, opts *ExpiringFind) ([]*types.ExpiringLot, error) {
+	if opts == nil {
+		opts = &ExpiringFind{Before: time.Now()}
+	}
+
+	query := `
+		SELECT l.id AS lot_id, l.lot_number, p.id AS product_id, p.sku, p.name, l.qty, l.expires_at
+		FROM lots l
+		JOIN products p ON p.id = l.product_id
+		WHERE l.qty > 0 AND l.expires_at <= ?`
+
+	args := []interface{}{opts.Before}
+	if len(opts.ProductIDs) > 0 {
+		placeholders := make([]string, len(opts.ProductIDs))
+		for i, id := range opts.ProductIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		query += " AND l.product_id IN (" + strings.Join(placeholders, ",") + ")"
+	}
+
+	objs := []*types.ExpiringLot{}
+	if err := tx.SQL(query+" ORDER BY l.expires_at, l.id", args...).Find(&objs); err != nil {
+		return nil, normalizeErr("lots", err)
+	}
+
+	now := time.Now()
+	for _, obj := range objs {
+		obj.DaysLeft = int64(math.Floor(obj.ExpiresAt.Sub(now).Hours() / 24))
+	}
+
+	return objs, nil
+}
+
+func (r *reportsRepo) Valuation(ctx context.Context, opts *ValuationFind) ([]*types.ValuationLine, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ValuationTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.ValuationLine), nil
+}
+
+// ValuationTx - the qty and value of stock on hand as of a point in time by product, category or
+// location, products by default. A product's value is split across where it's held by qty, its
+// cost layers don't know about locations. Products are grouped by the category they have now.
+func (r *reportsRepo) ValuationTx(ctx context.Context, tx *xorm.Session, opts *ValuationFind) ([]*types.ValuationLine, error) {
+	if opts == nil {
+		opts = &ValuationFind{AsOf: time.Now()}
+	}
+
+	held := []*struct {
+		ProductID    int64   `xorm:"product_id"`
+		Sku          string  `xorm:"sku"`
+		Name         string  `xorm:"name"`
+		Category     *string `xorm:"category"`
+		LocationID   *int64  `xorm:"location_id"`
+		LocationCode *string `xorm:"location_code"`
+		Qty          int64   `xorm:"qty"`
+		Value        int64   `xorm:"value"`
+	}{}
+	if err := tx.SQL(`
+		SELECT e.product_id, p.sku, p.name, p.category, e.location_id, l.code AS location_code,
+			SUM(e.qty) AS qty, SUM(e.value) AS value
+		FROM valuation_entries e
+		JOIN products p ON p.id = e.product_id
+		LEFT JOIN locations l ON l.id = e.location_id
+		WHERE e.created_at <= ?
+		GROUP BY e.product_id, p.sku, p.name, p.category, e.location_id, l.code
+		ORDER BY p.sku, e.product_id, e.location_id NULLS FIRST`, opts.AsOf,
+	).Find(&held); err != nil {
+		return nil, normalizeErr("valuation_entries", err)
+	}
+
+	// split each product's value across where it's held, whatever doesn't divide evenly goes
+	// with the most qty and value left with nothing on hand stays outside of any location
+	values := make([]int64, len(held))
+	for start := 0; start < len(held); {
+		end, qty, value := start, int64(0), int64(0)
+		for ; end < len(held) && held[end].ProductID == held[start].ProductID; end++ {
+			qty += held[end].Qty
+			value += held[end].Value
+		}
+
+		if qty == 0 {
+			values[start] = value
+		} else {
+			most, split := start, int64(0)
+			for i := start; i < end; i++ {
+				values[i] = value * held[i].Qty / qty
+				split += values[i]
+				if held[i].Qty > held[most].Qty {
+					most = i
+				}
+			}
+			values[most] += value - split
+		}
+
+		start = end
+	}
+
+	objs := []*types.ValuationLine{}
+	lines := map[string]*types.ValuationLine{}
+	for i, h := range held {
+		var key string
+		line := &types.ValuationLine{}
+		switch opts.GroupBy {
+		case types.ValuationGroupByCategory:
+			key = "-"
+			if h.Category != nil {
+				key = "c" + *h.Category
+			}
+			line.Category = h.Category
+		case types.ValuationGroupByLocation:
+			key = "-"
+			if h.LocationID != nil {
+				key = "l" + strconv.FormatInt(*h.LocationID, 10)
+			}
+			line.LocationID, line.LocationCode = h.LocationID, h.LocationCode
+		default:
+			key = strconv.FormatInt(h.ProductID, 10)
+			line.ProductID, line.Sku, line.Name, line.Category = &h.ProductID, &h.Sku, &h.Name, h.Category
+		}
+
+		if existing, ok := lines[key]; ok {
+			line = existing
+		} else {
+			lines[key] = line
+			objs = append(objs, line)
+		}
+
+		line.Qty += h.Qty
+		line.Value += values[i]
+	}
+
+	res := []*types.ValuationLine{}
+	for _, obj := range objs {
+		if obj.Qty != 0 || obj.Value != 0 {
+			res = append(res, obj)
+		}
+	}
+
+	switch opts.GroupBy {
+	case types.ValuationGroupByCategory:
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].Category != nil && (res[j].Category == nil || *res[i].Category < *res[j].Category)
+		})
+	case types.ValuationGroupByLocation:
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[j].LocationCode != nil && (res[i].LocationCode == nil || *res[i].LocationCode < *res[j].LocationCode)
+		})
+	}
+
+	return res, nil
+}
+
+func (r *reportsRepo) ABC(ctx context.Context, opts *ABCFind) ([]*types.ABCLine, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ABCTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.ABCLine), nil
+}
+
+// ABCTx - every product ranked by the value, or qty with velocity, of what was used since a point
+// in time, a year ago by default. Products are A until the ones ranked above them make up the A
+// share of everything used, then B until they make up the B share and C after that. Products that
+// weren't used are always C.
+func (r *reportsRepo) ABCTx(ctx context.Context, tx *xorm.Session, opts *ABCFind) ([]*types.ABCLine, error) {
+	if opts == nil {
+		opts = &ABCFind{Since: time.Now().AddDate(-1, 0, 0)}
+	}
+
+	a, b := opts.A, opts.B
+	if a <= 0 {
+		a = 0.8
+	}
+	if b <= 0 {
+		b = 0.95
+	}
+
+	usage, err := usageTx(tx, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	used := func(u *types.ProductUsage) int64 {
+		if opts.By == types.ABCByVelocity {
+			return u.UsedQty
+		}
+		return u.UsedValue
+	}
+
+	var total int64
+	for _, u := range usage {
+		total += used(u)
+	}
+
+	sort.SliceStable(usage, func(i, j int) bool {
+		return used(usage[i]) > used(usage[j])
+	})
+
+	objs := make([]*types.ABCLine, len(usage))
+	var cumulative float64
+	for i, u := range usage {
+		obj := &types.ABCLine{
+			ProductID: u.ProductID,
+			Sku:       u.Sku,
+			Name:      u.Name,
+			Category:  u.Category,
+			UsedQty:   u.UsedQty,
+			UsedValue: u.UsedValue,
+			Class:     types.ABCClassC,
+		}
+
+		if total > 0 && used(u) > 0 {
+			switch {
+			case cumulative < a:
+				obj.Class = types.ABCClassA
+			case cumulative < b:
+				obj.Class = types.ABCClassB
+			}
+
+			obj.Share = float64(used(u)) / float64(total)
+			cumulative += obj.Share
+		}
+		obj.CumulativeShare = cumulative
+
+		objs[i] = obj
+	}
+
+	return objs, nil
+}
+
+func (r *reportsRepo) Turnover(ctx context.Context, opts *UsageFind) ([]*types.ProductUsage, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.TurnoverTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.ProductUsage), nil
+}
+
+// TurnoverTx - every product's turnover and days of supply over the time since a point in time,
+// a year ago by default
+func (r *reportsRepo) TurnoverTx(ctx context.Context, tx *xorm.Session, opts *UsageFind) ([]*types.ProductUsage, error) {
+	if opts == nil {
+		opts = &UsageFind{Since: time.Now().AddDate(-1, 0, 0)}
+	}
+
+	return usageTx(tx, opts.Since)
+}
+
+func (r *reportsRepo) DeadStock(ctx context.Context, opts *UsageFind) ([]*types.ProductUsage, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.DeadStockTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.ProductUsage), nil
+}
+
+// DeadStockTx - products with stock on hand that none of was used since a point in time, 180 days
+// ago by default, most value first
+func (r *reportsRepo) DeadStockTx(ctx context.Context, tx *xorm.Session, opts *UsageFind) ([]*types.ProductUsage, error) {
+	if opts == nil {
+		opts = &UsageFind{Since: time.Now().AddDate(0, 0, -180)}
+	}
+
+	usage, err := usageTx(tx, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := []*types.ProductUsage{}
+	for _, u := range usage {
+		if u.Qty > 0 && u.UsedQty == 0 {
+			objs = append(objs, u)
+		}
+	}
+
+	sort.SliceStable(objs, func(i, j int) bool {
+		return objs[i].Value > objs[j].Value
+	})
+
+	return objs, nil
+}
+
+func (r *reportsRepo) SlowMoving(ctx context.Context, opts *SlowMovingFind) ([]*types.ProductUsage, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.SlowMovingTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.ProductUsage), nil
+}
+
+// SlowMovingTx - products that were used since a point in time, 90 days ago by default, but have
+// more days of supply on hand than they should, most days first. Products that weren't used at
+// all are dead stock.
+func (r *reportsRepo) SlowMovingTx(ctx context.Context, tx *xorm.Session, opts *SlowMovingFind) ([]*types.ProductUsage, error) {
+	if opts == nil {
+		opts = &SlowMovingFind{Since: time.Now().AddDate(0, 0, -90)}
+	}
+
+	days := opts.DaysOfSupply
+	if days <= 0 {
+		days = 180
+	}
+
+	usage, err := usageTx(tx, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := []*types.ProductUsage{}
+	for _, u := range usage {
+		if u.DaysOfSupply != nil && *u.DaysOfSupply > days {
+			objs = append(objs, u)
+		}
+	}
+
+	sort.SliceStable(objs, func(i, j int) bool {
+		return *objs[i].DaysOfSupply > *objs[j].DaysOfSupply
+	})
+
+	return objs, nil
+}
+
+// usageTx - every product's stock now and when the window starting at since opened, and what of
+// it was used in between, by sku. It's all added up from the valuation entries in one pass.
+func usageTx(tx *xorm.Session, since time.Time) ([]*types.ProductUsage, error) {
+	objs := []*types.ProductUsage{}
+	if err := tx.SQL(`
+		SELECT p.id AS product_id, p.sku, p.name, p.category,
+			COALESCE(SUM(e.qty), 0) AS qty,
+			COALESCE(SUM(e.value), 0) AS value,
+			COALESCE(SUM(e.qty) FILTER (WHERE e.created_at <= ?), 0) AS opening_qty,
+			COALESCE(SUM(e.value) FILTER (WHERE e.created_at <= ?), 0) AS opening_value,
+			COALESCE(-SUM(e.qty) FILTER (WHERE e.created_at > ? AND e.qty < 0 AND NOT e.move), 0) AS used_qty,
+			COALESCE(-SUM(e.value) FILTER (WHERE e.created_at > ? AND e.qty < 0 AND NOT e.move), 0) AS used_value,
+			MAX(e.created_at) FILTER (WHERE e.qty < 0 AND NOT e.move) AS last_used_at
+		FROM products p
+		LEFT JOIN valuation_entries e ON e.product_id = p.id
+		GROUP BY p.id, p.sku, p.name, p.category
+		ORDER BY p.sku`, since, since, since, since,
+	).Find(&objs); err != nil {
+		return nil, normalizeErr("valuation_entries", err)
+	}
+
+	days := time.Since(since).Hours() / 24
+	for _, obj := range objs {
+		if average := float64(obj.OpeningValue+obj.Value) / 2; average > 0 {
+			turnover := math.Round(float64(obj.UsedValue)/average*100) / 100
+			obj.Turnover = &turnover
+		}
+		if obj.UsedQty > 0 && days > 0 {
+			supply := math.Round(float64(obj.Qty)/(float64(obj.UsedQty)/days)*100) / 100
+			obj.DaysOfSupply = &supply
+		}
+	}
+
+	return objs, nil
+}
+
+func (r *reportsRepo) Aging(ctx context.Context, opts *AgingFind) ([]*types.AgingLine, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.AgingTx(ctx, tx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.AgingLine), nil
+}
+
+// AgingTx - stock on hand by product and location, by sku then location code with stock outside
+// of them first, bucketed by how long ago it was received. A product's remaining cost layers date
+// its stock, they don't know about locations so every location holds the same mix of ages. Stock
+// no layer accounts for is counted as the oldest.
+func (r *reportsRepo) AgingTx(ctx context.Context, tx *xorm.Session, opts *AgingFind) ([]*types.AgingLine, error) {
+	if opts == nil {
+		opts = &AgingFind{}
+	}
+
+	filter := func(column string, ids []int64) string {
+		if len(ids) == 0 {
+			return ""
+		}
+		return " AND " + column + " IN (" + strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",") + ")"
+	}
+
+	now := time.Now()
+	ages := []*struct {
+		ProductID        int64      `xorm:"product_id"`
+		Qty              int64      `xorm:"qty"`
+		UpTo30           int64      `xorm:"up_to_30"`
+		UpTo90           int64      `xorm:"up_to_90"`
+		UpTo180          int64      `xorm:"up_to_180"`
+		Over180          int64      `xorm:"over_180"`
+		OldestReceivedAt *time.Time `xorm:"oldest_received_at"`
+	}{}
+	if err := tx.SQL(`
+		SELECT c.product_id, p.qty,
+			COALESCE(SUM(c.remaining) FILTER (WHERE c.created_at > ?), 0) AS up_to_30,
+			COALESCE(SUM(c.remaining) FILTER (WHERE c.created_at <= ? AND c.created_at > ?), 0) AS up_to_90,
+			COALESCE(SUM(c.remaining) FILTER (WHERE c.created_at <= ? AND c.created_at > ?), 0) AS up_to_180,
+			COALESCE(SUM(c.remaining) FILTER (WHERE c.created_at <= ?), 0) AS over_180,
+			MIN(c.created_at) AS oldest_received_at
+		FROM cost_layers c
+		JOIN products p ON p.id = c.product_id
+		WHERE c.remaining > 0`+filter("c.product_id", opts.ProductIDs)+`
+		GROUP BY c.product_id, p.qty`,
+		append([]interface{}{
+			now.AddDate(0, 0, -30), now.AddDate(0, 0, -30), now.AddDate(0, 0, -90),
+			now.AddDate(0, 0, -90), now.AddDate(0, 0, -180), now.AddDate(0, 0, -180),
+		}, utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)...,
+	).Find(&ages); err != nil {
+		return nil, normalizeErr("cost_layers", err)
+	}
+
+	ids := utils.Int64ArrToInterfaceArr(opts.ProductIDs...)
+	args := append([]interface{}{}, ids...)
+	args = append(append(args, ids...), utils.Int64ArrToInterfaceArr(opts.LocationIDs...)...)
+
+	held := []*types.AgingLine{}
+	if err := tx.SQL(`
+		SELECT * FROM (
+			SELECT p.id AS product_id, p.sku, p.name, NULL::BIGINT AS location_id, NULL::TEXT AS location_code,
+				p.qty - COALESCE(SUM(s.qty), 0) AS qty
+			FROM products p
+			LEFT JOIN stock_levels s ON s.product_id = p.id
+			WHERE TRUE`+filter("p.id", opts.ProductIDs)+`
+			GROUP BY p.id, p.sku, p.name
+			UNION ALL
+			SELECT p.id, p.sku, p.name, l.id, l.code, s.qty
+			FROM stock_levels s
+			JOIN products p ON p.id = s.product_id
+			JOIN locations l ON l.id = s.location_id
+			WHERE TRUE`+filter("p.id", opts.ProductIDs)+filter("l.id", opts.LocationIDs)+`
+		) held
+		WHERE qty > 0
+		ORDER BY sku, location_code NULLS FIRST`, args...,
+	).Find(&held); err != nil {
+		return nil, normalizeErr("stock_levels", err)
+	}
+
+	byProduct := map[int64]int{}
+	for i, age := range ages {
+		byProduct[age.ProductID] = i
+	}
+
+	objs := []*types.AgingLine{}
+	for _, line := range held {
+		if len(opts.LocationIDs) > 0 && line.LocationID == nil {
+			continue
+		}
+
+		i, exists := byProduct[line.ProductID]
+		if !exists {
+			line.Over180 = line.Qty
+			objs = append(objs, line)
+			continue
+		}
+
+		// the line gets its share of the product's stock up to each age, so what it has in
+		// each bucket always adds up to its qty
+		age := ages[i]
+		buckets := []int64{age.Over180, age.UpTo180, age.UpTo90, age.UpTo30}
+		var total int64
+		for _, qty := range buckets {
+			total += qty
+		}
+		if unlayered := age.Qty - total; unlayered > 0 {
+			buckets[0] += unlayered
+			total += unlayered
+		}
+
+		shares := make([]int64, len(buckets))
+		var cumulative, allotted int64
+		for b, qty := range buckets {
+			cumulative += qty
+			share := line.Qty * cumulative / total
+			shares[b] = share - allotted
+			allotted = share
+		}
+		line.Over180, line.UpTo180, line.UpTo90, line.UpTo30 = shares[0], shares[1], shares[2], shares[3]
+		line.OldestReceivedAt = age.OldestReceivedAt
+
+		objs = append(objs, line)
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,348 @@
+package repos_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Reports", func() {
+
+	var (
+		repo     repos.Reports
+		low      *types.Product
+		fine     *types.Product
+		location *types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_levels", "locations", "products")
+
+		repo = gr.Reports()
+
+		var err error
+		low, err = gr.Products().Create(ctx, types.NewProduct{Name: "low", Sku: "low", Qty: 2, ReorderPoint: 5, ReorderQty: 20})
+		Expect(err).To(BeNil())
+
+		fine, err = gr.Products().Create(ctx, types.NewProduct{Name: "fine", Sku: "fine", Qty: 50, ReorderPoint: 5})
+		Expect(err).To(BeNil())
+
+		_, err = gr.Products().Create(ctx, types.NewProduct{Name: "untracked", Sku: "untracked", Qty: 1})
+		Expect(err).To(BeNil())
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{
+			ProductID: fine.ID, LocationID: location.ID, Qty: utils.Ref(int64(1)), ReorderPoint: utils.Ref(int64(4)),
+		})
+		Expect(err).To(BeNil())
+	})
+
+	Context("LowStock(Tx)", func() {
+		It("should return products and locations at or below their reorder point", func() {
+			items, err := repo.LowStock(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(items).To(HaveLen(2))
+
+			Expect(items[0].ProductID).To(Equal(fine.ID))
+			Expect(*items[0].LocationCode).To(Equal("MAIN"))
+			Expect(items[0].Shortfall).To(BeNumerically("==", 3))
+
+			Expect(items[1].ProductID).To(Equal(low.ID))
+			Expect(items[1].LocationID).To(BeNil())
+			Expect(items[1].ReorderQty).To(BeNumerically("==", 20))
+		})
+
+		It("should only return location rows when filtering by location", func() {
+			items, err := repo.LowStock(ctx, &repos.LowStockFind{LocationIDs: []int64{location.ID}})
+			Expect(err).To(BeNil())
+			Expect(items).To(HaveLen(1))
+			Expect(*items[0].LocationID).To(Equal(location.ID))
+		})
+	})
+
+	Context("Valuation(Tx)", func() {
+		var (
+			widget *types.Product
+			gadget *types.Product
+			bolt   *types.Product
+			opened time.Time
+		)
+
+		BeforeEach(func() {
+			clearDatabase("products")
+
+			var err error
+			widget, err = gr.Products().Create(ctx, types.NewProduct{
+				Name: "widget", Sku: "widget", Qty: 10, Category: utils.Ref("widgets"), UnitCost: utils.Ref(int64(100)),
+			})
+			Expect(err).To(BeNil())
+
+			gadget, err = gr.Products().Create(ctx, types.NewProduct{
+				Name: "gadget", Sku: "gadget", Qty: 10, Category: utils.Ref("gadgets"), CostMethod: types.CostMethodAverage,
+				UnitCost: utils.Ref(int64(100)),
+			})
+			Expect(err).To(BeNil())
+
+			bolt, err = gr.Products().Create(ctx, types.NewProduct{
+				Name: "bolt", Sku: "bolt", Qty: 4, CostMethod: types.CostMethodStandard, StandardCost: 300,
+			})
+			Expect(err).To(BeNil())
+
+			opened = time.Now()
+
+			// widget: 10 @ 1.00 unlocated then 10 @ 2.00 at MAIN, 8 of the cheapest go out of MAIN
+			_, err = gr.StockLevels().Receive(ctx, widget.ID, location.ID, 10, 200)
+			Expect(err).To(BeNil())
+			_, err = gr.StockLevels().Adjust(ctx, widget.ID, location.ID, -8, nil)
+			Expect(err).To(BeNil())
+
+			// gadget: 10 @ 1.00 then 10 @ 2.00 averages out at 1.50
+			_, err = gr.Products().Adjust(ctx, gadget.ID, types.ProductAdjustment{Qty: 10, UnitCost: utils.Ref(int64(200))})
+			Expect(err).To(BeNil())
+			_, err = gr.Products().Adjust(ctx, gadget.ID, types.ProductAdjustment{Qty: -15})
+			Expect(err).To(BeNil())
+
+			// bolt: revalued from 3.00 to 2.50
+			_, err = gr.Products().Update(ctx, &types.UpdateProduct{ID: bolt.ID, StandardCost: utils.Ref(int64(250))})
+			Expect(err).To(BeNil())
+		})
+
+		It("should value each product by its cost method", func() {
+			lines, err := repo.Valuation(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(3))
+
+			Expect(*lines[0].ProductID).To(Equal(bolt.ID))
+			Expect(lines[0].Qty).To(BeNumerically("==", 4))
+			Expect(lines[0].Value).To(BeNumerically("==", 1000))
+
+			Expect(*lines[1].ProductID).To(Equal(gadget.ID))
+			Expect(lines[1].Qty).To(BeNumerically("==", 5))
+			Expect(lines[1].Value).To(BeNumerically("==", 750))
+
+			Expect(*lines[2].ProductID).To(Equal(widget.ID))
+			Expect(lines[2].Qty).To(BeNumerically("==", 12))
+			Expect(lines[2].Value).To(BeNumerically("==", 2200))
+		})
+
+		It("should value stock as it stood at a point in time", func() {
+			lines, err := repo.Valuation(ctx, &repos.ValuationFind{AsOf: opened})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0].Value).To(BeNumerically("==", 1200))
+			Expect(lines[1].Value).To(BeNumerically("==", 1000))
+			Expect(lines[2].Value).To(BeNumerically("==", 1000))
+		})
+
+		It("should group by category", func() {
+			lines, err := repo.Valuation(ctx, &repos.ValuationFind{AsOf: time.Now(), GroupBy: types.ValuationGroupByCategory})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(3))
+			Expect(*lines[0].Category).To(Equal("gadgets"))
+			Expect(*lines[1].Category).To(Equal("widgets"))
+			Expect(lines[2].Category).To(BeNil())
+			Expect(lines[2].Value).To(BeNumerically("==", 1000))
+		})
+
+		It("should split a product's value across where it's held", func() {
+			lines, err := repo.Valuation(ctx, &repos.ValuationFind{AsOf: time.Now(), GroupBy: types.ValuationGroupByLocation})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(2))
+
+			Expect(lines[0].LocationID).To(BeNil())
+			Expect(lines[0].Qty).To(BeNumerically("==", 19))
+			Expect(lines[0].Value).To(BeNumerically("==", 1000+750+1834))
+
+			Expect(*lines[1].LocationCode).To(Equal("MAIN"))
+			Expect(lines[1].Qty).To(BeNumerically("==", 2))
+			Expect(lines[1].Value).To(BeNumerically("==", 366))
+		})
+
+		It("should keep products sharing a sku apart when splitting by location", func() {
+			// 2 @ 1.00 unlocated and 5 @ 1.00 at MAIN under the widget's sku
+			other, err := gr.Products().Create(ctx, types.NewProduct{
+				Name: "widget v2", Sku: "widget", Qty: 2, UnitCost: utils.Ref(int64(100)),
+			})
+			Expect(err).To(BeNil())
+			_, err = gr.StockLevels().Receive(ctx, other.ID, location.ID, 5, 100)
+			Expect(err).To(BeNil())
+
+			lines, err := repo.Valuation(ctx, &repos.ValuationFind{AsOf: time.Now(), GroupBy: types.ValuationGroupByLocation})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0].Value).To(BeNumerically("==", 1000+750+1834+200))
+			Expect(lines[1].Value).To(BeNumerically("==", 366+500))
+		})
+
+		It("should leave the value alone when stock moves between locations", func() {
+			bin, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Bin", Code: "BIN"})
+			Expect(err).To(BeNil())
+
+			_, err = gr.Putaway().Move(ctx, types.MoveStock{ProductID: widget.ID, FromLocationID: location.ID, ToLocationID: bin.ID, Qty: 2})
+			Expect(err).To(BeNil())
+
+			lines, err := repo.Valuation(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(lines[2].Qty).To(BeNumerically("==", 12))
+			Expect(lines[2].Value).To(BeNumerically("==", 2200))
+		})
+	})
+
+	Context("ABC, Turnover, DeadStock and SlowMoving(Tx)", func() {
+		var (
+			fast  *types.Product
+			slow  *types.Product
+			idle  *types.Product
+			since time.Time
+		)
+
+		BeforeEach(func() {
+			clearDatabase("products")
+
+			since = time.Now().Add(-24 * time.Hour)
+
+			var err error
+			fast, err = gr.Products().Create(ctx, types.NewProduct{Name: "fast", Sku: "fast", Qty: 10, UnitCost: utils.Ref(int64(100))})
+			Expect(err).To(BeNil())
+			_, err = gr.Products().Adjust(ctx, fast.ID, types.ProductAdjustment{Qty: -8})
+			Expect(err).To(BeNil())
+
+			slow, err = gr.Products().Create(ctx, types.NewProduct{Name: "slow", Sku: "slow", Qty: 100, UnitCost: utils.Ref(int64(10))})
+			Expect(err).To(BeNil())
+			_, err = gr.Products().Adjust(ctx, slow.ID, types.ProductAdjustment{Qty: -1})
+			Expect(err).To(BeNil())
+
+			// idle only ever moves between locations
+			idle, err = gr.Products().Create(ctx, types.NewProduct{Name: "idle", Sku: "idle", Qty: 5, UnitCost: utils.Ref(int64(50))})
+			Expect(err).To(BeNil())
+			_, err = gr.StockLevels().Receive(ctx, idle.ID, location.ID, 5, 50)
+			Expect(err).To(BeNil())
+			bin, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Bin", Code: "BIN"})
+			Expect(err).To(BeNil())
+			_, err = gr.Putaway().Move(ctx, types.MoveStock{ProductID: idle.ID, FromLocationID: location.ID, ToLocationID: bin.ID, Qty: 5})
+			Expect(err).To(BeNil())
+		})
+
+		It("should rank products by the value of what was used", func() {
+			lines, err := repo.ABC(ctx, &repos.ABCFind{Since: since, By: types.ABCByValue})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(3))
+
+			Expect(lines[0].ProductID).To(Equal(fast.ID))
+			Expect(lines[0].UsedValue).To(BeNumerically("==", 800))
+			Expect(lines[0].Class).To(Equal(types.ABCClassA))
+
+			Expect(lines[1].ProductID).To(Equal(slow.ID))
+			Expect(lines[1].Class).To(Equal(types.ABCClassC))
+
+			Expect(lines[2].ProductID).To(Equal(idle.ID))
+			Expect(lines[2].Share).To(BeZero())
+			Expect(lines[2].Class).To(Equal(types.ABCClassC))
+		})
+
+		It("should rank products by the qty of what was used", func() {
+			lines, err := repo.ABC(ctx, &repos.ABCFind{Since: since, By: types.ABCByVelocity})
+			Expect(err).To(BeNil())
+			Expect(lines[0].Class).To(Equal(types.ABCClassA))
+			Expect(lines[1].ProductID).To(Equal(slow.ID))
+			Expect(lines[1].Class).To(Equal(types.ABCClassB))
+			Expect(lines[1].CumulativeShare).To(BeNumerically("~", 1, 0.0001))
+		})
+
+		It("should work out turnover and days of supply", func() {
+			usage, err := repo.Turnover(ctx, &repos.UsageFind{Since: since})
+			Expect(err).To(BeNil())
+			Expect(usage).To(HaveLen(3))
+
+			Expect(usage[0].ProductID).To(Equal(fast.ID))
+			Expect(usage[0].UsedQty).To(BeNumerically("==", 8))
+			Expect(usage[0].Value).To(BeNumerically("==", 200))
+			Expect(*usage[0].Turnover).To(Equal(8.0))
+			Expect(*usage[0].DaysOfSupply).To(Equal(0.25))
+
+			Expect(usage[1].ProductID).To(Equal(idle.ID))
+			Expect(usage[1].UsedQty).To(BeZero())
+			Expect(usage[1].DaysOfSupply).To(BeNil())
+
+			Expect(usage[2].ProductID).To(Equal(slow.ID))
+			Expect(*usage[2].DaysOfSupply).To(Equal(99.0))
+		})
+
+		It("should list stock that wasn't used", func() {
+			usage, err := repo.DeadStock(ctx, &repos.UsageFind{Since: since})
+			Expect(err).To(BeNil())
+			Expect(usage).To(HaveLen(1))
+			Expect(usage[0].ProductID).To(Equal(idle.ID))
+			Expect(usage[0].Qty).To(BeNumerically("==", 10))
+			Expect(usage[0].LastUsedAt).To(BeNil())
+		})
+
+		It("should list stock that's used too slowly", func() {
+			usage, err := repo.SlowMoving(ctx, &repos.SlowMovingFind{Since: since, DaysOfSupply: 30})
+			Expect(err).To(BeNil())
+			Expect(usage).To(HaveLen(1))
+			Expect(usage[0].ProductID).To(Equal(slow.ID))
+		})
+	})
+
+	Context("Aging(Tx)", func() {
+		var aged *types.Product
+
+		BeforeEach(func() {
+			clearDatabase("products")
+
+			var err error
+			aged, err = gr.Products().Create(ctx, types.NewProduct{Name: "aged", Sku: "aged", Qty: 10, UnitCost: utils.Ref(int64(100))})
+			Expect(err).To(BeNil())
+			_, err = gr.DB().Exec("UPDATE cost_layers SET created_at = ? WHERE product_id = ?", time.Now().AddDate(0, 0, -200), aged.ID)
+			Expect(err).To(BeNil())
+
+			_, err = gr.StockLevels().Receive(ctx, aged.ID, location.ID, 10, 100)
+			Expect(err).To(BeNil())
+		})
+
+		It("should bucket stock by product and location by when it was received", func() {
+			lines, err := repo.Aging(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(2))
+
+			Expect(lines[0].LocationID).To(BeNil())
+			Expect(lines[0].Qty).To(BeNumerically("==", 10))
+			Expect(lines[0].UpTo30).To(BeNumerically("==", 5))
+			Expect(lines[0].Over180).To(BeNumerically("==", 5))
+			Expect(*lines[0].OldestReceivedAt).To(BeTemporally("~", time.Now().AddDate(0, 0, -200), time.Minute))
+
+			Expect(*lines[1].LocationCode).To(Equal("MAIN"))
+			Expect(lines[1].Qty).To(BeNumerically("==", 10))
+			Expect(lines[1].UpTo30 + lines[1].UpTo90 + lines[1].UpTo180 + lines[1].Over180).To(BeNumerically("==", 10))
+		})
+
+		It("should count stock no cost layer accounts for as the oldest", func() {
+			partial, err := gr.Products().Create(ctx, types.NewProduct{Name: "partial", Sku: "partial", Qty: 10, UnitCost: utils.Ref(int64(100))})
+			Expect(err).To(BeNil())
+			// 4 more on hand than its layers know about, like stock from before they were kept
+			_, err = gr.DB().Exec("UPDATE products SET qty = qty + 4 WHERE id = ?", partial.ID)
+			Expect(err).To(BeNil())
+
+			lines, err := repo.Aging(ctx, &repos.AgingFind{ProductIDs: []int64{partial.ID}})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0].Qty).To(BeNumerically("==", 14))
+			Expect(lines[0].UpTo30).To(BeNumerically("==", 10))
+			Expect(lines[0].Over180).To(BeNumerically("==", 4))
+		})
+
+		It("should only report on locations when filtering by them", func() {
+			lines, err := repo.Aging(ctx, &repos.AgingFind{ProductIDs: []int64{aged.ID}, LocationIDs: []int64{location.ID}})
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(1))
+			Expect(*lines[0].LocationID).To(Equal(location.ID))
+		})
+	})
+})
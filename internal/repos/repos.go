@@ -1,6 +1,7 @@
 package repos
 
 import (
+	"context"
 	"log"
 	"strings"
 
@@ -51,7 +52,22 @@ func normalizeErr(entityName string, err error) error {
 			log.Println("database err with constraint ", pgErr.ConstraintName)
 			return types.NewBadRequestError("duplicate object already exists")
 		}
+
+		switch pgErr.Code {
+		case "23503": // foreign_key_violation
+			log.Println("database err with constraint ", pgErr.ConstraintName)
+			return types.NewBadRequestError("related object is missing or still in use")
+		case "23514": // check_violation
+			log.Println("database err with constraint ", pgErr.ConstraintName)
+			return types.NewBadRequestError("invalid value for " + entityName)
+		}
 	}
 
 	return err
 }
+
+func notify(ctx context.Context, observers []StockObserver, change types.StockChange) {
+	for _, o := range observers {
+		o.StockChanged(ctx, change)
+	}
+}
@@ -30,7 +30,7 @@ var _ = BeforeSuite(func() {
 	cfg.Debug = true // force debug in testing
 
 	var err error
-	gr, err = db.NewDB(cfg.DBConfig)
+	gr, err = db.NewDB(cfg.DBConfig, nil)
 	Expect(err).To(BeNil())
 })
 
@@ -0,0 +1,538 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type ReturnsFind struct {
+	Limit         int
+	Offset        int
+	IDs           []int64
+	SalesOrderIDs []int64
+	Statuses      []types.ReturnStatus
+}
+
+//go:generate mockgen -source=./returns.go -destination=./mocks/Returns.go -package=mock_repos Returns
+type Returns interface {
+	Find(ctx context.Context, opts *ReturnsFind) ([]*types.Return, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *ReturnsFind) ([]*types.Return, int64, error)
+	Get(ctx context.Context, id int64) (*types.Return, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Return, bool, error)
+	Create(ctx context.Context, newReturn types.NewReturn) (*types.Return, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newReturn types.NewReturn) (*types.Return, error)
+	Receive(ctx context.Context, receive *types.DisposeReturn) (*types.Return, error)
+	ReceiveTx(ctx context.Context, tx *xorm.Session, receive *types.DisposeReturn) (*types.Return, error)
+	Release(ctx context.Context, release *types.DisposeReturn) (*types.Return, error)
+	ReleaseTx(ctx context.Context, tx *xorm.Session, release *types.DisposeReturn) (*types.Return, error)
+	Cancel(ctx context.Context, id int64) (*types.Return, error)
+	CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Return, error)
+}
+
+// NewReturns - restocking goes through products and stock levels, is recorded on the stock
+// movement ledger and fills backorders, all in the same transaction
+func NewReturns(db *xorm.Engine, products Products, stockLevels StockLevels, salesOrders SalesOrders, stockMovements StockMovements, serials Serials) Returns {
+	return &returnsRepo{db, products, stockLevels, salesOrders, stockMovements, serials}
+}
+
+type returnsRepo struct {
+	db             *xorm.Engine
+	products       Products
+	stockLevels    StockLevels
+	salesOrders    SalesOrders
+	stockMovements StockMovements
+	serials        Serials
+}
+
+// returnReference - the reference type returns write to the stock movement ledger and serial histories
+var returnReference = "return"
+
+func (r *returnsRepo) Find(ctx context.Context, opts *ReturnsFind) ([]*types.Return, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		rt, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return rt, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Return), count, nil
+}
+
+func (r *returnsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *ReturnsFind) ([]*types.Return, int64, error) {
+	if opts == nil {
+		opts = &ReturnsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.SalesOrderIDs) > 0 {
+		tx = tx.In("sales_order_id", utils.Int64ArrToInterfaceArr(opts.SalesOrderIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.Return{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("returns", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *returnsRepo) Get(ctx context.Context, id int64) (*types.Return, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		rt, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return rt, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Return), exists, nil
+}
+
+func (r *returnsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Return, bool, error) {
+	obj := &types.Return{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("returns", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *returnsRepo) Create(ctx context.Context, newReturn types.NewReturn) (*types.Return, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newReturn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Return), nil
+}
+
+// CreateTx - authorizes goods shipped on a sales order to come back. A line can't have more
+// authorized, across every return that isn't cancelled, than was shipped on it.
+func (r *returnsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newReturn types.NewReturn) (*types.Return, error) {
+	if err := types.Validate(newReturn); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	// lock the order so two returns against it can't both authorize the same units
+	exists, err := tx.Where("id = ?", newReturn.SalesOrderID).ForUpdate().Get(&types.SalesOrder{})
+	if err != nil {
+		return nil, normalizeErr("returns", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("sales order not found by id")
+	}
+
+	orderLines := []*types.SalesOrderLine{}
+	if err := tx.Where("sales_order_id = ?", newReturn.SalesOrderID).Find(&orderLines); err != nil {
+		return nil, normalizeErr("sales_order_lines", err)
+	}
+
+	orderLineByID := map[int64]*types.SalesOrderLine{}
+	for _, line := range orderLines {
+		orderLineByID[line.ID] = line
+	}
+
+	obj := &types.Return{
+		SalesOrderID: newReturn.SalesOrderID,
+		Status:       types.ReturnStatusAuthorized,
+		Reason:       newReturn.Reason,
+		Notes:        newReturn.Notes,
+		CreatedAt:    time.Now(),
+		Lines:        []*types.ReturnLine{},
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("returns", err)
+	}
+
+	for _, nl := range newReturn.Lines {
+		orderLine, exists := orderLineByID[nl.SalesOrderLineID]
+		if !exists {
+			return nil, types.NewNotFoundError("sales order line not found on this order")
+		}
+
+		// includes lines added earlier in this return
+		var authorized int64
+		if _, err := tx.SQL(`
+			SELECT COALESCE(SUM(rl.qty_authorized), 0) FROM return_lines rl
+			INNER JOIN returns rt ON rt.id = rl.return_id
+			WHERE rl.sales_order_line_id = ? AND rt.status <> ?`,
+			orderLine.ID, types.ReturnStatusCancelled,
+		).Get(&authorized); err != nil {
+			return nil, normalizeErr("return_lines", err)
+		}
+
+		if authorized+nl.Qty > orderLine.QtyShipped {
+			return nil, types.NewBadRequestError("can not return more than was shipped")
+		}
+
+		line := &types.ReturnLine{
+			ReturnID:         obj.ID,
+			SalesOrderLineID: orderLine.ID,
+			ProductID:        orderLine.ProductID,
+			QtyAuthorized:    nl.Qty,
+			CreatedAt:        time.Now(),
+		}
+
+		if _, err := tx.Insert(line); err != nil {
+			return nil, normalizeErr("return_lines", err)
+		}
+
+		obj.Lines = append(obj.Lines, line)
+	}
+
+	return obj, nil
+}
+
+func (r *returnsRepo) Receive(ctx context.Context, receive *types.DisposeReturn) (*types.Return, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReceiveTx(ctx, tx, receive)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Return), nil
+}
+
+// ReceiveTx - records returned goods as they come back along with what inspection decided.
+// Restocked units go back into stock and are offered to backorders, quarantined units are held
+// until they're released and scrapped units never reach stock. Serials that come back are
+// marked returned whatever happens to them. Every disposition is written to the stock movement
+// ledger against the return.
+func (r *returnsRepo) ReceiveTx(ctx context.Context, tx *xorm.Session, receive *types.DisposeReturn) (*types.Return, error) {
+	if err := types.Validate(receive); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, err := r.getForUpdate(tx, receive.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !obj.Status.Open() {
+		return nil, types.NewBadRequestError("return is not open for receiving")
+	}
+
+	err = r.dispose(ctx, tx, obj, receive, func(line *types.ReturnLine, dl types.DisposeReturnLine) error {
+		if dl.Qty > line.Outstanding() {
+			return types.NewBadRequestError("can not receive more than was authorized")
+		}
+		line.QtyReceived += dl.Qty
+
+		_, err := r.serials.MoveTx(ctx, tx, types.SerialMove{
+			ProductID:     line.ProductID,
+			Qty:           dl.Qty,
+			SerialNumbers: dl.SerialNumbers,
+			To:            types.SerialStatusReturned,
+			ReferenceType: &returnReference,
+			ReferenceID:   &obj.ID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status := types.ReturnStatusReceived
+	for _, line := range obj.Lines {
+		if line.Outstanding() > 0 {
+			status = types.ReturnStatusPartiallyReceived
+			break
+		}
+	}
+
+	if err := r.setStatus(tx, obj, status); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *returnsRepo) Release(ctx context.Context, release *types.DisposeReturn) (*types.Return, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReleaseTx(ctx, tx, release)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Return), nil
+}
+
+// ReleaseTx - restocks or scraps units that were quarantined when they were received, they're
+// recorded leaving quarantine as well as where they went
+func (r *returnsRepo) ReleaseTx(ctx context.Context, tx *xorm.Session, release *types.DisposeReturn) (*types.Return, error) {
+	if err := types.Validate(release); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, err := r.getForUpdate(tx, release.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.dispose(ctx, tx, obj, release, func(line *types.ReturnLine, dl types.DisposeReturnLine) error {
+		if dl.Disposition == types.ReturnDispositionQuarantine {
+			return types.NewBadRequestError("quarantined units can only be restocked or scrapped")
+		}
+		if dl.Qty > line.QtyQuarantined {
+			return types.NewBadRequestError("can not release more than is quarantined")
+		}
+		line.QtyQuarantined -= dl.Qty
+
+		return r.record(ctx, tx, obj, line, release.LocationID, -dl.Qty, types.StockMovementReasonReturnQuarantine)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols("updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("returns", err)
+	}
+
+	return obj, nil
+}
+
+func (r *returnsRepo) Cancel(ctx context.Context, id int64) (*types.Return, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CancelTx(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Return), nil
+}
+
+// CancelTx - withdraws an authorization nothing has been received against yet
+func (r *returnsRepo) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Return, error) {
+	obj, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.setStatus(tx, obj, types.ReturnStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// dispose - applies each line's disposition once check has accepted it, then hands any
+// restocked products to backorders
+func (r *returnsRepo) dispose(
+	ctx context.Context, tx *xorm.Session, obj *types.Return, dispose *types.DisposeReturn,
+	check func(line *types.ReturnLine, dl types.DisposeReturnLine) error,
+) error {
+	if dispose.LocationID != nil {
+		exists, err := tx.Table("locations").Where("id = ?", *dispose.LocationID).Exist()
+		if err != nil {
+			return normalizeErr("returns", err)
+		}
+		if !exists {
+			return types.NewNotFoundError("location not found by id")
+		}
+	}
+
+	lineByID := map[int64]*types.ReturnLine{}
+	for _, line := range obj.Lines {
+		lineByID[line.ID] = line
+	}
+
+	restocked := []int64{}
+	for _, dl := range dispose.Lines {
+		line, exists := lineByID[dl.ReturnLineID]
+		if !exists {
+			return types.NewNotFoundError("return line not found on this return")
+		}
+
+		if err := check(line, dl); err != nil {
+			return err
+		}
+
+		switch dl.Disposition {
+		case types.ReturnDispositionRestock:
+			if _, err := r.serials.MoveTx(ctx, tx, types.SerialMove{
+				ProductID:     line.ProductID,
+				Qty:           dl.Qty,
+				SerialNumbers: dl.SerialNumbers,
+				To:            types.SerialStatusInStock,
+				LocationID:    dispose.LocationID,
+				ReferenceType: &returnReference,
+				ReferenceID:   &obj.ID,
+			}); err != nil {
+				return err
+			}
+
+			if dispose.LocationID != nil {
+				if _, err := r.stockLevels.AdjustTx(ctx, tx, line.ProductID, *dispose.LocationID, dl.Qty, dl.LotID); err != nil {
+					return err
+				}
+			} else {
+				product, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{Qty: dl.Qty})
+				if err != nil {
+					return err
+				}
+				if err := keepLotTx(tx, product, dl.LotID, dl.Qty); err != nil {
+					return err
+				}
+			}
+
+			if err := r.record(ctx, tx, obj, line, dispose.LocationID, dl.Qty, types.StockMovementReasonReturn); err != nil {
+				return err
+			}
+
+			line.QtyRestocked += dl.Qty
+			restocked = append(restocked, line.ProductID)
+		case types.ReturnDispositionQuarantine:
+			if err := r.record(ctx, tx, obj, line, dispose.LocationID, dl.Qty, types.StockMovementReasonReturnQuarantine); err != nil {
+				return err
+			}
+
+			line.QtyQuarantined += dl.Qty
+		case types.ReturnDispositionScrap:
+			if err := r.record(ctx, tx, obj, line, dispose.LocationID, -dl.Qty, types.StockMovementReasonReturnScrap); err != nil {
+				return err
+			}
+
+			line.QtyScrapped += dl.Qty
+		}
+
+		if _, err := tx.ID(line.ID).
+			Cols("qty_received", "qty_restocked", "qty_quarantined", "qty_scrapped").Update(line); err != nil {
+			return normalizeErr("return_lines", err)
+		}
+	}
+
+	if len(restocked) > 0 {
+		if _, err := r.salesOrders.AllocateTx(ctx, tx, restocked...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// record - writes a line's disposition to the stock movement ledger against the return
+func (r *returnsRepo) record(
+	ctx context.Context, tx *xorm.Session, obj *types.Return, line *types.ReturnLine,
+	locationID *int64, qty int64, reason types.StockMovementReason,
+) error {
+	_, err := r.stockMovements.CreateTx(ctx, tx, types.NewStockMovement{
+		ProductID:     line.ProductID,
+		LocationID:    locationID,
+		Qty:           qty,
+		Reason:        reason,
+		ReferenceType: &returnReference,
+		ReferenceID:   &obj.ID,
+	})
+	return err
+}
+
+// getForUpdate - locks the return and loads its lines
+func (r *returnsRepo) getForUpdate(tx *xorm.Session, id int64) (*types.Return, error) {
+	obj := &types.Return{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("returns", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("return not found by id")
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *returnsRepo) setStatus(tx *xorm.Session, obj *types.Return, to types.ReturnStatus) error {
+	if !obj.Status.CanTransition(to) {
+		return types.NewBadRequestError("return can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	obj.Status = to
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols("status", "updated_at").Update(obj); err != nil {
+		return normalizeErr("returns", err)
+	}
+
+	return nil
+}
+
+func (r *returnsRepo) loadLines(tx *xorm.Session, returns ...*types.Return) error {
+	if len(returns) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.Return{}
+	ids := []int64{}
+	for _, rt := range returns {
+		rt.Lines = []*types.ReturnLine{}
+		byID[rt.ID] = rt
+		ids = append(ids, rt.ID)
+	}
+
+	lines := []*types.ReturnLine{}
+	if err := tx.In("return_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("return_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.ReturnID].Lines = append(byID[line.ReturnID].Lines, line)
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Returns", func() {
+
+	var (
+		repo     repos.Returns
+		product  *types.Product
+		location *types.Location
+		order    *types.SalesOrder
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_movements", "returns", "shipments", "pick_lists", "sales_orders", "stock_levels", "locations", "products")
+
+		repo = gr.Returns()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		// ship 5 so there's something to return
+		order, err = gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+			CustomerName: "Jane Doe",
+			Lines:        []types.NewSalesOrderLine{{ProductID: product.ID, Qty: 5, UnitPrice: 1999}},
+		})
+		Expect(err).To(BeNil())
+
+		_, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+		Expect(err).To(BeNil())
+
+		list, err := gr.PickLists().Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+		Expect(err).To(BeNil())
+
+		list, err = gr.PickLists().Pick(ctx, &types.PickPickList{ID: list.ID})
+		Expect(err).To(BeNil())
+
+		shipment, err := gr.Shipments().Create(ctx, types.NewShipment{
+			PickListID: list.ID,
+			Cartons:    []types.NewCarton{{Items: []types.NewCartonItem{{PickListLineID: list.Lines[0].ID, Qty: 5}}}},
+		})
+		Expect(err).To(BeNil())
+
+		_, err = gr.Shipments().Ship(ctx, &types.ShipShipment{ID: shipment.ID, Carrier: "UPS", TrackingNumber: "1Z999"})
+		Expect(err).To(BeNil())
+
+		order, _, err = gr.SalesOrders().Get(ctx, order.ID)
+		Expect(err).To(BeNil())
+	})
+
+	authorize := func(qty int64) *types.Return {
+		rt, err := repo.Create(ctx, types.NewReturn{
+			SalesOrderID: order.ID,
+			Lines:        []types.NewReturnLine{{SalesOrderLineID: order.Lines[0].ID, Qty: qty}},
+		})
+		Expect(err).To(BeNil())
+		return rt
+	}
+
+	onHand := func() int64 {
+		p, _, err := gr.Products().Get(ctx, product.ID)
+		Expect(err).To(BeNil())
+		return p.Qty
+	}
+
+	Context("Create(Tx)", func() {
+		It("should fail for unknown orders or lines", func() {
+			_, err := repo.Create(ctx, types.NewReturn{
+				SalesOrderID: 99999999, Lines: []types.NewReturnLine{{SalesOrderLineID: order.Lines[0].ID, Qty: 1}},
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewReturn{
+				SalesOrderID: order.ID, Lines: []types.NewReturnLine{{SalesOrderLineID: 99999999, Qty: 1}},
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should not authorize more than was shipped across returns", func() {
+			rt := authorize(3)
+			Expect(rt.Status).To(Equal(types.ReturnStatusAuthorized))
+			Expect(rt.Lines[0].QtyAuthorized).To(BeNumerically("==", 3))
+
+			_, err := repo.Create(ctx, types.NewReturn{
+				SalesOrderID: order.ID, Lines: []types.NewReturnLine{{SalesOrderLineID: order.Lines[0].ID, Qty: 3}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			// cancelled returns don't count
+			_, err = repo.Cancel(ctx, rt.ID)
+			Expect(err).To(BeNil())
+			authorize(5)
+		})
+	})
+
+	Context("Receive(Tx)", func() {
+		It("should restock, quarantine and scrap and record each of them", func() {
+			rt := authorize(5)
+			lineID := rt.Lines[0].ID
+
+			rt, err := repo.Receive(ctx, &types.DisposeReturn{
+				ID:         rt.ID,
+				LocationID: &location.ID,
+				Lines: []types.DisposeReturnLine{
+					{ReturnLineID: lineID, Qty: 2, Disposition: types.ReturnDispositionRestock},
+					{ReturnLineID: lineID, Qty: 1, Disposition: types.ReturnDispositionQuarantine},
+					{ReturnLineID: lineID, Qty: 1, Disposition: types.ReturnDispositionScrap},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(rt.Status).To(Equal(types.ReturnStatusPartiallyReceived))
+			Expect(rt.Lines[0].QtyReceived).To(BeNumerically("==", 4))
+			Expect(rt.Lines[0].QtyRestocked).To(BeNumerically("==", 2))
+			Expect(rt.Lines[0].QtyQuarantined).To(BeNumerically("==", 1))
+			Expect(rt.Lines[0].QtyScrapped).To(BeNumerically("==", 1))
+
+			// only the restocked units are back on hand
+			Expect(onHand()).To(BeNumerically("==", 7))
+
+			level, _, err := gr.StockLevels().Get(ctx, product.ID, location.ID)
+			Expect(err).To(BeNil())
+			Expect(level.Qty).To(BeNumerically("==", 2))
+
+			movements, count, err := gr.StockMovements().Find(ctx, &repos.StockMovementsFind{
+				ReferenceType: utils.Ref("return"), ReferenceIDs: []int64{rt.ID},
+			})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 3))
+
+			byReason := map[types.StockMovementReason]*types.StockMovement{}
+			for _, movement := range movements {
+				byReason[movement.Reason] = movement
+			}
+			Expect(byReason[types.StockMovementReasonReturn].Qty).To(BeNumerically("==", 2))
+			Expect(*byReason[types.StockMovementReasonReturn].LocationID).To(Equal(location.ID))
+			Expect(byReason[types.StockMovementReasonReturnQuarantine].Qty).To(BeNumerically("==", 1))
+			Expect(byReason[types.StockMovementReasonReturnScrap].Qty).To(BeNumerically("==", -1))
+		})
+
+		It("should not receive more than was authorized", func() {
+			rt := authorize(2)
+
+			_, err := repo.Receive(ctx, &types.DisposeReturn{
+				ID:    rt.ID,
+				Lines: []types.DisposeReturnLine{{ReturnLineID: rt.Lines[0].ID, Qty: 3, Disposition: types.ReturnDispositionScrap}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should only restock into a lot when the product tracks lots", func() {
+			rt := authorize(2)
+
+			_, err := repo.Receive(ctx, &types.DisposeReturn{
+				ID: rt.ID,
+				Lines: []types.DisposeReturnLine{{
+					ReturnLineID: rt.Lines[0].ID, Qty: 2, Disposition: types.ReturnDispositionRestock, LotID: utils.Ref(int64(99999999)),
+				}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+			Expect(onHand()).To(BeNumerically("==", 5))
+		})
+
+		It("should close the return once everything is back and refuse to cancel it", func() {
+			rt := authorize(2)
+
+			rt, err := repo.Receive(ctx, &types.DisposeReturn{
+				ID:    rt.ID,
+				Lines: []types.DisposeReturnLine{{ReturnLineID: rt.Lines[0].ID, Qty: 2, Disposition: types.ReturnDispositionRestock}},
+			})
+			Expect(err).To(BeNil())
+			Expect(rt.Status).To(Equal(types.ReturnStatusReceived))
+			Expect(onHand()).To(BeNumerically("==", 7))
+
+			_, err = repo.Cancel(ctx, rt.ID)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Release(Tx)", func() {
+		It("should restock or scrap quarantined units", func() {
+			rt := authorize(3)
+
+			rt, err := repo.Receive(ctx, &types.DisposeReturn{
+				ID:    rt.ID,
+				Lines: []types.DisposeReturnLine{{ReturnLineID: rt.Lines[0].ID, Qty: 3, Disposition: types.ReturnDispositionQuarantine}},
+			})
+			Expect(err).To(BeNil())
+			Expect(onHand()).To(BeNumerically("==", 5))
+
+			_, err = repo.Release(ctx, &types.DisposeReturn{
+				ID:    rt.ID,
+				Lines: []types.DisposeReturnLine{{ReturnLineID: rt.Lines[0].ID, Qty: 4, Disposition: types.ReturnDispositionRestock}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			rt, err = repo.Release(ctx, &types.DisposeReturn{
+				ID: rt.ID,
+				Lines: []types.DisposeReturnLine{
+					{ReturnLineID: rt.Lines[0].ID, Qty: 2, Disposition: types.ReturnDispositionRestock},
+					{ReturnLineID: rt.Lines[0].ID, Qty: 1, Disposition: types.ReturnDispositionScrap},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(rt.Lines[0].QtyQuarantined).To(BeNumerically("==", 0))
+			Expect(rt.Lines[0].QtyRestocked).To(BeNumerically("==", 2))
+			Expect(rt.Lines[0].QtyScrapped).To(BeNumerically("==", 1))
+			Expect(onHand()).To(BeNumerically("==", 7))
+
+			// in and back out of quarantine, then restocked and scrapped
+			movements, _, err := gr.StockMovements().Find(ctx, &repos.StockMovementsFind{
+				ReferenceType: utils.Ref("return"), ReferenceIDs: []int64{rt.ID},
+				Reasons: []types.StockMovementReason{types.StockMovementReasonReturnQuarantine},
+			})
+			Expect(err).To(BeNil())
+			var held int64
+			for _, movement := range movements {
+				held += movement.Qty
+			}
+			Expect(movements).To(HaveLen(3))
+			Expect(held).To(BeNumerically("==", 0))
+		})
+	})
+})
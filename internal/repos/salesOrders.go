@@ -0,0 +1,654 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type SalesOrdersFind struct {
+	Limit       int
+	Offset      int
+	IDs         []int64
+	CustomerIDs []int64
+	Statuses    []types.SalesOrderStatus
+	// Backordered - only orders with lines still waiting on stock
+	Backordered bool
+}
+
+//go:generate mockgen -source=./salesOrders.go -destination=./mocks/SalesOrders.go -package=mock_repos SalesOrders
+type SalesOrders interface {
+	Find(ctx context.Context, opts *SalesOrdersFind) ([]*types.SalesOrder, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *SalesOrdersFind) ([]*types.SalesOrder, int64, error)
+	Get(ctx context.Context, id int64) (*types.SalesOrder, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.SalesOrder, bool, error)
+	Create(ctx context.Context, newOrder types.NewSalesOrder) (*types.SalesOrder, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewSalesOrder) (*types.SalesOrder, error)
+	Update(ctx context.Context, diff *types.UpdateSalesOrder) (*types.SalesOrder, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateSalesOrder) (*types.SalesOrder, error)
+	Transition(ctx context.Context, id int64, to types.SalesOrderStatus) (*types.SalesOrder, error)
+	TransitionTx(ctx context.Context, tx *xorm.Session, id int64, to types.SalesOrderStatus) (*types.SalesOrder, error)
+	Allocate(ctx context.Context, productIDs ...int64) ([]*types.SalesOrderLine, error)
+	AllocateTx(ctx context.Context, tx *xorm.Session, productIDs ...int64) ([]*types.SalesOrderLine, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+}
+
+// NewSalesOrders - products is used to reserve stock for confirmed orders and kits to build
+// kits from their components as they're allocated
+func NewSalesOrders(db *xorm.Engine, products Products, kits Kits) SalesOrders {
+	return &salesOrdersRepo{db, products, kits}
+}
+
+type salesOrdersRepo struct {
+	db       *xorm.Engine
+	products Products
+	kits     Kits
+}
+
+func (r *salesOrdersRepo) Find(ctx context.Context, opts *SalesOrdersFind) ([]*types.SalesOrder, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return s, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.SalesOrder), count, nil
+}
+
+func (r *salesOrdersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *SalesOrdersFind) ([]*types.SalesOrder, int64, error) {
+	if opts == nil {
+		opts = &SalesOrdersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.CustomerIDs) > 0 {
+		tx = tx.In("customer_id", utils.Int64ArrToInterfaceArr(opts.CustomerIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	if opts.Backordered {
+		tx = tx.Where("EXISTS (SELECT 1 FROM sales_order_lines l WHERE l.sales_order_id = sales_orders.id AND l.backorder AND l.qty_allocated + l.qty_shipped < l.qty)")
+	}
+
+	objs := []*types.SalesOrder{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("sales_orders", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *salesOrdersRepo) Get(ctx context.Context, id int64) (*types.SalesOrder, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return s, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.SalesOrder), exists, nil
+}
+
+func (r *salesOrdersRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.SalesOrder, bool, error) {
+	obj := &types.SalesOrder{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("sales_orders", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *salesOrdersRepo) Create(ctx context.Context, newOrder types.NewSalesOrder) (*types.SalesOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.SalesOrder), nil
+}
+
+// CreateTx - sales orders always start out as drafts, nothing is allocated until they're confirmed
+func (r *salesOrdersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewSalesOrder) (*types.SalesOrder, error) {
+	if err := types.Validate(newOrder); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj := &types.SalesOrder{
+		CustomerID:   newOrder.CustomerID,
+		CustomerName: newOrder.CustomerName,
+		Reference:    newOrder.Reference,
+		Status:       types.SalesOrderStatusDraft,
+		Notes:        newOrder.Notes,
+		CreatedAt:    time.Now(),
+	}
+
+	if obj.CustomerID != nil {
+		customer, err := getCustomerTx(tx, *obj.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		if obj.CustomerName == "" {
+			obj.CustomerName = customer.Name
+		}
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("sales_orders", err)
+	}
+
+	if err := r.insertLines(tx, obj, newOrder.Lines); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *salesOrdersRepo) Update(ctx context.Context, diff *types.UpdateSalesOrder) (*types.SalesOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.SalesOrder), nil
+}
+
+// UpdateTx - the customer details and notes can change until the order is cancelled, the
+// lines only while it's a draft
+func (r *salesOrdersRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateSalesOrder) (*types.SalesOrder, error) {
+	if err := types.Validate(diff); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, exists, err := r.getForUpdate(tx, diff.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("sales order not found by id")
+	}
+
+	if obj.Status == types.SalesOrderStatusCancelled {
+		return nil, types.NewBadRequestError("sales order is cancelled")
+	}
+
+	if diff.CustomerID != nil {
+		customer, err := getCustomerTx(tx, *diff.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		obj.CustomerID = diff.CustomerID
+		if diff.CustomerName == nil {
+			obj.CustomerName = customer.Name
+		}
+	}
+
+	if diff.CustomerName != nil {
+		obj.CustomerName = *diff.CustomerName
+	}
+
+	if diff.Reference != nil {
+		obj.Reference = diff.Reference
+	}
+
+	if diff.Notes != nil {
+		obj.Notes = diff.Notes
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).Cols("customer_id", "customer_name", "reference", "notes", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("sales_orders", err)
+	}
+
+	if len(diff.Lines) > 0 {
+		if obj.Status != types.SalesOrderStatusDraft {
+			return nil, types.NewBadRequestError("only draft sales orders can change their lines")
+		}
+
+		if _, err := tx.Where("sales_order_id = ?", obj.ID).Delete(&types.SalesOrderLine{}); err != nil {
+			return nil, normalizeErr("sales_order_lines", err)
+		}
+
+		if err := r.insertLines(tx, obj, diff.Lines); err != nil {
+			return nil, err
+		}
+	} else if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *salesOrdersRepo) Transition(ctx context.Context, id int64, to types.SalesOrderStatus) (*types.SalesOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.TransitionTx(ctx, tx, id, to)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.SalesOrder), nil
+}
+
+// TransitionTx - moves a sales order along its lifecycle. Confirming allocates what's available
+// to each line and splits any shortfall off into a backorder line. Cancelling gives the allocated
+// stock back and offers it to other orders' backorders, it's refused while pick lists are in progress.
+// Kits are built from their components as they're allocated and stay built if the order is cancelled.
+// Shipping moves allocated stock itself so the shipped statuses have no side effects here.
+func (r *salesOrdersRepo) TransitionTx(ctx context.Context, tx *xorm.Session, id int64, to types.SalesOrderStatus) (*types.SalesOrder, error) {
+	obj, exists, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("sales order not found by id")
+	}
+
+	if !obj.Status.CanTransition(to) {
+		return nil, types.NewBadRequestError("sales order can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	// products whose allocated stock was given back
+	released := []int64{}
+
+	switch to {
+	case types.SalesOrderStatusConfirmed:
+		if err := r.allocateOnConfirm(ctx, tx, obj); err != nil {
+			return nil, err
+		}
+		obj.ConfirmedAt = utils.Ref(time.Now())
+	case types.SalesOrderStatusCancelled:
+		inProgress, err := tx.Table("pick_lists").
+			Where("sales_order_id = ?", obj.ID).
+			In("status", types.PickListStatusOpen, types.PickListStatusPicked, types.PickListStatusPacked).
+			Exist()
+		if err != nil {
+			return nil, normalizeErr("pick_lists", err)
+		}
+		if inProgress {
+			return nil, types.NewBadRequestError("sales order has pick lists in progress, cancel them first")
+		}
+
+		for _, line := range obj.Lines {
+			if line.QtyAllocated == 0 {
+				continue
+			}
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{Allocated: -line.QtyAllocated}); err != nil {
+				return nil, err
+			}
+			line.QtyAllocated = 0
+			if _, err := tx.ID(line.ID).Cols("qty_allocated").Update(line); err != nil {
+				return nil, normalizeErr("sales_order_lines", err)
+			}
+			released = append(released, line.ProductID)
+		}
+	}
+
+	obj.Status = to
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).Cols("status", "confirmed_at", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("sales_orders", err)
+	}
+
+	// the status is written first so a cancelled order's own backorders aren't offered the stock
+	if len(released) > 0 {
+		if _, err := r.AllocateTx(ctx, tx, released...); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func (r *salesOrdersRepo) Allocate(ctx context.Context, productIDs ...int64) ([]*types.SalesOrderLine, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.AllocateTx(ctx, tx, productIDs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.SalesOrderLine), nil
+}
+
+// AllocateTx - hands available stock to backorder lines on open orders, oldest order first.
+// Anything that adds stock should call this so waiting customers get it before new orders do.
+// With no product ids every product with an open backorder is allocated, otherwise kits made from
+// any of them are allocated too. It returns the lines that were given stock.
+func (r *salesOrdersRepo) AllocateTx(ctx context.Context, tx *xorm.Session, productIDs ...int64) ([]*types.SalesOrderLine, error) {
+	if len(productIDs) == 0 {
+		if err := tx.SQL(`
+			SELECT DISTINCT l.product_id FROM sales_order_lines l
+			INNER JOIN sales_orders o ON o.id = l.sales_order_id
+			WHERE o.status IN (?, ?) AND l.backorder AND l.qty_allocated + l.qty_shipped < l.qty
+			ORDER BY l.product_id`, types.SalesOrderStatusConfirmed, types.SalesOrderStatusPartiallyShipped).Find(&productIDs); err != nil {
+			return nil, normalizeErr("sales_order_lines", err)
+		}
+	} else {
+		kitIDs := []int64{}
+		if err := tx.Table("kit_components").Distinct("kit_id").
+			In("component_id", utils.Int64ArrToInterfaceArr(productIDs...)...).OrderBy("kit_id").Find(&kitIDs); err != nil {
+			return nil, normalizeErr("kit_components", err)
+		}
+		productIDs = append(productIDs, kitIDs...)
+	}
+
+	allocated := []*types.SalesOrderLine{}
+	seen := map[int64]bool{}
+	for _, productID := range productIDs {
+		if seen[productID] {
+			continue
+		}
+		seen[productID] = true
+
+		product := &types.Product{}
+		exists, err := tx.Where("id = ?", productID).ForUpdate().Get(product)
+		if err != nil {
+			return nil, normalizeErr("products", err)
+		}
+		if !exists {
+			continue
+		}
+
+		lines := []*types.SalesOrderLine{}
+		if err := tx.SQL(`
+			SELECT l.* FROM sales_order_lines l
+			INNER JOIN sales_orders o ON o.id = l.sales_order_id
+			WHERE l.product_id = ? AND o.status IN (?, ?) AND l.backorder AND l.qty_allocated + l.qty_shipped < l.qty
+			ORDER BY o.confirmed_at, l.id
+			FOR UPDATE OF l`, productID, types.SalesOrderStatusConfirmed, types.SalesOrderStatusPartiallyShipped).Find(&lines); err != nil {
+			return nil, normalizeErr("sales_order_lines", err)
+		}
+
+		if product.Kit {
+			var waiting int64
+			for _, line := range lines {
+				waiting += line.Unallocated()
+			}
+			if product, err = r.buildKits(ctx, tx, product, waiting); err != nil {
+				return nil, err
+			}
+		}
+
+		if product.Available() <= 0 {
+			continue
+		}
+
+		available := product.Available()
+		for _, line := range lines {
+			if available == 0 {
+				break
+			}
+
+			qty := min(available, line.Unallocated())
+			if _, err := r.products.AdjustTx(ctx, tx, productID, types.ProductAdjustment{Allocated: qty}); err != nil {
+				return nil, err
+			}
+
+			line.QtyAllocated += qty
+			if _, err := tx.ID(line.ID).Cols("qty_allocated").Update(line); err != nil {
+				return nil, normalizeErr("sales_order_lines", err)
+			}
+
+			available -= qty
+			allocated = append(allocated, line)
+		}
+	}
+
+	return allocated, nil
+}
+
+func (r *salesOrdersRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+// DestroyTx - only drafts can be deleted, confirmed orders get cancelled instead
+func (r *salesOrdersRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	obj, exists, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return types.NewNotFoundError("sales order not found")
+	}
+
+	if obj.Status != types.SalesOrderStatusDraft {
+		return types.NewBadRequestError("only draft sales orders can be deleted")
+	}
+
+	if _, err := tx.Where("id = ?", id).Delete(&types.SalesOrder{}); err != nil {
+		return normalizeErr("sales_orders", err)
+	}
+	return nil
+}
+
+// allocateOnConfirm - gives each line as much of the available stock as it can. A line that
+// can't be filled keeps what it got and the rest is split off into a backorder line.
+func (r *salesOrdersRepo) allocateOnConfirm(ctx context.Context, tx *xorm.Session, obj *types.SalesOrder) error {
+	lines := obj.Lines
+	for _, line := range lines {
+		product := &types.Product{}
+		if _, err := tx.Where("id = ?", line.ProductID).ForUpdate().Get(product); err != nil {
+			return normalizeErr("products", err)
+		}
+
+		if product.Kit {
+			var err error
+			if product, err = r.buildKits(ctx, tx, product, line.Qty); err != nil {
+				return err
+			}
+		}
+
+		qty := min(max(product.Available(), 0), line.Qty)
+		if qty > 0 {
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{Allocated: qty}); err != nil {
+				return err
+			}
+		}
+
+		if qty == line.Qty {
+			line.QtyAllocated = qty
+			if _, err := tx.ID(line.ID).Cols("qty_allocated").Update(line); err != nil {
+				return normalizeErr("sales_order_lines", err)
+			}
+			continue
+		}
+
+		if qty == 0 {
+			// nothing to split, the whole line waits
+			line.Backorder = true
+			if _, err := tx.ID(line.ID).Cols("backorder").Update(line); err != nil {
+				return normalizeErr("sales_order_lines", err)
+			}
+			continue
+		}
+
+		backorder := &types.SalesOrderLine{
+			SalesOrderID: obj.ID,
+			ProductID:    line.ProductID,
+			Qty:          line.Qty - qty,
+			Backorder:    true,
+			UnitPrice:    line.UnitPrice,
+			CreatedAt:    time.Now(),
+		}
+
+		line.Qty = qty
+		line.QtyAllocated = qty
+		if _, err := tx.ID(line.ID).Cols("qty", "qty_allocated").Update(line); err != nil {
+			return normalizeErr("sales_order_lines", err)
+		}
+
+		if _, err := tx.Insert(backorder); err != nil {
+			return normalizeErr("sales_order_lines", err)
+		}
+
+		obj.Lines = append(obj.Lines, backorder)
+	}
+
+	return nil
+}
+
+// buildKits - builds as many kits as it takes for want to be available, or as many as the
+// components allow if that's fewer. It returns the kit's product as it is afterwards.
+func (r *salesOrdersRepo) buildKits(ctx context.Context, tx *xorm.Session, product *types.Product, want int64) (*types.Product, error) {
+	short := want - max(product.Available(), 0)
+	if short <= 0 {
+		return product, nil
+	}
+
+	kit, exists, err := r.kits.GetTx(ctx, tx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return product, nil
+	}
+
+	qty := min(short, kit.Buildable())
+	if qty <= 0 {
+		return product, nil
+	}
+
+	if kit, err = r.kits.AssembleTx(ctx, tx, &types.AssembleKit{ID: product.ID, Qty: qty}); err != nil {
+		return nil, err
+	}
+
+	return kit.Product, nil
+}
+
+func (r *salesOrdersRepo) getForUpdate(tx *xorm.Session, id int64) (*types.SalesOrder, bool, error) {
+	obj := &types.SalesOrder{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("sales_orders", err)
+	}
+	return obj, exists, nil
+}
+
+func (r *salesOrdersRepo) loadLines(tx *xorm.Session, orders ...*types.SalesOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.SalesOrder{}
+	ids := []int64{}
+	for _, order := range orders {
+		order.Lines = []*types.SalesOrderLine{}
+		byID[order.ID] = order
+		ids = append(ids, order.ID)
+	}
+
+	lines := []*types.SalesOrderLine{}
+	if err := tx.In("sales_order_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("sales_order_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.SalesOrderID].Lines = append(byID[line.SalesOrderID].Lines, line)
+	}
+
+	return nil
+}
+
+func (r *salesOrdersRepo) insertLines(tx *xorm.Session, order *types.SalesOrder, newLines []types.NewSalesOrderLine) error {
+	order.Lines = []*types.SalesOrderLine{}
+
+	for _, nl := range newLines {
+		exists, err := tx.Table("products").Where("id = ?", nl.ProductID).Exist()
+		if err != nil {
+			return normalizeErr("sales_order_lines", err)
+		}
+		if !exists {
+			return types.NewNotFoundError("product not found by id")
+		}
+
+		line := &types.SalesOrderLine{
+			SalesOrderID: order.ID,
+			ProductID:    nl.ProductID,
+			Qty:          nl.Qty,
+			UnitPrice:    nl.UnitPrice,
+			CreatedAt:    time.Now(),
+		}
+
+		if _, err := tx.Insert(line); err != nil {
+			return normalizeErr("sales_order_lines", err)
+		}
+
+		order.Lines = append(order.Lines, line)
+	}
+
+	return nil
+}
+
+// getCustomerTx - the customer a sales order is for, not found when they don't exist
+func getCustomerTx(tx *xorm.Session, id int64) (*types.Customer, error) {
+	customer := &types.Customer{}
+	exists, err := tx.Where("id = ?", id).Get(customer)
+	if err != nil {
+		return nil, normalizeErr("customers", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("customer not found by id")
+	}
+	return customer, nil
+}
@@ -0,0 +1,203 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: SalesOrders", func() {
+
+	var (
+		repo    repos.SalesOrders
+		product *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("sales_orders", "sales_order_lines", "receipts", "purchase_orders", "suppliers", "products")
+
+		repo = gr.SalesOrders()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+	})
+
+	newOrder := func(qty int64) *types.SalesOrder {
+		order, err := repo.Create(ctx, types.NewSalesOrder{
+			CustomerName: "Jane Doe",
+			Lines:        []types.NewSalesOrderLine{{ProductID: product.ID, Qty: qty, UnitPrice: 1999}},
+		})
+		Expect(err).To(BeNil())
+		return order
+	}
+
+	allocated := func() int64 {
+		p, _, err := gr.Products().Get(ctx, product.ID)
+		Expect(err).To(BeNil())
+		return p.Allocated
+	}
+
+	Context("Create(Tx)", func() {
+		It("should fail without a customer, lines or with unknown products", func() {
+			_, err := repo.Create(ctx, types.NewSalesOrder{
+				Lines: []types.NewSalesOrderLine{{ProductID: product.ID, Qty: 1}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewSalesOrder{CustomerName: "Jane Doe"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewSalesOrder{
+				CustomerName: "Jane Doe", Lines: []types.NewSalesOrderLine{{ProductID: 99999999, Qty: 1}},
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should create a draft without allocating anything", func() {
+			order := newOrder(4)
+			Expect(order.Status).To(Equal(types.SalesOrderStatusDraft))
+			Expect(order.Lines).To(HaveLen(1))
+			Expect(allocated()).To(BeNumerically("==", 0))
+		})
+	})
+
+	Context("Transition(Tx)", func() {
+		It("should allocate the whole line when there's enough stock", func() {
+			order, err := repo.Transition(ctx, newOrder(4).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+			Expect(order.ConfirmedAt).NotTo(BeNil())
+			Expect(order.Lines).To(HaveLen(1))
+			Expect(order.Lines[0].QtyAllocated).To(BeNumerically("==", 4))
+			Expect(allocated()).To(BeNumerically("==", 4))
+		})
+
+		It("should split the shortfall into a backorder line", func() {
+			order, err := repo.Transition(ctx, newOrder(15).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+			Expect(order.Lines).To(HaveLen(2))
+			Expect(order.Lines[0].Qty).To(BeNumerically("==", 10))
+			Expect(order.Lines[0].QtyAllocated).To(BeNumerically("==", 10))
+			Expect(order.Lines[1].Backorder).To(BeTrue())
+			Expect(order.Lines[1].Qty).To(BeNumerically("==", 5))
+			Expect(order.Lines[1].QtyAllocated).To(BeNumerically("==", 0))
+			Expect(order.Lines[1].UnitPrice).To(BeNumerically("==", 1999))
+			Expect(allocated()).To(BeNumerically("==", 10))
+
+			// the stock is spoken for
+			_, err = gr.Products().Adjust(ctx, product.ID, types.ProductAdjustment{Qty: -1})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			orders, count, err := repo.Find(ctx, &repos.SalesOrdersFind{Backordered: true})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(orders[0].ID).To(Equal(order.ID))
+		})
+
+		It("should backorder the whole line when nothing is available", func() {
+			_, err := repo.Transition(ctx, newOrder(10).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			order, err := repo.Transition(ctx, newOrder(3).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+			Expect(order.Lines).To(HaveLen(1))
+			Expect(order.Lines[0].Backorder).To(BeTrue())
+			Expect(order.Lines[0].QtyAllocated).To(BeNumerically("==", 0))
+		})
+
+		It("should release the stock on cancellation and hand it to backorders", func() {
+			first, err := repo.Transition(ctx, newOrder(10).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			second, err := repo.Transition(ctx, newOrder(6).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+			Expect(second.Lines[0].Backorder).To(BeTrue())
+
+			cancelled, err := repo.Transition(ctx, first.ID, types.SalesOrderStatusCancelled)
+			Expect(err).To(BeNil())
+			Expect(cancelled.Lines[0].QtyAllocated).To(BeNumerically("==", 0))
+
+			second, _, err = repo.Get(ctx, second.ID)
+			Expect(err).To(BeNil())
+			Expect(second.Lines[0].QtyAllocated).To(BeNumerically("==", 6))
+			Expect(allocated()).To(BeNumerically("==", 6))
+		})
+
+		It("should not confirm twice", func() {
+			order := newOrder(1)
+			_, err := repo.Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			_, err = repo.Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Allocate(Tx)", func() {
+		It("should fill backorders oldest first as stock arrives", func() {
+			first, err := repo.Transition(ctx, newOrder(12).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			second, err := repo.Transition(ctx, newOrder(3).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			_, err = gr.Products().Adjust(ctx, product.ID, types.ProductAdjustment{Qty: 4})
+			Expect(err).To(BeNil())
+
+			lines, err := repo.Allocate(ctx)
+			Expect(err).To(BeNil())
+			Expect(lines).To(HaveLen(2))
+
+			first, _, err = repo.Get(ctx, first.ID)
+			Expect(err).To(BeNil())
+			Expect(first.Lines[1].QtyAllocated).To(BeNumerically("==", 2))
+
+			second, _, err = repo.Get(ctx, second.ID)
+			Expect(err).To(BeNil())
+			Expect(second.Lines[0].QtyAllocated).To(BeNumerically("==", 2))
+			Expect(second.Lines[0].Unallocated()).To(BeNumerically("==", 1))
+		})
+
+		It("should allocate backorders when a delivery is received", func() {
+			order, err := repo.Transition(ctx, newOrder(15).ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			supplier, err := gr.Suppliers().Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+			Expect(err).To(BeNil())
+
+			po, err := gr.PurchaseOrders().Create(ctx, types.NewPurchaseOrder{
+				SupplierID: supplier.ID,
+				Lines:      []types.NewPurchaseOrderLine{{ProductID: product.ID, Qty: 20, UnitCost: utils.Ref[int64](250)}},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = gr.PurchaseOrders().Transition(ctx, po.ID, types.PurchaseOrderStatusSubmitted)
+			Expect(err).To(BeNil())
+
+			_, err = gr.Receipts().Create(ctx, types.NewReceipt{
+				PurchaseOrderID: po.ID,
+				Lines:           []types.NewReceiptLine{{PurchaseOrderLineID: po.Lines[0].ID, QtyReceived: 20}},
+			})
+			Expect(err).To(BeNil())
+
+			order, _, err = repo.Get(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(order.Lines[1].QtyAllocated).To(BeNumerically("==", 5))
+			Expect(allocated()).To(BeNumerically("==", 15))
+		})
+	})
+
+	Context("Destroy(Tx)", func() {
+		It("should only delete drafts", func() {
+			order := newOrder(1)
+			_, err := repo.Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+			Expect(types.IsBadRequestError(repo.Destroy(ctx, order.ID))).To(BeTrue())
+
+			Expect(repo.Destroy(ctx, newOrder(1).ID)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,348 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type SerialsFind struct {
+	Limit           int
+	Offset          int
+	IDs             []int64
+	ProductIDs      []int64
+	SerialNumbers   []string
+	Statuses        []types.SerialStatus
+	LocationIDs     []int64
+	PickListLineIDs []int64
+}
+
+//go:generate mockgen -source=./serials.go -destination=./mocks/Serials.go -package=mock_repos Serials
+type Serials interface {
+	Find(ctx context.Context, opts *SerialsFind) ([]*types.Serial, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *SerialsFind) ([]*types.Serial, int64, error)
+	Get(ctx context.Context, id int64) (*types.Serial, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Serial, bool, error)
+	History(ctx context.Context, serialNumber string) (*types.SerialHistory, bool, error)
+	HistoryTx(ctx context.Context, tx *xorm.Session, serialNumber string) (*types.SerialHistory, bool, error)
+	Receive(ctx context.Context, move types.SerialMove) ([]*types.Serial, error)
+	ReceiveTx(ctx context.Context, tx *xorm.Session, move types.SerialMove) ([]*types.Serial, error)
+	Move(ctx context.Context, move types.SerialMove) ([]*types.Serial, error)
+	MoveTx(ctx context.Context, tx *xorm.Session, move types.SerialMove) ([]*types.Serial, error)
+}
+
+// NewSerials - serials only follow the units of serialized products around, whoever moves the
+// stock moves its serials in the same transaction
+func NewSerials(db *xorm.Engine) Serials {
+	return &serialsRepo{db}
+}
+
+type serialsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *serialsRepo) Find(ctx context.Context, opts *SerialsFind) ([]*types.Serial, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return s, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Serial), count, nil
+}
+
+func (r *serialsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *SerialsFind) ([]*types.Serial, int64, error) {
+	if opts == nil {
+		opts = &SerialsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.SerialNumbers) > 0 {
+		serialNumbers := []interface{}{}
+		for _, serialNumber := range opts.SerialNumbers {
+			serialNumbers = append(serialNumbers, serialNumber)
+		}
+		tx = tx.In("serial_number", serialNumbers...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	if len(opts.LocationIDs) > 0 {
+		tx = tx.In("location_id", utils.Int64ArrToInterfaceArr(opts.LocationIDs...)...)
+	}
+
+	if len(opts.PickListLineIDs) > 0 {
+		tx = tx.In("pick_list_line_id", utils.Int64ArrToInterfaceArr(opts.PickListLineIDs...)...)
+	}
+
+	objs := []*types.Serial{}
+	count, err := tx.OrderBy("serial_number").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("serials", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *serialsRepo) Get(ctx context.Context, id int64) (*types.Serial, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return s, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Serial), exists, nil
+}
+
+func (r *serialsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Serial, bool, error) {
+	obj := &types.Serial{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("serials", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *serialsRepo) History(ctx context.Context, serialNumber string) (*types.SerialHistory, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		h, ex, e := r.HistoryTx(ctx, tx, serialNumber)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return h, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.SerialHistory), exists, nil
+}
+
+// HistoryTx - the serial and every move it has made from the moment it was received
+func (r *serialsRepo) HistoryTx(ctx context.Context, tx *xorm.Session, serialNumber string) (*types.SerialHistory, bool, error) {
+	obj := &types.Serial{}
+	exists, err := tx.Where("serial_number = ?", serialNumber).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("serials", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	events := []*types.SerialEvent{}
+	if err := tx.Where("serial_id = ?", obj.ID).OrderBy("id").Find(&events); err != nil {
+		return nil, false, normalizeErr("serial_events", err)
+	}
+
+	return &types.SerialHistory{Serial: obj, Events: events}, exists, nil
+}
+
+func (r *serialsRepo) Receive(ctx context.Context, move types.SerialMove) ([]*types.Serial, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReceiveTx(ctx, tx, move)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.Serial), nil
+}
+
+// ReceiveTx - brings new serials into stock, a serial number can only ever be received once
+func (r *serialsRepo) ReceiveTx(ctx context.Context, tx *xorm.Session, move types.SerialMove) ([]*types.Serial, error) {
+	move.To = types.SerialStatusInStock
+	serialized, err := r.expect(tx, move)
+	if err != nil || !serialized {
+		return nil, err
+	}
+
+	objs := []*types.Serial{}
+	for _, serialNumber := range move.SerialNumbers {
+		exists, err := tx.Where("serial_number = ?", serialNumber).Exist(&types.Serial{})
+		if err != nil {
+			return nil, normalizeErr("serials", err)
+		}
+		if exists {
+			return nil, types.NewBadRequestError("serial " + serialNumber + " already exists")
+		}
+
+		obj := &types.Serial{
+			ProductID:    move.ProductID,
+			SerialNumber: serialNumber,
+			Status:       types.SerialStatusInStock,
+			LocationID:   move.LocationID,
+			CreatedAt:    time.Now(),
+		}
+
+		if _, err := tx.Insert(obj); err != nil {
+			return nil, normalizeErr("serials", err)
+		}
+
+		if err := r.record(tx, obj, move); err != nil {
+			return nil, err
+		}
+
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+func (r *serialsRepo) Move(ctx context.Context, move types.SerialMove) ([]*types.Serial, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.MoveTx(ctx, tx, move)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]*types.Serial), nil
+}
+
+// MoveTx - moves existing serials of the product to move.To. Allocated serials hold on to the
+// pick list line they were picked for and shipped serials no longer have a location.
+func (r *serialsRepo) MoveTx(ctx context.Context, tx *xorm.Session, move types.SerialMove) ([]*types.Serial, error) {
+	serialized, err := r.expect(tx, move)
+	if err != nil || !serialized {
+		return nil, err
+	}
+
+	objs := []*types.Serial{}
+	for _, serialNumber := range move.SerialNumbers {
+		obj := &types.Serial{}
+		exists, err := tx.Where("serial_number = ?", serialNumber).ForUpdate().Get(obj)
+		if err != nil {
+			return nil, normalizeErr("serials", err)
+		}
+		if !exists || obj.ProductID != move.ProductID {
+			return nil, types.NewNotFoundError("serial " + serialNumber + " not found for this product")
+		}
+
+		if !obj.Status.CanTransition(move.To) {
+			return nil, types.NewBadRequestError("serial " + serialNumber + " can not go from " + string(obj.Status) + " to " + string(move.To))
+		}
+
+		obj.Status = move.To
+		obj.PickListLineID = nil
+		switch move.To {
+		case types.SerialStatusAllocated:
+			obj.PickListLineID = move.PickListLineID
+		case types.SerialStatusShipped:
+			obj.LocationID = nil
+		case types.SerialStatusInStock:
+			obj.LocationID = move.LocationID
+		}
+		obj.UpdatedAt = utils.Ref(time.Now())
+
+		if _, err := tx.ID(obj.ID).Cols("status", "location_id", "pick_list_line_id", "updated_at").Update(obj); err != nil {
+			return nil, normalizeErr("serials", err)
+		}
+
+		if err := r.record(tx, obj, move); err != nil {
+			return nil, err
+		}
+
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+// expect - whether the product is serialized and, when it is, that there's one distinct serial
+// number for every unit moved
+func (r *serialsRepo) expect(tx *xorm.Session, move types.SerialMove) (bool, error) {
+	product := &types.Product{}
+	exists, err := tx.ID(move.ProductID).Get(product)
+	if err != nil {
+		return false, normalizeErr("products", err)
+	}
+	if !exists {
+		return false, types.NewNotFoundError("product not found by id")
+	}
+
+	if !product.Serialized {
+		if len(move.SerialNumbers) > 0 {
+			return false, types.NewBadRequestError("product " + product.Sku + " isn't serialized")
+		}
+		return false, nil
+	}
+
+	if int64(len(move.SerialNumbers)) != move.Qty {
+		return false, types.NewBadRequestError(fmt.Sprintf("%d serial numbers are needed for product %s", move.Qty, product.Sku))
+	}
+
+	seen := map[string]bool{}
+	for _, serialNumber := range move.SerialNumbers {
+		if serialNumber == "" {
+			return false, types.NewBadRequestError("serial numbers can not be blank")
+		}
+		if seen[serialNumber] {
+			return false, types.NewBadRequestError("serial " + serialNumber + " is listed more than once")
+		}
+		seen[serialNumber] = true
+	}
+
+	return true, nil
+}
+
+// record - adds the serial's new status and location to its history
+func (r *serialsRepo) record(tx *xorm.Session, obj *types.Serial, move types.SerialMove) error {
+	event := &types.SerialEvent{
+		SerialID:      obj.ID,
+		Status:        obj.Status,
+		LocationID:    obj.LocationID,
+		ReferenceType: move.ReferenceType,
+		ReferenceID:   move.ReferenceID,
+		CreatedAt:     time.Now(),
+	}
+
+	if _, err := tx.Insert(event); err != nil {
+		return normalizeErr("serial_events", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Serials", func() {
+
+	var (
+		repo    repos.Serials
+		product *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("serials", "products")
+
+		repo = gr.Serials()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Serialized: true})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Receive(Tx)", func() {
+		It("should need a unique serial number for every unit", func() {
+			_, err := repo.Receive(ctx, types.SerialMove{ProductID: product.ID, Qty: 2, SerialNumbers: []string{"SN-1"}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Receive(ctx, types.SerialMove{ProductID: product.ID, Qty: 2, SerialNumbers: []string{"SN-1", "SN-1"}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			serials, err := repo.Receive(ctx, types.SerialMove{ProductID: product.ID, Qty: 2, SerialNumbers: []string{"SN-1", "SN-2"}})
+			Expect(err).To(BeNil())
+			Expect(serials).To(HaveLen(2))
+			Expect(serials[0].Status).To(Equal(types.SerialStatusInStock))
+
+			_, err = repo.Receive(ctx, types.SerialMove{ProductID: product.ID, Qty: 1, SerialNumbers: []string{"SN-2"}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should not take serial numbers for products that aren't serialized", func() {
+			other, err := gr.Products().Create(ctx, types.NewProduct{Name: "other", Sku: "other", Qty: 1})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Receive(ctx, types.SerialMove{ProductID: other.ID, Qty: 1, SerialNumbers: []string{"SN-1"}})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			serials, err := repo.Receive(ctx, types.SerialMove{ProductID: other.ID, Qty: 1})
+			Expect(err).To(BeNil())
+			Expect(serials).To(BeEmpty())
+		})
+	})
+
+	Context("Move(Tx) and History(Tx)", func() {
+		It("should follow a serial from receipt to return", func() {
+			_, err := repo.Receive(ctx, types.SerialMove{ProductID: product.ID, Qty: 1, SerialNumbers: []string{"SN-1"}})
+			Expect(err).To(BeNil())
+
+			move := func(to types.SerialStatus) error {
+				_, err := repo.Move(ctx, types.SerialMove{ProductID: product.ID, Qty: 1, SerialNumbers: []string{"SN-1"}, To: to})
+				return err
+			}
+
+			// it hasn't been picked yet
+			Expect(types.IsBadRequestError(move(types.SerialStatusShipped))).To(BeTrue())
+
+			Expect(move(types.SerialStatusAllocated)).To(Succeed())
+			Expect(move(types.SerialStatusShipped)).To(Succeed())
+			Expect(move(types.SerialStatusReturned)).To(Succeed())
+
+			_, err = repo.Move(ctx, types.SerialMove{ProductID: product.ID, Qty: 1, SerialNumbers: []string{"SN-404"}, To: types.SerialStatusAllocated})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			history, exists, err := repo.History(ctx, "SN-1")
+			Expect(err).To(BeNil())
+			Expect(exists).To(BeTrue())
+			Expect(history.Serial.Status).To(Equal(types.SerialStatusReturned))
+			Expect(history.Events).To(HaveLen(4))
+			Expect(history.Events[0].Status).To(Equal(types.SerialStatusInStock))
+			Expect(history.Events[3].Status).To(Equal(types.SerialStatusReturned))
+
+			_, exists, err = repo.History(ctx, "SN-404")
+			Expect(err).To(BeNil())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should find serials by status", func() {
+			_, err := repo.Receive(ctx, types.SerialMove{ProductID: product.ID, Qty: 2, SerialNumbers: []string{"SN-1", "SN-2"}})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Move(ctx, types.SerialMove{ProductID: product.ID, Qty: 1, SerialNumbers: []string{"SN-2"}, To: types.SerialStatusAllocated})
+			Expect(err).To(BeNil())
+
+			serials, count, err := repo.Find(ctx, &repos.SerialsFind{Statuses: []types.SerialStatus{types.SerialStatusAllocated}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(serials[0].SerialNumber).To(Equal("SN-2"))
+		})
+	})
+})
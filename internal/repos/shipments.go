@@ -0,0 +1,455 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type ShipmentsFind struct {
+	Limit         int
+	Offset        int
+	IDs           []int64
+	SalesOrderIDs []int64
+	Statuses      []types.ShipmentStatus
+}
+
+//go:generate mockgen -source=./shipments.go -destination=./mocks/Shipments.go -package=mock_repos Shipments
+type Shipments interface {
+	Find(ctx context.Context, opts *ShipmentsFind) ([]*types.Shipment, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *ShipmentsFind) ([]*types.Shipment, int64, error)
+	Get(ctx context.Context, id int64) (*types.Shipment, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Shipment, bool, error)
+	Create(ctx context.Context, newShipment types.NewShipment) (*types.Shipment, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newShipment types.NewShipment) (*types.Shipment, error)
+	Ship(ctx context.Context, ship *types.ShipShipment) (*types.Shipment, error)
+	ShipTx(ctx context.Context, tx *xorm.Session, ship *types.ShipShipment) (*types.Shipment, error)
+}
+
+// NewShipments - shipping takes stock off hand and moves the sales order along so it goes
+// through those repos to keep everything in the same transaction
+func NewShipments(db *xorm.Engine, products Products, stockLevels StockLevels, salesOrders SalesOrders, lots Lots, serials Serials, invoices Invoices) Shipments {
+	return &shipmentsRepo{db, products, stockLevels, salesOrders, lots, serials, invoices}
+}
+
+type shipmentsRepo struct {
+	db          *xorm.Engine
+	products    Products
+	stockLevels StockLevels
+	salesOrders SalesOrders
+	lots        Lots
+	serials     Serials
+	invoices    Invoices
+}
+
+// shipmentReference - the reference type shipments write to serial histories
+var shipmentReference = "shipment"
+
+func (r *shipmentsRepo) Find(ctx context.Context, opts *ShipmentsFind) ([]*types.Shipment, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return s, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Shipment), count, nil
+}
+
+func (r *shipmentsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *ShipmentsFind) ([]*types.Shipment, int64, error) {
+	if opts == nil {
+		opts = &ShipmentsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.SalesOrderIDs) > 0 {
+		tx = tx.In("sales_order_id", utils.Int64ArrToInterfaceArr(opts.SalesOrderIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.Shipment{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("shipments", err)
+	}
+
+	if err := r.loadCartons(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *shipmentsRepo) Get(ctx context.Context, id int64) (*types.Shipment, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return s, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Shipment), exists, nil
+}
+
+func (r *shipmentsRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Shipment, bool, error) {
+	obj := &types.Shipment{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("shipments", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadCartons(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *shipmentsRepo) Create(ctx context.Context, newShipment types.NewShipment) (*types.Shipment, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newShipment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Shipment), nil
+}
+
+// CreateTx - packs a picked pick list into cartons. Every unit that was picked has to be packed
+// and nothing more. Stock doesn't move until the shipment is shipped.
+func (r *shipmentsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newShipment types.NewShipment) (*types.Shipment, error) {
+	if err := types.Validate(newShipment); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	list := &types.PickList{}
+	exists, err := tx.Where("id = ?", newShipment.PickListID).ForUpdate().Get(list)
+	if err != nil {
+		return nil, normalizeErr("shipments", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("pick list not found by id")
+	}
+
+	if !list.Status.CanTransition(types.PickListStatusPacked) {
+		return nil, types.NewBadRequestError("only picked pick lists can be packed")
+	}
+
+	lines := []*types.PickListLine{}
+	if err := tx.Where("pick_list_id = ?", list.ID).Find(&lines); err != nil {
+		return nil, normalizeErr("pick_list_lines", err)
+	}
+
+	lineByID := map[int64]*types.PickListLine{}
+	for _, line := range lines {
+		lineByID[line.ID] = line
+	}
+
+	packed := map[int64]int64{}
+	for _, carton := range newShipment.Cartons {
+		for _, item := range carton.Items {
+			if _, exists := lineByID[item.PickListLineID]; !exists {
+				return nil, types.NewNotFoundError("pick list line not found on this pick list")
+			}
+			packed[item.PickListLineID] += item.Qty
+		}
+	}
+
+	for _, line := range lines {
+		if packed[line.ID] != line.QtyPicked {
+			return nil, types.NewBadRequestError("everything picked has to be packed, nothing more")
+		}
+	}
+
+	obj := &types.Shipment{
+		SalesOrderID: list.SalesOrderID,
+		PickListID:   list.ID,
+		Status:       types.ShipmentStatusPacked,
+		CreatedAt:    time.Now(),
+		Cartons:      []*types.Carton{},
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("shipments", err)
+	}
+
+	for i, nc := range newShipment.Cartons {
+		carton := &types.Carton{
+			ShipmentID:  obj.ID,
+			Reference:   nc.Reference,
+			WeightGrams: nc.WeightGrams,
+			CreatedAt:   time.Now(),
+			Items:       []*types.CartonItem{},
+		}
+
+		if carton.Reference == "" {
+			carton.Reference = fmt.Sprintf("%d of %d", i+1, len(newShipment.Cartons))
+		}
+
+		if _, err := tx.Insert(carton); err != nil {
+			return nil, normalizeErr("shipment_cartons", err)
+		}
+
+		for _, ni := range nc.Items {
+			line := lineByID[ni.PickListLineID]
+			item := &types.CartonItem{
+				CartonID:         carton.ID,
+				PickListLineID:   line.ID,
+				SalesOrderLineID: line.SalesOrderLineID,
+				ProductID:        line.ProductID,
+				Qty:              ni.Qty,
+			}
+
+			if _, err := tx.Insert(item); err != nil {
+				return nil, normalizeErr("shipment_carton_items", err)
+			}
+
+			carton.Items = append(carton.Items, item)
+		}
+
+		obj.Cartons = append(obj.Cartons, carton)
+	}
+
+	list.Status = types.PickListStatusPacked
+	list.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(list.ID).Cols("status", "updated_at").Update(list); err != nil {
+		return nil, normalizeErr("pick_lists", err)
+	}
+
+	return obj, nil
+}
+
+func (r *shipmentsRepo) Ship(ctx context.Context, ship *types.ShipShipment) (*types.Shipment, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ShipTx(ctx, tx, ship)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Shipment), nil
+}
+
+// ShipTx - hands a packed shipment to the carrier. The shipped units come off hand, from the
+// location and lot they were picked from, and off the order's allocation. Serials picked for the
+// shipment are marked shipped. The order becomes shipped once every line has gone out. What was
+// shipped is invoiced, see Invoices.CreateTx.
+func (r *shipmentsRepo) ShipTx(ctx context.Context, tx *xorm.Session, ship *types.ShipShipment) (*types.Shipment, error) {
+	if err := types.Validate(ship); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj := &types.Shipment{}
+	exists, err := tx.Where("id = ?", ship.ID).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("shipments", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("shipment not found by id")
+	}
+
+	if obj.Status != types.ShipmentStatusPacked {
+		return nil, types.NewBadRequestError("only packed shipments can be shipped")
+	}
+
+	// lock the order before touching its lines
+	if _, err := tx.Where("id = ?", obj.SalesOrderID).ForUpdate().Get(&types.SalesOrder{}); err != nil {
+		return nil, normalizeErr("sales_orders", err)
+	}
+
+	if err := r.loadCartons(tx, obj); err != nil {
+		return nil, err
+	}
+
+	lines := []*types.PickListLine{}
+	if err := tx.Where("pick_list_id = ?", obj.PickListID).Find(&lines); err != nil {
+		return nil, normalizeErr("pick_list_lines", err)
+	}
+
+	for _, line := range lines {
+		if line.QtyPicked == 0 {
+			continue
+		}
+
+		if line.LocationID != nil {
+			// allocated has to come down first, it can never be more than what's on hand
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{Allocated: -line.QtyPicked}); err != nil {
+				return nil, err
+			}
+			if _, err := r.stockLevels.AdjustTx(ctx, tx, line.ProductID, *line.LocationID, -line.QtyPicked, line.LotID); err != nil {
+				return nil, err
+			}
+		} else {
+			// stock put away at locations or in transit can't leave without its location knowing
+			located, err := tx.Where("product_id = ?", line.ProductID).SumInt(&types.StockLevel{}, "qty")
+			if err != nil {
+				return nil, normalizeErr("stock_levels", err)
+			}
+
+			product, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{
+				Qty: -line.QtyPicked, Allocated: -line.QtyPicked,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if product.Qty-product.InTransit < located {
+				return nil, types.NewBadRequestError("not enough stock outside of locations, pick it from a location")
+			}
+
+			if line.LotID != nil {
+				if _, err := r.lots.AdjustTx(ctx, tx, *line.LotID, -line.QtyPicked); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		serials, _, err := r.serials.FindTx(ctx, tx, &SerialsFind{PickListLineIDs: []int64{line.ID}})
+		if err != nil {
+			return nil, err
+		}
+		if len(serials) > 0 {
+			serialNumbers := []string{}
+			for _, serial := range serials {
+				serialNumbers = append(serialNumbers, serial.SerialNumber)
+			}
+
+			if _, err := r.serials.MoveTx(ctx, tx, types.SerialMove{
+				ProductID:     line.ProductID,
+				Qty:           line.QtyPicked,
+				SerialNumbers: serialNumbers,
+				To:            types.SerialStatusShipped,
+				ReferenceType: &shipmentReference,
+				ReferenceID:   &obj.ID,
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		orderLine := &types.SalesOrderLine{}
+		if _, err := tx.Where("id = ?", line.SalesOrderLineID).Get(orderLine); err != nil {
+			return nil, normalizeErr("sales_order_lines", err)
+		}
+
+		orderLine.QtyAllocated -= line.QtyPicked
+		orderLine.QtyShipped += line.QtyPicked
+		if _, err := tx.ID(orderLine.ID).Cols("qty_allocated", "qty_shipped").Update(orderLine); err != nil {
+			return nil, normalizeErr("sales_order_lines", err)
+		}
+	}
+
+	status := types.SalesOrderStatusShipped
+	unshipped, err := tx.Where("sales_order_id = ? AND qty_shipped < qty", obj.SalesOrderID).Exist(&types.SalesOrderLine{})
+	if err != nil {
+		return nil, normalizeErr("sales_order_lines", err)
+	}
+	if unshipped {
+		status = types.SalesOrderStatusPartiallyShipped
+	}
+
+	// the pick list goes first, an order can't be left with pick lists in progress once it's shipped
+	if _, err := tx.Table("pick_lists").Where("id = ?", obj.PickListID).
+		Update(map[string]interface{}{"status": types.PickListStatusShipped, "updated_at": time.Now()}); err != nil {
+		return nil, normalizeErr("pick_lists", err)
+	}
+
+	if _, err := r.salesOrders.TransitionTx(ctx, tx, obj.SalesOrderID, status); err != nil {
+		return nil, err
+	}
+
+	obj.Status = types.ShipmentStatusShipped
+	obj.Carrier = utils.Ref(ship.Carrier)
+	obj.TrackingNumber = utils.Ref(ship.TrackingNumber)
+	obj.ShippedAt = utils.Ref(time.Now())
+	obj.UpdatedAt = obj.ShippedAt
+
+	if _, err := tx.ID(obj.ID).Cols("status", "carrier", "tracking_number", "shipped_at", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("shipments", err)
+	}
+
+	if _, err := r.invoices.CreateTx(ctx, tx, obj.ID); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *shipmentsRepo) loadCartons(tx *xorm.Session, shipments ...*types.Shipment) error {
+	if len(shipments) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.Shipment{}
+	ids := []int64{}
+	for _, shipment := range shipments {
+		shipment.Cartons = []*types.Carton{}
+		byID[shipment.ID] = shipment
+		ids = append(ids, shipment.ID)
+	}
+
+	cartons := []*types.Carton{}
+	if err := tx.In("shipment_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&cartons); err != nil {
+		return normalizeErr("shipment_cartons", err)
+	}
+
+	if len(cartons) == 0 {
+		return nil
+	}
+
+	cartonByID := map[int64]*types.Carton{}
+	cartonIDs := []int64{}
+	for _, carton := range cartons {
+		carton.Items = []*types.CartonItem{}
+		cartonByID[carton.ID] = carton
+		cartonIDs = append(cartonIDs, carton.ID)
+		byID[carton.ShipmentID].Cartons = append(byID[carton.ShipmentID].Cartons, carton)
+	}
+
+	items := []*types.CartonItem{}
+	if err := tx.In("carton_id", utils.Int64ArrToInterfaceArr(cartonIDs...)...).OrderBy("id").Find(&items); err != nil {
+		return normalizeErr("shipment_carton_items", err)
+	}
+
+	for _, item := range items {
+		cartonByID[item.CartonID].Items = append(cartonByID[item.CartonID].Items, item)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Shipments", func() {
+
+	var (
+		repo     repos.Shipments
+		product  *types.Product
+		location *types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("shipments", "pick_lists", "sales_orders", "stock_levels", "locations", "products")
+
+		repo = gr.Shipments()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 4})
+		Expect(err).To(BeNil())
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(6))})
+		Expect(err).To(BeNil())
+	})
+
+	// confirms an order then picks it, picked overrides the qty picked on every line
+	pickedList := func(qty int64, picked ...types.PickedLine) (*types.SalesOrder, *types.PickList) {
+		order, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+			CustomerName: "Jane Doe",
+			Lines:        []types.NewSalesOrderLine{{ProductID: product.ID, Qty: qty, UnitPrice: 1999}},
+		})
+		Expect(err).To(BeNil())
+
+		order, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+		Expect(err).To(BeNil())
+
+		list, err := gr.PickLists().Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+		Expect(err).To(BeNil())
+
+		if len(picked) > 0 {
+			picked[0].PickListLineID = list.Lines[0].ID
+		}
+
+		list, err = gr.PickLists().Pick(ctx, &types.PickPickList{ID: list.ID, Lines: picked})
+		Expect(err).To(BeNil())
+		return order, list
+	}
+
+	pack := func(list *types.PickList) *types.Shipment {
+		items := []types.NewCartonItem{}
+		for _, line := range list.Lines {
+			if line.QtyPicked > 0 {
+				items = append(items, types.NewCartonItem{PickListLineID: line.ID, Qty: line.QtyPicked})
+			}
+		}
+
+		shipment, err := repo.Create(ctx, types.NewShipment{
+			PickListID: list.ID,
+			Cartons:    []types.NewCarton{{WeightGrams: 1200, Items: items}},
+		})
+		Expect(err).To(BeNil())
+		return shipment
+	}
+
+	Context("Create(Tx)", func() {
+		It("should only pack picked lists", func() {
+			order, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+				CustomerName: "Jane Doe",
+				Lines:        []types.NewSalesOrderLine{{ProductID: product.ID, Qty: 1}},
+			})
+			Expect(err).To(BeNil())
+			_, err = gr.SalesOrders().Transition(ctx, order.ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			list, err := gr.PickLists().Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewShipment{
+				PickListID: list.ID,
+				Cartons:    []types.NewCarton{{Items: []types.NewCartonItem{{PickListLineID: list.Lines[0].ID, Qty: 1}}}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should require everything picked to be packed", func() {
+			_, list := pickedList(3)
+
+			_, err := repo.Create(ctx, types.NewShipment{
+				PickListID: list.ID,
+				Cartons:    []types.NewCarton{{Items: []types.NewCartonItem{{PickListLineID: list.Lines[0].ID, Qty: 2}}}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should pack into cartons without moving stock", func() {
+			_, list := pickedList(8)
+
+			shipment := pack(list)
+			Expect(shipment.Status).To(Equal(types.ShipmentStatusPacked))
+			Expect(shipment.Cartons).To(HaveLen(1))
+			Expect(shipment.Cartons[0].Reference).To(Equal("1 of 1"))
+			Expect(shipment.Cartons[0].Items).To(HaveLen(2))
+
+			p, _, err := gr.Products().Get(ctx, product.ID)
+			Expect(err).To(BeNil())
+			Expect(p.Qty).To(BeNumerically("==", 10))
+			Expect(p.Allocated).To(BeNumerically("==", 8))
+		})
+	})
+
+	Context("Ship(Tx)", func() {
+		It("should take the stock off hand and ship the order", func() {
+			order, list := pickedList(8)
+
+			shipment, err := repo.Ship(ctx, &types.ShipShipment{ID: pack(list).ID, Carrier: "UPS", TrackingNumber: "1Z999"})
+			Expect(err).To(BeNil())
+			Expect(shipment.Status).To(Equal(types.ShipmentStatusShipped))
+			Expect(*shipment.TrackingNumber).To(Equal("1Z999"))
+			Expect(shipment.ShippedAt).NotTo(BeNil())
+
+			p, _, err := gr.Products().Get(ctx, product.ID)
+			Expect(err).To(BeNil())
+			Expect(p.Qty).To(BeNumerically("==", 2))
+			Expect(p.Allocated).To(BeNumerically("==", 0))
+
+			level, _, err := gr.StockLevels().Get(ctx, product.ID, location.ID)
+			Expect(err).To(BeNil())
+			Expect(level.Qty).To(BeNumerically("==", 0))
+
+			order, _, err = gr.SalesOrders().Get(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.SalesOrderStatusShipped))
+			Expect(order.Lines[0].QtyShipped).To(BeNumerically("==", 8))
+
+			list, _, err = gr.PickLists().Get(ctx, list.ID)
+			Expect(err).To(BeNil())
+			Expect(list.Status).To(Equal(types.PickListStatusShipped))
+		})
+
+		It("should partially ship a short pick and leave the rest allocated", func() {
+			order, list := pickedList(3, types.PickedLine{QtyPicked: 1})
+
+			_, err := repo.Ship(ctx, &types.ShipShipment{ID: pack(list).ID, Carrier: "UPS", TrackingNumber: "1Z999"})
+			Expect(err).To(BeNil())
+
+			order, _, err = gr.SalesOrders().Get(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.SalesOrderStatusPartiallyShipped))
+			Expect(order.Lines[0].QtyShipped).To(BeNumerically("==", 1))
+			Expect(order.Lines[0].QtyAllocated).To(BeNumerically("==", 2))
+
+			// the rest can go on a new list
+			list, err = gr.PickLists().Create(ctx, types.NewPickList{SalesOrderID: order.ID})
+			Expect(err).To(BeNil())
+			Expect(list.Lines[0].Qty).To(BeNumerically("==", 2))
+		})
+
+		It("should not ship twice", func() {
+			_, list := pickedList(1)
+			shipment := pack(list)
+
+			_, err := repo.Ship(ctx, &types.ShipShipment{ID: shipment.ID, Carrier: "UPS", TrackingNumber: "1Z999"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Ship(ctx, &types.ShipShipment{ID: shipment.ID, Carrier: "UPS", TrackingNumber: "1Z999"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+})
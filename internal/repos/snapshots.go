@@ -0,0 +1,169 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type SnapshotsFind struct {
+	Limit  int
+	Offset int
+}
+
+//go:generate mockgen -source=./snapshots.go -destination=./mocks/Snapshots.go -package=mock_repos Snapshots
+type Snapshots interface {
+	Find(ctx context.Context, opts *SnapshotsFind) ([]*types.StockSnapshot, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *SnapshotsFind) ([]*types.StockSnapshot, int64, error)
+	Take(ctx context.Context) (*types.StockSnapshot, error)
+	TakeTx(ctx context.Context, tx *xorm.Session) (*types.StockSnapshot, error)
+}
+
+func NewSnapshots(db *xorm.Engine) Snapshots {
+	return &snapshotsRepo{db}
+}
+
+type snapshotsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *snapshotsRepo) Find(ctx context.Context, opts *SnapshotsFind) ([]*types.StockSnapshot, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return s, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.StockSnapshot), count, nil
+}
+
+// FindTx - newest first
+func (r *snapshotsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *SnapshotsFind) ([]*types.StockSnapshot, int64, error) {
+	if opts == nil {
+		opts = &SnapshotsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	objs := []*types.StockSnapshot{}
+	count, err := tx.Desc("taken_at").Desc("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("stock_snapshots", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *snapshotsRepo) Take(ctx context.Context) (*types.StockSnapshot, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.TakeTx(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.StockSnapshot), nil
+}
+
+// TakeTx - records what's on hand right now at every location and outside of them. It has to be
+// the first thing its transaction does. The transaction reads every table as they stood when it
+// began and that's when the snapshot is taken, so a qty change committed while it runs is either
+// in the snapshot or after it for stockAsOfTx, never both.
+func (r *snapshotsRepo) TakeTx(ctx context.Context, tx *xorm.Session) (*types.StockSnapshot, error) {
+	if _, err := tx.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ"); err != nil {
+		return nil, normalizeErr("stock_snapshots", err)
+	}
+
+	obj := &types.StockSnapshot{CreatedAt: time.Now()}
+	// the first query fixes what the transaction sees, now() is when the transaction began
+	if _, err := tx.SQL("SELECT now()").Get(&obj.TakenAt); err != nil {
+		return nil, normalizeErr("stock_snapshots", err)
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("stock_snapshots", err)
+	}
+
+	res, err := tx.Exec(`
+		INSERT INTO stock_snapshot_lines (snapshot_id, product_id, location_id, qty)
+		SELECT ?, s.product_id, s.location_id, s.qty FROM stock_levels s WHERE s.qty != 0
+		UNION ALL
+		SELECT ?, p.id, NULL, p.qty - COALESCE(SUM(s.qty), 0)
+		FROM products p
+		LEFT JOIN stock_levels s ON s.product_id = p.id
+		GROUP BY p.id
+		HAVING p.qty - COALESCE(SUM(s.qty), 0) != 0`, obj.ID, obj.ID)
+	if err != nil {
+		return nil, normalizeErr("stock_snapshot_lines", err)
+	}
+
+	if obj.Lines, err = res.RowsAffected(); err != nil {
+		return nil, normalizeErr("stock_snapshot_lines", err)
+	}
+	if _, err := tx.ID(obj.ID).Cols("lines").Update(obj); err != nil {
+		return nil, normalizeErr("stock_snapshots", err)
+	}
+
+	return obj, nil
+}
+
+// stockAsOf - qty on hand of a product at a location, or outside of them when LocationID is nil
+type stockAsOf struct {
+	ProductID  int64  `xorm:"product_id"`
+	LocationID *int64 `xorm:"location_id"`
+	Qty        int64  `xorm:"qty"`
+}
+
+// stockAsOfTx - rebuilds what was on hand at asOf for these products, all of them when there
+// aren't any, from the last snapshot taken by then and every qty change after it. Pairs with
+// nothing on hand are left out.
+func stockAsOfTx(tx *xorm.Session, asOf time.Time, productIDs []int64) ([]*stockAsOf, error) {
+	// the products are filtered on both sides of the union
+	filter := func(column string) string {
+		if len(productIDs) == 0 {
+			return ""
+		}
+		return " AND " + column + " IN (" + strings.TrimSuffix(strings.Repeat("?,", len(productIDs)), ",") + ")"
+	}
+	ids := utils.Int64ArrToInterfaceArr(productIDs...)
+	args := append([]interface{}{asOf}, ids...)
+	args = append(append(args, asOf), ids...)
+
+	objs := []*stockAsOf{}
+	if err := tx.SQL(`
+		WITH snapshot AS (
+			SELECT id, taken_at FROM stock_snapshots WHERE taken_at <= ? ORDER BY taken_at DESC, id DESC LIMIT 1
+		)
+		SELECT product_id, location_id, SUM(qty) AS qty FROM (
+			SELECT l.product_id, l.location_id, l.qty
+			FROM stock_snapshot_lines l
+			WHERE l.snapshot_id IN (SELECT id FROM snapshot)`+filter("l.product_id")+`
+			UNION ALL
+			SELECT e.product_id, e.location_id, e.qty
+			FROM valuation_entries e
+			WHERE e.created_at <= ? AND e.created_at > COALESCE((SELECT taken_at FROM snapshot), '-infinity')`+filter("e.product_id")+`
+		) stock
+		GROUP BY product_id, location_id
+		HAVING SUM(qty) != 0`, args...).Find(&objs); err != nil {
+		return nil, normalizeErr("stock_snapshot_lines", err)
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,119 @@
+package repos_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Snapshots", func() {
+
+	var (
+		repo     repos.Snapshots
+		product  *types.Product
+		location *types.Location
+		created  time.Time
+		stocked  time.Time
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_snapshots", "locations", "products")
+
+		repo = gr.Snapshots()
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "widget", Sku: "widget", Qty: 10})
+		Expect(err).To(BeNil())
+		created = time.Now()
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		_, err = gr.StockLevels().Adjust(ctx, product.ID, location.ID, 4, nil)
+		Expect(err).To(BeNil())
+		stocked = time.Now()
+
+		_, err = gr.Products().Adjust(ctx, product.ID, types.ProductAdjustment{Qty: -3})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Take(Tx)", func() {
+		It("should snapshot what's at each location and outside of them", func() {
+			snapshot, err := repo.Take(ctx)
+			Expect(err).To(BeNil())
+			Expect(snapshot.Lines).To(BeNumerically("==", 2))
+
+			lines := []*types.StockSnapshotLine{}
+			Expect(gr.DB().Where("snapshot_id = ?", snapshot.ID).OrderBy("location_id NULLS FIRST").Find(&lines)).To(Succeed())
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0].LocationID).To(BeNil())
+			Expect(lines[0].Qty).To(BeNumerically("==", 7))
+			Expect(*lines[1].LocationID).To(Equal(location.ID))
+			Expect(lines[1].Qty).To(BeNumerically("==", 4))
+
+			snapshots, count, err := repo.Find(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(snapshots[0].ID).To(Equal(snapshot.ID))
+		})
+
+		It("should be taken when its transaction began", func() {
+			session := gr.DB().NewSession()
+			defer session.Close()
+			Expect(session.Begin()).To(Succeed())
+
+			began := time.Now()
+			time.Sleep(100 * time.Millisecond)
+
+			snapshot, err := repo.TakeTx(ctx, session)
+			Expect(err).To(BeNil())
+			Expect(session.Commit()).To(Succeed())
+			Expect(snapshot.TakenAt).To(BeTemporally("~", began, 50*time.Millisecond))
+		})
+	})
+
+	Context("as of", func() {
+		It("should rebuild a product's qty at any time", func() {
+			products, _, err := gr.Products().Find(ctx, &repos.ProductsFind{IDs: []int64{product.ID}, AsOf: &created})
+			Expect(err).To(BeNil())
+			Expect(products).To(HaveLen(1))
+			Expect(products[0].Qty).To(BeNumerically("==", 10))
+
+			products, _, err = gr.Products().Find(ctx, &repos.ProductsFind{IDs: []int64{product.ID}, AsOf: &stocked})
+			Expect(err).To(BeNil())
+			Expect(products[0].Qty).To(BeNumerically("==", 14))
+
+			before := product.CreatedAt.Add(-time.Hour)
+			products, _, err = gr.Products().Find(ctx, &repos.ProductsFind{IDs: []int64{product.ID}, AsOf: &before})
+			Expect(err).To(BeNil())
+			Expect(products).To(BeEmpty())
+		})
+
+		It("should rebuild the stock at a location", func() {
+			levels, _, err := gr.StockLevels().Find(ctx, &repos.StockLevelsFind{LocationIDs: []int64{location.ID}, AsOf: &stocked})
+			Expect(err).To(BeNil())
+			Expect(levels).To(HaveLen(1))
+			Expect(levels[0].Qty).To(BeNumerically("==", 4))
+		})
+
+		It("should start from the last snapshot", func() {
+			snapshot, err := repo.Take(ctx)
+			Expect(err).To(BeNil())
+
+			_, err = gr.Products().Adjust(ctx, product.ID, types.ProductAdjustment{Qty: 5})
+			Expect(err).To(BeNil())
+
+			// nothing before the snapshot is needed once it's been taken
+			_, err = gr.DB().Exec("DELETE FROM valuation_entries WHERE created_at <= ?", snapshot.TakenAt)
+			Expect(err).To(BeNil())
+
+			now := time.Now()
+			products, _, err := gr.Products().Find(ctx, &repos.ProductsFind{IDs: []int64{product.ID}, AsOf: &now})
+			Expect(err).To(BeNil())
+			Expect(products[0].Qty).To(BeNumerically("==", 16))
+		})
+	})
+})
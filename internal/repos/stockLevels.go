@@ -0,0 +1,429 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type StockLevelsFind struct {
+	Limit       int
+	Offset      int
+	ProductIDs  []int64
+	LocationIDs []int64
+	// WithinLocationIDs - stock at these locations and anything inside them, like every bin in a warehouse
+	WithinLocationIDs []int64
+	// AsOf - stock levels that existed by this time with Qty rebuilt to what was held then, see
+	// Snapshots
+	AsOf *time.Time
+}
+
+//go:generate mockgen -source=./stockLevels.go -destination=./mocks/StockLevels.go -package=mock_repos StockLevels
+type StockLevels interface {
+	Find(ctx context.Context, opts *StockLevelsFind) ([]*types.StockLevel, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *StockLevelsFind) ([]*types.StockLevel, int64, error)
+	Get(ctx context.Context, productID, locationID int64) (*types.StockLevel, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, productID, locationID int64) (*types.StockLevel, bool, error)
+	Set(ctx context.Context, set types.SetStockLevel) (*types.StockLevel, error)
+	SetTx(ctx context.Context, tx *xorm.Session, set types.SetStockLevel) (*types.StockLevel, error)
+	Adjust(ctx context.Context, productID, locationID, delta int64, lotID *int64) (*types.StockLevel, error)
+	AdjustTx(ctx context.Context, tx *xorm.Session, productID, locationID, delta int64, lotID *int64) (*types.StockLevel, error)
+	Receive(ctx context.Context, productID, locationID, qty, unitCost int64) (*types.StockLevel, error)
+	ReceiveTx(ctx context.Context, tx *xorm.Session, productID, locationID, qty, unitCost int64) (*types.StockLevel, error)
+	Move(ctx context.Context, productID, locationID, delta int64) (*types.StockLevel, error)
+	MoveTx(ctx context.Context, tx *xorm.Session, productID, locationID, delta int64) (*types.StockLevel, error)
+}
+
+// NewStockLevels - products is used to keep each product's total in step with
+// what is held at its locations
+func NewStockLevels(db *xorm.Engine, products Products, observers ...StockObserver) StockLevels {
+	return &stockLevelsRepo{db, products, observers}
+}
+
+type stockLevelsRepo struct {
+	db        *xorm.Engine
+	products  Products
+	observers []StockObserver
+}
+
+func (r *stockLevelsRepo) Find(ctx context.Context, opts *StockLevelsFind) ([]*types.StockLevel, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return s, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.StockLevel), count, nil
+}
+
+func (r *stockLevelsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *StockLevelsFind) ([]*types.StockLevel, int64, error) {
+	if opts == nil {
+		opts = &StockLevelsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.LocationIDs) > 0 {
+		tx = tx.In("location_id", utils.Int64ArrToInterfaceArr(opts.LocationIDs...)...)
+	}
+
+	if len(opts.WithinLocationIDs) > 0 {
+		tx = tx.Where("location_id IN ("+withinLocationsSQL(len(opts.WithinLocationIDs))+")",
+			utils.Int64ArrToInterfaceArr(opts.WithinLocationIDs...)...)
+	}
+
+	if opts.AsOf != nil {
+		tx = tx.Where("created_at <= ?", *opts.AsOf)
+	}
+
+	objs := []*types.StockLevel{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("stock_levels", err)
+	}
+
+	if opts.AsOf != nil && len(objs) > 0 {
+		ids := make([]int64, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ProductID
+		}
+
+		stock, err := stockAsOfTx(tx, *opts.AsOf, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, obj := range objs {
+			obj.Qty = 0
+			for _, s := range stock {
+				if s.ProductID == obj.ProductID && s.LocationID != nil && *s.LocationID == obj.LocationID {
+					obj.Qty = s.Qty
+				}
+			}
+		}
+	}
+
+	return objs, count, nil
+}
+
+func (r *stockLevelsRepo) Get(ctx context.Context, productID, locationID int64) (*types.StockLevel, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		s, ex, e := r.GetTx(ctx, tx, productID, locationID)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return s, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.StockLevel), exists, nil
+}
+
+func (r *stockLevelsRepo) GetTx(ctx context.Context, tx *xorm.Session, productID, locationID int64) (*types.StockLevel, bool, error) {
+	obj := &types.StockLevel{}
+	exists, err := tx.Where("product_id = ? AND location_id = ?", productID, locationID).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("stock_levels", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *stockLevelsRepo) Set(ctx context.Context, set types.SetStockLevel) (*types.StockLevel, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.SetTx(ctx, tx, set)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.StockLevel), nil
+}
+
+// SetTx - creates or updates the stock level for a product at a location. Stock at a
+// location is part of the product's total so any change in qty is applied there too, and to
+// the lot it was set against for lot tracked products.
+func (r *stockLevelsRepo) SetTx(ctx context.Context, tx *xorm.Session, set types.SetStockLevel) (*types.StockLevel, error) {
+	return r.setTx(ctx, tx, set, true)
+}
+
+// setTx - SetTx, keepLots is false for receipts which put their stock into a lot themselves and
+// for moves which don't change how much is in any lot
+func (r *stockLevelsRepo) setTx(ctx context.Context, tx *xorm.Session, set types.SetStockLevel, keepLots bool) (*types.StockLevel, error) {
+	if err := types.Validate(set); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	exists, err := tx.Table("locations").Where("id = ?", set.LocationID).Exist()
+	if err != nil {
+		return nil, normalizeErr("stock_levels", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("location not found by id")
+	}
+
+	obj := &types.StockLevel{}
+	exists, err = tx.Where("product_id = ? AND location_id = ?", set.ProductID, set.LocationID).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("stock_levels", err)
+	}
+	if !exists {
+		obj = &types.StockLevel{
+			ProductID:  set.ProductID,
+			LocationID: set.LocationID,
+			CreatedAt:  time.Now(),
+		}
+	}
+
+	before := obj.Qty
+	if set.Qty != nil {
+		obj.Qty = *set.Qty
+	}
+
+	if set.ReorderPoint != nil {
+		obj.ReorderPoint = *set.ReorderPoint
+	}
+
+	if set.ReorderQty != nil {
+		obj.ReorderQty = *set.ReorderQty
+	}
+
+	// this also makes sure the product exists
+	product, err := r.products.AdjustTx(ctx, tx, set.ProductID, types.ProductAdjustment{
+		Qty: obj.Qty - before, LocationID: utils.Ref(set.LocationID), UnitCost: set.UnitCost, Move: set.Move,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if keepLots {
+		if err := keepLotTx(tx, product, set.LotID, obj.Qty-before); err != nil {
+			return nil, err
+		}
+	}
+
+	if obj.Qty != before && len(r.observers) > 0 {
+		change := types.StockChange{
+			ProductID:    product.ID,
+			Sku:          product.Sku,
+			Name:         product.Name,
+			LocationID:   utils.Ref(obj.LocationID),
+			Before:       before,
+			After:        obj.Qty,
+			ReorderPoint: obj.ReorderPoint,
+			ReorderQty:   obj.ReorderQty,
+		}
+		tx = tx.After(func(interface{}) {
+			notify(ctx, r.observers, change)
+		})
+	}
+
+	if !exists {
+		if _, err := tx.Insert(obj); err != nil {
+			return nil, normalizeErr("stock_levels", err)
+		}
+		return obj, nil
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).Cols("qty", "reorder_point", "reorder_qty", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("stock_levels", err)
+	}
+
+	return obj, nil
+}
+
+func (r *stockLevelsRepo) Adjust(ctx context.Context, productID, locationID, delta int64, lotID *int64) (*types.StockLevel, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.AdjustTx(ctx, tx, productID, locationID, delta, lotID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.StockLevel), nil
+}
+
+// AdjustTx - moves the stock level at a location by delta rather than setting it outright. lotID
+// is the lot the units went into or came out of, it's needed for lot tracked products.
+func (r *stockLevelsRepo) AdjustTx(ctx context.Context, tx *xorm.Session, productID, locationID, delta int64, lotID *int64) (*types.StockLevel, error) {
+	return r.adjustTx(ctx, tx, types.SetStockLevel{ProductID: productID, LocationID: locationID, LotID: lotID}, delta, true)
+}
+
+func (r *stockLevelsRepo) Receive(ctx context.Context, productID, locationID, qty, unitCost int64) (*types.StockLevel, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReceiveTx(ctx, tx, productID, locationID, qty, unitCost)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.StockLevel), nil
+}
+
+// ReceiveTx - adds qty bought or made at a known unit cost to a location, the caller puts it
+// into a lot when the product is lot tracked
+func (r *stockLevelsRepo) ReceiveTx(ctx context.Context, tx *xorm.Session, productID, locationID, qty, unitCost int64) (*types.StockLevel, error) {
+	return r.adjustTx(ctx, tx, types.SetStockLevel{ProductID: productID, LocationID: locationID, UnitCost: &unitCost}, qty, false)
+}
+
+func (r *stockLevelsRepo) Move(ctx context.Context, productID, locationID, delta int64) (*types.StockLevel, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.MoveTx(ctx, tx, productID, locationID, delta)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.StockLevel), nil
+}
+
+// MoveTx - AdjustTx for one side of stock moving between locations, or in and out of transit,
+// the caller makes the other side in the same transaction. Its value goes with it.
+func (r *stockLevelsRepo) MoveTx(ctx context.Context, tx *xorm.Session, productID, locationID, delta int64) (*types.StockLevel, error) {
+	return r.adjustTx(ctx, tx, types.SetStockLevel{ProductID: productID, LocationID: locationID, Move: true}, delta, false)
+}
+
+func (r *stockLevelsRepo) adjustTx(ctx context.Context, tx *xorm.Session, set types.SetStockLevel, delta int64, keepLots bool) (*types.StockLevel, error) {
+	obj := &types.StockLevel{}
+	if _, err := tx.Where("product_id = ? AND location_id = ?", set.ProductID, set.LocationID).ForUpdate().Get(obj); err != nil {
+		return nil, normalizeErr("stock_levels", err)
+	}
+
+	if obj.Qty+delta < 0 {
+		return nil, types.NewBadRequestError("not enough stock at location")
+	}
+
+	set.Qty = utils.Ref(obj.Qty + delta)
+	return r.setTx(ctx, tx, set, keepLots)
+}
+
+// withinLocationsSQL - selects the ids of n locations and everything nested inside them
+func withinLocationsSQL(n int) string {
+	return `WITH RECURSIVE tree AS (
+		SELECT id FROM locations WHERE id IN (` + strings.TrimSuffix(strings.Repeat("?,", n), ",") + `)
+		UNION SELECT l.id FROM locations l INNER JOIN tree t ON l.parent_id = t.id
+	) SELECT id FROM tree`
+}
+
+// consumeStock - takes qty of a product off hand for something it's used up in, like a kit or a
+// work order. It has to be available, it's taken from the locations with the most free stock first
+// and then from stock that isn't held at a location, the same way a pick list would find it. Each
+// take is written to the ledger as movement with its product, location and qty filled in. It returns
+// what the stock it took was worth.
+func consumeStock(ctx context.Context, tx *xorm.Session, products Products, stockLevels StockLevels, stockMovements StockMovements,
+	productID, qty int64, movement types.NewStockMovement) (int64, error) {
+	product := &types.Product{}
+	exists, err := tx.Where("id = ?", productID).ForUpdate().Get(product)
+	if err != nil {
+		return 0, normalizeErr("products", err)
+	}
+	if !exists {
+		return 0, types.NewNotFoundError("product not found by id")
+	}
+
+	if product.Available() < qty {
+		return 0, types.NewBadRequestError("not enough " + product.Sku + " available")
+	}
+
+	_, before, err := stockValueTx(tx, product.ID)
+	if err != nil {
+		return 0, err
+	}
+	consumed := func() (int64, error) {
+		_, after, err := stockValueTx(tx, product.ID)
+		return before - after, err
+	}
+
+	record := func(locationID *int64, qty int64) error {
+		movement.ProductID, movement.LocationID, movement.Qty = product.ID, locationID, -qty
+		_, err := stockMovements.CreateTx(ctx, tx, movement)
+		return err
+	}
+
+	sources := []struct {
+		LocationID int64 `xorm:"location_id"`
+		Free       int64 `xorm:"free"`
+	}{}
+	if err := tx.SQL(`
+		SELECT s.location_id, s.qty - (
+			SELECT `+pendingQty+` FROM pick_list_lines pll
+			INNER JOIN pick_lists pl ON pl.id = pll.pick_list_id
+			WHERE pll.product_id = s.product_id AND pll.location_id = s.location_id AND pl.status IN (?, ?, ?)
+		) AS free
+		FROM stock_levels s
+		WHERE s.product_id = ? AND s.qty > 0
+		ORDER BY free DESC, s.location_id`,
+		types.PickListStatusOpen, types.PickListStatusPicked, types.PickListStatusPacked, product.ID,
+	).Find(&sources); err != nil {
+		return 0, normalizeErr("stock_levels", err)
+	}
+
+	for _, source := range sources {
+		if qty == 0 || source.Free <= 0 {
+			break
+		}
+
+		take := min(qty, source.Free)
+		if _, err := stockLevels.AdjustTx(ctx, tx, product.ID, source.LocationID, -take, nil); err != nil {
+			return 0, err
+		}
+		if err := record(utils.Ref(source.LocationID), take); err != nil {
+			return 0, err
+		}
+		qty -= take
+	}
+
+	if qty == 0 {
+		return consumed()
+	}
+
+	// stock put away at locations or in transit can't be used without its location knowing
+	located, err := tx.Where("product_id = ?", product.ID).SumInt(&types.StockLevel{}, "qty")
+	if err != nil {
+		return 0, normalizeErr("stock_levels", err)
+	}
+
+	product, err = products.AdjustTx(ctx, tx, product.ID, types.ProductAdjustment{Qty: -qty})
+	if err != nil {
+		return 0, err
+	}
+	if product.Qty-product.InTransit < located {
+		return 0, types.NewBadRequestError("not enough free " + product.Sku + " outside of locations")
+	}
+
+	if err := record(nil, qty); err != nil {
+		return 0, err
+	}
+
+	return consumed()
+}
@@ -0,0 +1,151 @@
+package repos_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type recordingObserver struct {
+	mu      sync.Mutex
+	changes []types.StockChange
+}
+
+func (o *recordingObserver) StockChanged(ctx context.Context, change types.StockChange) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.changes = append(o.changes, change)
+}
+
+func (o *recordingObserver) Changes() []types.StockChange {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]types.StockChange{}, o.changes...)
+}
+
+var _ = Describe("REPOS: StockLevels", func() {
+
+	var (
+		repo     repos.StockLevels
+		observer *recordingObserver
+		product  *types.Product
+		location *types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_levels", "locations", "lots", "products")
+
+		observer = &recordingObserver{}
+		repo = repos.NewStockLevels(gr.DB(), repos.NewProducts(gr.DB(), observer), observer)
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10, ReorderPoint: 12})
+		Expect(err).To(BeNil())
+
+		location, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Set(Tx)", func() {
+		It("should fail for an unknown product or location", func() {
+			_, err := repo.Set(ctx, types.SetStockLevel{ProductID: 99999999, LocationID: location.ID, Qty: utils.Ref(int64(1))})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: 99999999, Qty: utils.Ref(int64(1))})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should not allow a negative qty", func() {
+			_, err := repo.Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(-1))})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should create then update the level and keep the product total in step", func() {
+			level, err := repo.Set(ctx, types.SetStockLevel{
+				ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(8)), ReorderPoint: utils.Ref(int64(3)),
+			})
+			Expect(err).To(BeNil())
+			Expect(level.Qty).To(BeNumerically("==", 8))
+
+			p, _, err := gr.Products().Get(ctx, product.ID)
+			Expect(err).To(BeNil())
+			Expect(p.Qty).To(BeNumerically("==", 18))
+
+			level, err = repo.Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(2))})
+			Expect(err).To(BeNil())
+			Expect(level.Qty).To(BeNumerically("==", 2))
+			Expect(level.ReorderPoint).To(BeNumerically("==", 3))
+
+			p, _, err = gr.Products().Get(ctx, product.ID)
+			Expect(err).To(BeNil())
+			Expect(p.Qty).To(BeNumerically("==", 12))
+		})
+
+		It("should tell observers about both the location and the product total", func() {
+			_, err := repo.Set(ctx, types.SetStockLevel{
+				ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(8)), ReorderPoint: utils.Ref(int64(3)),
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(2))})
+			Expect(err).To(BeNil())
+
+			changes := observer.Changes()
+			Expect(changes).To(HaveLen(4))
+
+			var crossed []types.StockChange
+			for _, c := range changes {
+				if c.CrossedReorderPoint() {
+					crossed = append(crossed, c)
+				}
+			}
+			// 8 -> 2 at the location crosses 3, 18 -> 12 on the product crosses 12
+			Expect(crossed).To(HaveLen(2))
+		})
+
+		It("should keep a lot tracked product's lot in step with its stock", func() {
+			tracked, err := gr.Products().Create(ctx, types.NewProduct{Name: "tracked", Sku: "tracked", LotTracked: true})
+			Expect(err).To(BeNil())
+
+			lot, err := gr.Lots().Receive(ctx, tracked.ID, types.NewLot{LotNumber: "L-1"}, 0)
+			Expect(err).To(BeNil())
+
+			_, err = repo.Set(ctx, types.SetStockLevel{ProductID: tracked.ID, LocationID: location.ID, Qty: utils.Ref(int64(5))})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Set(ctx, types.SetStockLevel{
+				ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(5)), LotID: &lot.ID,
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Set(ctx, types.SetStockLevel{
+				ProductID: tracked.ID, LocationID: location.ID, Qty: utils.Ref(int64(5)), LotID: &lot.ID,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Adjust(ctx, tracked.ID, location.ID, -2, &lot.ID)
+			Expect(err).To(BeNil())
+
+			lot, _, err = gr.Lots().Get(ctx, lot.ID)
+			Expect(err).To(BeNil())
+			Expect(lot.Qty).To(BeNumerically("==", 3))
+		})
+	})
+
+	Context("Find(Tx)", func() {
+		It("should find the levels at a location", func() {
+			_, err := repo.Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: location.ID, Qty: utils.Ref(int64(4))})
+			Expect(err).To(BeNil())
+
+			levels, count, err := repo.Find(ctx, &repos.StockLevelsFind{LocationIDs: []int64{location.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 1))
+			Expect(levels[0].ProductID).To(Equal(product.ID))
+		})
+	})
+})
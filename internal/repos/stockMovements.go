@@ -0,0 +1,135 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type StockMovementsFind struct {
+	Limit       int
+	Offset      int
+	ProductIDs  []int64
+	LocationIDs []int64
+	Reasons     []types.StockMovementReason
+	// ReferenceType and ReferenceIDs - movements caused by these records, e.g. "return"
+	ReferenceType *string
+	ReferenceIDs  []int64
+}
+
+//go:generate mockgen -source=./stockMovements.go -destination=./mocks/StockMovements.go -package=mock_repos StockMovements
+type StockMovements interface {
+	Find(ctx context.Context, opts *StockMovementsFind) ([]*types.StockMovement, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *StockMovementsFind) ([]*types.StockMovement, int64, error)
+	Create(ctx context.Context, newMovement types.NewStockMovement) (*types.StockMovement, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newMovement types.NewStockMovement) (*types.StockMovement, error)
+}
+
+// NewStockMovements - the ledger only records movements, whoever moves the stock writes to it
+// in the same transaction
+func NewStockMovements(db *xorm.Engine) StockMovements {
+	return &stockMovementsRepo{db}
+}
+
+type stockMovementsRepo struct {
+	db *xorm.Engine
+}
+
+func (r *stockMovementsRepo) Find(ctx context.Context, opts *StockMovementsFind) ([]*types.StockMovement, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		m, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return m, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.StockMovement), count, nil
+}
+
+func (r *stockMovementsRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *StockMovementsFind) ([]*types.StockMovement, int64, error) {
+	if opts == nil {
+		opts = &StockMovementsFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.LocationIDs) > 0 {
+		tx = tx.In("location_id", utils.Int64ArrToInterfaceArr(opts.LocationIDs...)...)
+	}
+
+	if len(opts.Reasons) > 0 {
+		reasons := []interface{}{}
+		for _, reason := range opts.Reasons {
+			reasons = append(reasons, reason)
+		}
+		tx = tx.In("reason", reasons...)
+	}
+
+	if opts.ReferenceType != nil {
+		tx = tx.Where("reference_type = ?", *opts.ReferenceType)
+	}
+
+	if len(opts.ReferenceIDs) > 0 {
+		tx = tx.In("reference_id", utils.Int64ArrToInterfaceArr(opts.ReferenceIDs...)...)
+	}
+
+	objs := []*types.StockMovement{}
+	count, err := tx.OrderBy("created_at, id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("stock_movements", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *stockMovementsRepo) Create(ctx context.Context, newMovement types.NewStockMovement) (*types.StockMovement, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newMovement)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.StockMovement), nil
+}
+
+func (r *stockMovementsRepo) CreateTx(ctx context.Context, tx *xorm.Session, newMovement types.NewStockMovement) (*types.StockMovement, error) {
+	if err := types.Validate(newMovement); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj := &types.StockMovement{
+		ProductID:     newMovement.ProductID,
+		LocationID:    newMovement.LocationID,
+		Qty:           newMovement.Qty,
+		Reason:        newMovement.Reason,
+		ReferenceType: newMovement.ReferenceType,
+		ReferenceID:   newMovement.ReferenceID,
+		CreatedAt:     time.Now(),
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("stock_movements", err)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,52 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: StockMovements", func() {
+
+	var (
+		repo    repos.StockMovements
+		product *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_movements", "products")
+
+		repo = gr.StockMovements()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should require a product, a qty and a reason", func() {
+			_, err := repo.Create(ctx, types.NewStockMovement{ProductID: product.ID, Reason: types.StockMovementReasonReturn})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewStockMovement{ProductID: product.ID, Qty: 1})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Find(Tx)", func() {
+		It("should find movements oldest first", func() {
+			for _, qty := range []int64{2, -1} {
+				_, err := repo.Create(ctx, types.NewStockMovement{ProductID: product.ID, Qty: qty, Reason: types.StockMovementReasonReturn})
+				Expect(err).To(BeNil())
+			}
+
+			movements, count, err := repo.Find(ctx, &repos.StockMovementsFind{ProductIDs: []int64{product.ID}})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 2))
+			Expect(movements[0].Qty).To(BeNumerically("==", 2))
+			Expect(movements[1].Qty).To(BeNumerically("==", -1))
+		})
+	})
+})
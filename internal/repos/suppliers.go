@@ -0,0 +1,221 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type SuppliersFind struct {
+	Limit  int
+	Offset int
+	IDs    []int64
+	Codes  []string
+}
+
+//go:generate mockgen -source=./suppliers.go -destination=./mocks/Suppliers.go -package=mock_repos Suppliers
+type Suppliers interface {
+	Find(ctx context.Context, opts *SuppliersFind) ([]*types.Supplier, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *SuppliersFind) ([]*types.Supplier, int64, error)
+	Get(ctx context.Context, id int64) (*types.Supplier, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Supplier, bool, error)
+	Create(ctx context.Context, newSupplier types.NewSupplier) (*types.Supplier, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newSupplier types.NewSupplier) (*types.Supplier, error)
+	Update(ctx context.Context, diff *types.UpdateSupplier) (*types.Supplier, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateSupplier) (*types.Supplier, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+}
+
+func NewSuppliers(db *xorm.Engine) Suppliers {
+	return &suppliersRepo{db}
+}
+
+type suppliersRepo struct {
+	db *xorm.Engine
+}
+
+func (r *suppliersRepo) Find(ctx context.Context, opts *SuppliersFind) ([]*types.Supplier, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return l, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Supplier), count, nil
+}
+
+func (r *suppliersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *SuppliersFind) ([]*types.Supplier, int64, error) {
+	if opts == nil {
+		opts = &SuppliersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.Codes) > 0 {
+		tx = tx.In("code", utils.StringArrToInterfaceArr(opts.Codes...)...)
+	}
+
+	objs := []*types.Supplier{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("suppliers", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *suppliersRepo) Get(ctx context.Context, id int64) (*types.Supplier, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		l, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return l, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Supplier), exists, nil
+}
+
+func (r *suppliersRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Supplier, bool, error) {
+	obj := &types.Supplier{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("suppliers", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *suppliersRepo) Create(ctx context.Context, newSupplier types.NewSupplier) (*types.Supplier, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newSupplier)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Supplier), nil
+}
+
+func (r *suppliersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newSupplier types.NewSupplier) (*types.Supplier, error) {
+	obj := &types.Supplier{
+		Name:        newSupplier.Name,
+		Code:        newSupplier.Code,
+		ContactName: newSupplier.ContactName,
+		Email:       newSupplier.Email,
+		Phone:       newSupplier.Phone,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("suppliers", err)
+	}
+
+	return obj, nil
+}
+
+func (r *suppliersRepo) Update(ctx context.Context, diff *types.UpdateSupplier) (*types.Supplier, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Supplier), nil
+}
+
+func (r *suppliersRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateSupplier) (*types.Supplier, error) {
+	obj, exists, err := r.GetTx(ctx, tx, diff.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, types.NewNotFoundError("supplier not found by id")
+	}
+
+	if diff.Name != nil {
+		obj.Name = *diff.Name
+	}
+
+	if diff.Code != nil {
+		obj.Code = *diff.Code
+	}
+
+	if diff.ContactName != nil {
+		obj.ContactName = diff.ContactName
+	}
+
+	if diff.Email != nil {
+		obj.Email = diff.Email
+	}
+
+	if diff.Phone != nil {
+		obj.Phone = diff.Phone
+	}
+
+	if err := types.Validate(obj); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(diff.ID).Update(obj); err != nil {
+		return nil, normalizeErr("suppliers", err)
+	}
+
+	return obj, nil
+}
+
+func (r *suppliersRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+// DestroyTx - the supplier's product links go with it
+func (r *suppliersRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	count, err := tx.Where("id = ?", id).Delete(&types.Supplier{})
+	if err != nil {
+		return normalizeErr("suppliers", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("supplier not found")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Suppliers", func() {
+
+	var (
+		repo repos.Suppliers
+	)
+
+	BeforeEach(func() {
+		clearDatabase("suppliers", "product_suppliers", "products")
+
+		repo = gr.Suppliers()
+		Expect(repo).NotTo(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should fail with invalid suppliers", func() {
+			_, err := repo.Create(ctx, types.NewSupplier{})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewSupplier{Name: "Acme Supply"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should not allow duplicate codes", func() {
+			_, err := repo.Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewSupplier{Name: "Other", Code: "ACME"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+
+	Context("supplier data creation", func() {
+		var supplier *types.Supplier
+
+		BeforeEach(func() {
+			var err error
+			supplier, err = repo.Create(ctx, types.NewSupplier{Name: "Acme Supply", Code: "ACME"})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Create(ctx, types.NewSupplier{Name: "Globex", Code: "GLOBEX"})
+			Expect(err).To(BeNil())
+		})
+
+		Context("Find(Tx)", func() {
+			It("should find by code", func() {
+				suppliers, count, err := repo.Find(ctx, &repos.SuppliersFind{Codes: []string{"GLOBEX"}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 1))
+				Expect(suppliers[0].Name).To(Equal("Globex"))
+			})
+		})
+
+		Context("Update(Tx)", func() {
+			It("should return not found for an unknown supplier", func() {
+				_, err := repo.Update(ctx, &types.UpdateSupplier{ID: 99999999, Name: utils.Ref("x")})
+				Expect(types.IsNotFoundError(err)).To(BeTrue())
+			})
+
+			It("should successfully update", func() {
+				updated, err := repo.Update(ctx, &types.UpdateSupplier{ID: supplier.ID, Name: utils.Ref("Acme Supply Co")})
+				Expect(err).To(BeNil())
+				Expect(updated.Name).To(Equal("Acme Supply Co"))
+				Expect(updated.Code).To(Equal("ACME"))
+			})
+		})
+
+		Context("Destroy(Tx)", func() {
+			It("should remove the supplier's product links", func() {
+				product, err := gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+				Expect(err).To(BeNil())
+
+				_, err = gr.ProductSuppliers().Create(ctx, types.NewProductSupplier{ProductID: product.ID, SupplierID: supplier.ID})
+				Expect(err).To(BeNil())
+
+				Expect(repo.Destroy(ctx, supplier.ID)).To(Succeed())
+
+				_, count, err := gr.ProductSuppliers().Find(ctx, &repos.ProductSuppliersFind{ProductIDs: []int64{product.ID}})
+				Expect(err).To(BeNil())
+				Expect(count).To(BeNumerically("==", 0))
+			})
+
+			It("should successfully destroy a supplier", func() {
+				Expect(repo.Destroy(ctx, supplier.ID)).To(Succeed())
+				Expect(types.IsNotFoundError(repo.Destroy(ctx, supplier.ID))).To(BeTrue())
+			})
+		})
+	})
+})
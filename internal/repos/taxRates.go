@@ -0,0 +1,354 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/tax"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type TaxRatesFind struct {
+	Limit     int
+	Offset    int
+	IDs       []int64
+	Countries []string
+}
+
+//go:generate mockgen -source=./taxRates.go -destination=./mocks/TaxRates.go -package=mock_repos TaxRates
+type TaxRates interface {
+	Find(ctx context.Context, opts *TaxRatesFind) ([]*types.TaxRate, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *TaxRatesFind) ([]*types.TaxRate, int64, error)
+	Get(ctx context.Context, id int64) (*types.TaxRate, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.TaxRate, bool, error)
+	Create(ctx context.Context, newRate types.NewTaxRate) (*types.TaxRate, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newRate types.NewTaxRate) (*types.TaxRate, error)
+	Update(ctx context.Context, diff *types.UpdateTaxRate) (*types.TaxRate, error)
+	UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateTaxRate) (*types.TaxRate, error)
+	Destroy(ctx context.Context, id int64) error
+	DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error
+	Calculate(ctx context.Context, salesOrderID int64) (*types.TaxBreakdown, error)
+	CalculateTx(ctx context.Context, tx *xorm.Session, salesOrderID int64) (*types.TaxBreakdown, error)
+	CalculateQtys(ctx context.Context, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error)
+	CalculateQtysTx(ctx context.Context, tx *xorm.Session, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error)
+}
+
+// NewTaxRates - calculator works out the tax on sales orders, when it's nil it's a tax.Table of
+// the rates kept here
+func NewTaxRates(db *xorm.Engine, calculator tax.Calculator) TaxRates {
+	return &taxRatesRepo{db, calculator}
+}
+
+type taxRatesRepo struct {
+	db         *xorm.Engine
+	calculator tax.Calculator
+}
+
+func (r *taxRatesRepo) Find(ctx context.Context, opts *TaxRatesFind) ([]*types.TaxRate, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		t, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return t, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.TaxRate), count, nil
+}
+
+func (r *taxRatesRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *TaxRatesFind) ([]*types.TaxRate, int64, error) {
+	if opts == nil {
+		opts = &TaxRatesFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.Countries) > 0 {
+		tx = tx.In("country", utils.StringArrToInterfaceArr(opts.Countries...)...)
+	}
+
+	objs := []*types.TaxRate{}
+	count, err := tx.OrderBy("country").OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("tax_rates", err)
+	}
+
+	return objs, count, nil
+}
+
+func (r *taxRatesRepo) Get(ctx context.Context, id int64) (*types.TaxRate, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		t, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return t, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.TaxRate), exists, nil
+}
+
+func (r *taxRatesRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.TaxRate, bool, error) {
+	obj := &types.TaxRate{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("tax_rates", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	return obj, exists, nil
+}
+
+func (r *taxRatesRepo) Create(ctx context.Context, newRate types.NewTaxRate) (*types.TaxRate, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newRate)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.TaxRate), nil
+}
+
+func (r *taxRatesRepo) CreateTx(ctx context.Context, tx *xorm.Session, newRate types.NewTaxRate) (*types.TaxRate, error) {
+	if err := types.Validate(newRate); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj := &types.TaxRate{
+		Name:      newRate.Name,
+		Country:   newRate.Country,
+		Region:    newRate.Region,
+		Category:  newRate.Category,
+		Rate:      newRate.Rate,
+		Rounding:  newRate.Rounding,
+		PerLine:   newRate.PerLine,
+		CreatedAt: time.Now(),
+	}
+	if obj.Rounding == "" {
+		obj.Rounding = types.TaxRoundingHalfUp
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("tax_rates", err)
+	}
+
+	return obj, nil
+}
+
+func (r *taxRatesRepo) Update(ctx context.Context, diff *types.UpdateTaxRate) (*types.TaxRate, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.UpdateTx(ctx, tx, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.TaxRate), nil
+}
+
+// UpdateTx - an empty region or category clears it
+func (r *taxRatesRepo) UpdateTx(ctx context.Context, tx *xorm.Session, diff *types.UpdateTaxRate) (*types.TaxRate, error) {
+	if err := types.Validate(diff); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, exists, err := r.GetTx(ctx, tx, diff.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("tax rate not found by id")
+	}
+
+	if diff.Name != nil {
+		obj.Name = *diff.Name
+	}
+
+	if diff.Country != nil {
+		obj.Country = *diff.Country
+	}
+
+	if diff.Region != nil {
+		obj.Region = diff.Region
+		if *diff.Region == "" {
+			obj.Region = nil
+		}
+	}
+
+	if diff.Category != nil {
+		obj.Category = diff.Category
+		if *diff.Category == "" {
+			obj.Category = nil
+		}
+	}
+
+	if diff.Rate != nil {
+		obj.Rate = *diff.Rate
+	}
+
+	if diff.Rounding != nil {
+		obj.Rounding = *diff.Rounding
+	}
+
+	if diff.PerLine != nil {
+		obj.PerLine = *diff.PerLine
+	}
+
+	obj.UpdatedAt = utils.Ref(time.Now())
+
+	if _, err := tx.ID(obj.ID).
+		Cols("name", "country", "region", "tax_category", "rate", "rounding", "per_line", "updated_at").
+		Update(obj); err != nil {
+		return nil, normalizeErr("tax_rates", err)
+	}
+
+	return obj, nil
+}
+
+func (r *taxRatesRepo) Destroy(ctx context.Context, id int64) error {
+	_, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return nil, r.DestroyTx(ctx, tx, id)
+	})
+	return err
+}
+
+func (r *taxRatesRepo) DestroyTx(ctx context.Context, tx *xorm.Session, id int64) error {
+	count, err := tx.Where("id = ?", id).Delete(&types.TaxRate{})
+	if err != nil {
+		return normalizeErr("tax_rates", err)
+	}
+	if count == 0 {
+		return types.NewNotFoundError("tax rate not found")
+	}
+	return nil
+}
+
+func (r *taxRatesRepo) Calculate(ctx context.Context, salesOrderID int64) (*types.TaxBreakdown, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CalculateTx(ctx, tx, salesOrderID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.TaxBreakdown), nil
+}
+
+// CalculateTx - the tax on a sales order where it ships to, the customer's default shipping
+// address. Orders without a customer, or a shipping address, aren't charged any.
+func (r *taxRatesRepo) CalculateTx(ctx context.Context, tx *xorm.Session, salesOrderID int64) (*types.TaxBreakdown, error) {
+	return r.calculateTx(ctx, tx, salesOrderID, nil)
+}
+
+func (r *taxRatesRepo) CalculateQtys(ctx context.Context, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CalculateQtysTx(ctx, tx, salesOrderID, qtys)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.TaxBreakdown), nil
+}
+
+// CalculateQtysTx - the same as CalculateTx but only on these qtys of the order's lines, by sales
+// order line id, like what went out on a shipment
+func (r *taxRatesRepo) CalculateQtysTx(ctx context.Context, tx *xorm.Session, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error) {
+	if qtys == nil {
+		qtys = map[int64]int64{}
+	}
+	return r.calculateTx(ctx, tx, salesOrderID, qtys)
+}
+
+// calculateTx - qtys replaces the lines' own qtys when it isn't nil, lines missing from it are left out
+func (r *taxRatesRepo) calculateTx(ctx context.Context, tx *xorm.Session, salesOrderID int64, qtys map[int64]int64) (*types.TaxBreakdown, error) {
+	order := &types.SalesOrder{}
+	exists, err := tx.Where("id = ?", salesOrderID).Get(order)
+	if err != nil {
+		return nil, normalizeErr("sales_orders", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("sales order not found by id")
+	}
+
+	taxOrder := tax.Order{}
+	if order.CustomerID != nil {
+		customer := &types.Customer{}
+		if _, err := tx.Where("id = ?", *order.CustomerID).Get(customer); err != nil {
+			return nil, normalizeErr("customers", err)
+		}
+		taxOrder.Exempt = customer.TaxExempt
+
+		address := &types.CustomerAddress{}
+		exists, err := tx.Where("customer_id = ? AND kind = ? AND is_default", *order.CustomerID, types.AddressKindShipping).
+			Get(address)
+		if err != nil {
+			return nil, normalizeErr("customer_addresses", err)
+		}
+		if exists {
+			taxOrder.Country, taxOrder.Region = address.Country, address.Region
+		}
+	}
+
+	lines := []*struct {
+		ID          int64   `xorm:"id"`
+		TaxCategory *string `xorm:"tax_category"`
+		Qty         int64   `xorm:"qty"`
+		UnitPrice   int64   `xorm:"unit_price"`
+	}{}
+	if err := tx.SQL(`
+		SELECT l.id, p.tax_category, l.qty, l.unit_price
+		FROM sales_order_lines l
+		JOIN products p ON p.id = l.product_id
+		WHERE l.sales_order_id = ?
+		ORDER BY l.id`, salesOrderID,
+	).Find(&lines); err != nil {
+		return nil, normalizeErr("sales_order_lines", err)
+	}
+	for _, line := range lines {
+		qty := line.Qty
+		if qtys != nil {
+			var exists bool
+			if qty, exists = qtys[line.ID]; !exists {
+				continue
+			}
+		}
+		taxOrder.Lines = append(taxOrder.Lines, tax.Line{ID: line.ID, Category: line.TaxCategory, Amount: qty * line.UnitPrice})
+	}
+
+	calculator := r.calculator
+	if calculator == nil {
+		rates := []*types.TaxRate{}
+		if err := tx.Where("country = ?", taxOrder.Country).OrderBy("id").Find(&rates); err != nil {
+			return nil, normalizeErr("tax_rates", err)
+		}
+		calculator = tax.Table{Rates: rates}
+	}
+
+	return calculator.Calculate(ctx, taxOrder)
+}
@@ -0,0 +1,144 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: TaxRates", func() {
+
+	var (
+		repo repos.TaxRates
+	)
+
+	BeforeEach(func() {
+		clearDatabase("tax_rates", "customers", "customer_addresses", "sales_orders", "sales_order_lines", "products")
+
+		repo = gr.TaxRates()
+		Expect(repo).NotTo(BeNil())
+	})
+
+	Context("Create(Tx)", func() {
+		It("should fail with invalid tax rates", func() {
+			_, err := repo.Create(ctx, types.NewTaxRate{})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewTaxRate{Name: "Federal", Country: "USA", Rate: 5})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewTaxRate{Name: "Federal", Country: "US", Rate: -1})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewTaxRate{Name: "Federal", Country: "US", Rate: 5, Rounding: "sideways"})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should default to rounding half up", func() {
+			rate, err := repo.Create(ctx, types.NewTaxRate{Name: "Federal", Country: "US", Rate: 5})
+			Expect(err).To(BeNil())
+			Expect(rate.Rounding).To(Equal(types.TaxRoundingHalfUp))
+		})
+	})
+
+	Context("Update(Tx)", func() {
+		It("should return not found for an unknown tax rate", func() {
+			_, err := repo.Update(ctx, &types.UpdateTaxRate{ID: 99999999, Name: utils.Ref("x")})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should clear the region with an empty one", func() {
+			rate, err := repo.Create(ctx, types.NewTaxRate{Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 6.25})
+			Expect(err).To(BeNil())
+
+			updated, err := repo.Update(ctx, &types.UpdateTaxRate{ID: rate.ID, Region: utils.Ref(""), Rate: utils.Ref(6.5)})
+			Expect(err).To(BeNil())
+			Expect(updated.Region).To(BeNil())
+			Expect(updated.Rate).To(Equal(6.5))
+
+			Expect(repo.Destroy(ctx, rate.ID)).To(Succeed())
+			Expect(types.IsNotFoundError(repo.Destroy(ctx, rate.ID))).To(BeTrue())
+		})
+	})
+
+	Context("Calculate(Tx)", func() {
+		var (
+			customer *types.Customer
+			order    *types.SalesOrder
+		)
+
+		BeforeEach(func() {
+			_, err := repo.Create(ctx, types.NewTaxRate{Name: "Federal", Country: "US", Rate: 5})
+			Expect(err).To(BeNil())
+			_, err = repo.Create(ctx, types.NewTaxRate{Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 1.25})
+			Expect(err).To(BeNil())
+			_, err = repo.Create(ctx, types.NewTaxRate{Name: "Texas food", Country: "US", Region: utils.Ref("TX"), Category: utils.Ref("food"), Rate: 0})
+			Expect(err).To(BeNil())
+			_, err = repo.Create(ctx, types.NewTaxRate{Name: "VAT", Country: "GB", Rate: 20})
+			Expect(err).To(BeNil())
+
+			widget, err := gr.Products().Create(ctx, types.NewProduct{Name: "widget", Sku: "widget", Qty: 10})
+			Expect(err).To(BeNil())
+			snack, err := gr.Products().Create(ctx, types.NewProduct{Name: "snack", Sku: "snack", Qty: 10, TaxCategory: utils.Ref("food")})
+			Expect(err).To(BeNil())
+
+			customer, err = gr.Customers().Create(ctx, types.NewCustomer{
+				Name: "Jane Doe", Code: "JANE",
+				Addresses: []types.NewCustomerAddress{
+					{Kind: types.AddressKindShipping, Line1: "1 Main St", City: "Austin", Region: utils.Ref("TX"), Country: "US"},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			order, err = gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+				CustomerID: &customer.ID,
+				Lines: []types.NewSalesOrderLine{
+					{ProductID: widget.ID, Qty: 2, UnitPrice: 5000},
+					{ProductID: snack.ID, Qty: 4, UnitPrice: 500},
+				},
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should return not found for an unknown sales order", func() {
+			_, err := repo.Calculate(ctx, 99999999)
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should charge the rates where the order ships to", func() {
+			breakdown, err := repo.Calculate(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(*breakdown.Country).To(Equal("US"))
+			Expect(breakdown.Subtotal).To(BeNumerically("==", 12000))
+			Expect(breakdown.Tax).To(BeNumerically("==", 725))
+			Expect(breakdown.Total).To(BeNumerically("==", 12725))
+			Expect(breakdown.Rates).To(HaveLen(3))
+			Expect(breakdown.Lines).To(HaveLen(2))
+			Expect(breakdown.Lines[0].Tax).To(BeNumerically("==", 625))
+			Expect(breakdown.Lines[1].Tax).To(BeNumerically("==", 100))
+		})
+
+		It("should not charge tax exempt customers", func() {
+			_, err := gr.Customers().Update(ctx, &types.UpdateCustomer{ID: customer.ID, TaxExempt: utils.Ref(true)})
+			Expect(err).To(BeNil())
+
+			breakdown, err := repo.Calculate(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(breakdown.Exempt).To(BeTrue())
+			Expect(breakdown.Tax).To(BeZero())
+			Expect(breakdown.Total).To(BeNumerically("==", 12000))
+		})
+
+		It("should not charge orders without somewhere to ship to", func() {
+			_, err := gr.Customers().Update(ctx, &types.UpdateCustomer{ID: customer.ID, Addresses: &[]types.NewCustomerAddress{}})
+			Expect(err).To(BeNil())
+
+			breakdown, err := repo.Calculate(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(breakdown.Country).To(BeNil())
+			Expect(breakdown.Tax).To(BeZero())
+		})
+	})
+})
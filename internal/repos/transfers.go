@@ -0,0 +1,508 @@
+package repos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type TransfersFind struct {
+	Limit  int
+	Offset int
+	IDs    []int64
+	// LocationIDs - transfers leaving or arriving at any of these locations
+	LocationIDs []int64
+	Statuses    []types.TransferStatus
+}
+
+//go:generate mockgen -source=./transfers.go -destination=./mocks/Transfers.go -package=mock_repos Transfers
+type Transfers interface {
+	Find(ctx context.Context, opts *TransfersFind) ([]*types.Transfer, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *TransfersFind) ([]*types.Transfer, int64, error)
+	Get(ctx context.Context, id int64) (*types.Transfer, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Transfer, bool, error)
+	Create(ctx context.Context, newTransfer types.NewTransfer) (*types.Transfer, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newTransfer types.NewTransfer) (*types.Transfer, error)
+	Ship(ctx context.Context, ship *types.ShipTransfer) (*types.Transfer, error)
+	ShipTx(ctx context.Context, tx *xorm.Session, ship *types.ShipTransfer) (*types.Transfer, error)
+	Receive(ctx context.Context, receive *types.ReceiveTransfer) (*types.Transfer, error)
+	ReceiveTx(ctx context.Context, tx *xorm.Session, receive *types.ReceiveTransfer) (*types.Transfer, error)
+	Cancel(ctx context.Context, id int64) (*types.Transfer, error)
+	CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Transfer, error)
+}
+
+// NewTransfers - stock moves through products and stock levels and is recorded on the stock
+// movement ledger, all in the same transaction
+func NewTransfers(db *xorm.Engine, products Products, stockLevels StockLevels, stockMovements StockMovements) Transfers {
+	return &transfersRepo{db, products, stockLevels, stockMovements}
+}
+
+type transfersRepo struct {
+	db             *xorm.Engine
+	products       Products
+	stockLevels    StockLevels
+	stockMovements StockMovements
+}
+
+// transferReference - the reference type transfers write to the stock movement ledger
+var transferReference = "transfer"
+
+func (r *transfersRepo) Find(ctx context.Context, opts *TransfersFind) ([]*types.Transfer, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		t, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return t, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.Transfer), count, nil
+}
+
+func (r *transfersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *TransfersFind) ([]*types.Transfer, int64, error) {
+	if opts == nil {
+		opts = &TransfersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.LocationIDs) > 0 {
+		ids := utils.Int64ArrToInterfaceArr(opts.LocationIDs...)
+		marks := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+		tx = tx.Where("(source_location_id IN ("+marks+") OR destination_location_id IN ("+marks+"))", append(ids, ids...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.Transfer{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("transfers", err)
+	}
+
+	if err := r.loadLines(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *transfersRepo) Get(ctx context.Context, id int64) (*types.Transfer, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		t, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return t, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.Transfer), exists, nil
+}
+
+func (r *transfersRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Transfer, bool, error) {
+	obj := &types.Transfer{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("transfers", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *transfersRepo) Create(ctx context.Context, newTransfer types.NewTransfer) (*types.Transfer, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newTransfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Transfer), nil
+}
+
+// CreateTx - drafts a transfer, no stock moves until it ships
+func (r *transfersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newTransfer types.NewTransfer) (*types.Transfer, error) {
+	if err := types.Validate(newTransfer); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	for _, locationID := range []int64{newTransfer.SourceLocationID, newTransfer.DestinationLocationID} {
+		exists, err := tx.Table("locations").Where("id = ?", locationID).Exist()
+		if err != nil {
+			return nil, normalizeErr("transfers", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("location not found by id")
+		}
+	}
+
+	obj := &types.Transfer{
+		SourceLocationID:      newTransfer.SourceLocationID,
+		DestinationLocationID: newTransfer.DestinationLocationID,
+		Status:                types.TransferStatusDraft,
+		Notes:                 newTransfer.Notes,
+		CreatedAt:             time.Now(),
+		Lines:                 []*types.TransferLine{},
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("transfers", err)
+	}
+
+	for _, nl := range newTransfer.Lines {
+		exists, err := tx.Table("products").Where("id = ?", nl.ProductID).Exist()
+		if err != nil {
+			return nil, normalizeErr("transfer_lines", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("product not found by id")
+		}
+
+		line := &types.TransferLine{
+			TransferID: obj.ID,
+			ProductID:  nl.ProductID,
+			Qty:        nl.Qty,
+			CreatedAt:  time.Now(),
+		}
+
+		if _, err := tx.Insert(line); err != nil {
+			return nil, normalizeErr("transfer_lines", err)
+		}
+
+		obj.Lines = append(obj.Lines, line)
+	}
+
+	return obj, nil
+}
+
+func (r *transfersRepo) Ship(ctx context.Context, ship *types.ShipTransfer) (*types.Transfer, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ShipTx(ctx, tx, ship)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Transfer), nil
+}
+
+// ShipTx - takes the stock out of the source location and puts it in transit. It stays part
+// of the product's on hand total while it travels.
+func (r *transfersRepo) ShipTx(ctx context.Context, tx *xorm.Session, ship *types.ShipTransfer) (*types.Transfer, error) {
+	if err := types.Validate(ship); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, err := r.getForUpdate(tx, ship.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !obj.Status.CanTransition(types.TransferStatusShipped) {
+		return nil, types.NewBadRequestError("only draft transfers can be shipped")
+	}
+
+	shipped := map[int64]int64{}
+	for _, line := range ship.Lines {
+		shipped[line.TransferLineID] = line.Qty
+	}
+
+	var total int64
+	for _, line := range obj.Lines {
+		line.QtyShipped = line.Qty
+		if qty, exists := shipped[line.ID]; exists {
+			if qty > line.Qty {
+				return nil, types.NewBadRequestError("can not ship more than the line asks for")
+			}
+			line.QtyShipped = qty
+			delete(shipped, line.ID)
+		}
+
+		if line.QtyShipped == 0 {
+			continue
+		}
+
+		// into transit first so the on hand total never dips below what's allocated
+		if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{
+			Qty: line.QtyShipped, InTransit: line.QtyShipped, Move: true,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := r.stockLevels.MoveTx(ctx, tx, line.ProductID, obj.SourceLocationID, -line.QtyShipped); err != nil {
+			return nil, err
+		}
+
+		if err := r.record(ctx, tx, obj, line.ProductID, &obj.SourceLocationID, -line.QtyShipped, types.StockMovementReasonTransferOut); err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ID(line.ID).Cols("qty_shipped").Update(line); err != nil {
+			return nil, normalizeErr("transfer_lines", err)
+		}
+
+		total += line.QtyShipped
+	}
+
+	if len(shipped) > 0 {
+		return nil, types.NewNotFoundError("transfer line not found on this transfer")
+	}
+
+	if total == 0 {
+		return nil, types.NewBadRequestError("nothing to ship on this transfer")
+	}
+
+	obj.ShippedAt = utils.Ref(time.Now())
+	if err := r.setStatus(tx, obj, types.TransferStatusShipped, "shipped_at"); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *transfersRepo) Receive(ctx context.Context, receive *types.ReceiveTransfer) (*types.Transfer, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.ReceiveTx(ctx, tx, receive)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Transfer), nil
+}
+
+// ReceiveTx - lands stock at the destination location. Closing writes off anything still in
+// transit as a discrepancy, otherwise the transfer stays open for a later receipt. The
+// transfer is received once nothing is left in transit.
+func (r *transfersRepo) ReceiveTx(ctx context.Context, tx *xorm.Session, receive *types.ReceiveTransfer) (*types.Transfer, error) {
+	if err := types.Validate(receive); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	obj, err := r.getForUpdate(tx, receive.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !obj.Status.InTransit() {
+		return nil, types.NewBadRequestError("transfer has nothing in transit")
+	}
+
+	received := map[int64]int64{}
+	for _, line := range receive.Lines {
+		received[line.TransferLineID] = line.Qty
+	}
+
+	for _, line := range obj.Lines {
+		qty := line.InTransit()
+		if rq, exists := received[line.ID]; exists {
+			if rq > qty {
+				return nil, types.NewBadRequestError("can not receive more than is in transit")
+			}
+			qty = rq
+			delete(received, line.ID)
+		}
+
+		if qty == 0 {
+			continue
+		}
+
+		if _, err := r.stockLevels.MoveTx(ctx, tx, line.ProductID, obj.DestinationLocationID, qty); err != nil {
+			return nil, err
+		}
+		if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{
+			Qty: -qty, InTransit: -qty, Move: true,
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := r.record(ctx, tx, obj, line.ProductID, &obj.DestinationLocationID, qty, types.StockMovementReasonTransferIn); err != nil {
+			return nil, err
+		}
+
+		line.QtyReceived += qty
+		if _, err := tx.ID(line.ID).Cols("qty_received").Update(line); err != nil {
+			return nil, normalizeErr("transfer_lines", err)
+		}
+	}
+
+	if len(received) > 0 {
+		return nil, types.NewNotFoundError("transfer line not found on this transfer")
+	}
+
+	if receive.Close {
+		for _, line := range obj.Lines {
+			lost := line.InTransit()
+			if lost == 0 {
+				continue
+			}
+
+			if _, err := r.products.AdjustTx(ctx, tx, line.ProductID, types.ProductAdjustment{
+				Qty: -lost, InTransit: -lost,
+			}); err != nil {
+				return nil, err
+			}
+
+			if err := r.record(ctx, tx, obj, line.ProductID, nil, -lost, types.StockMovementReasonTransferLoss); err != nil {
+				return nil, err
+			}
+
+			line.QtyLost += lost
+			if _, err := tx.ID(line.ID).Cols("qty_lost").Update(line); err != nil {
+				return nil, normalizeErr("transfer_lines", err)
+			}
+		}
+	}
+
+	status := types.TransferStatusReceived
+	for _, line := range obj.Lines {
+		if line.InTransit() > 0 {
+			status = types.TransferStatusPartiallyReceived
+			break
+		}
+	}
+
+	if status == types.TransferStatusReceived {
+		obj.ReceivedAt = utils.Ref(time.Now())
+	}
+
+	if err := r.setStatus(tx, obj, status, "received_at"); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *transfersRepo) Cancel(ctx context.Context, id int64) (*types.Transfer, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CancelTx(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.Transfer), nil
+}
+
+// CancelTx - only drafts can be cancelled, nothing has moved yet
+func (r *transfersRepo) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.Transfer, error) {
+	obj, err := r.getForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.setStatus(tx, obj, types.TransferStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (r *transfersRepo) record(
+	ctx context.Context, tx *xorm.Session, obj *types.Transfer,
+	productID int64, locationID *int64, qty int64, reason types.StockMovementReason,
+) error {
+	_, err := r.stockMovements.CreateTx(ctx, tx, types.NewStockMovement{
+		ProductID:     productID,
+		LocationID:    locationID,
+		Qty:           qty,
+		Reason:        reason,
+		ReferenceType: &transferReference,
+		ReferenceID:   &obj.ID,
+	})
+	return err
+}
+
+// getForUpdate - locks the transfer and loads its lines
+func (r *transfersRepo) getForUpdate(tx *xorm.Session, id int64) (*types.Transfer, error) {
+	obj := &types.Transfer{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("transfers", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("transfer not found by id")
+	}
+
+	if err := r.loadLines(tx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// setStatus - cols are any other columns that changed with the status
+func (r *transfersRepo) setStatus(tx *xorm.Session, obj *types.Transfer, to types.TransferStatus, cols ...string) error {
+	if !obj.Status.CanTransition(to) {
+		return types.NewBadRequestError("transfer can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	obj.Status = to
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols(append(cols, "status", "updated_at")...).Update(obj); err != nil {
+		return normalizeErr("transfers", err)
+	}
+
+	return nil
+}
+
+func (r *transfersRepo) loadLines(tx *xorm.Session, transfers ...*types.Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.Transfer{}
+	ids := []int64{}
+	for _, transfer := range transfers {
+		transfer.Lines = []*types.TransferLine{}
+		byID[transfer.ID] = transfer
+		ids = append(ids, transfer.ID)
+	}
+
+	lines := []*types.TransferLine{}
+	if err := tx.In("transfer_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&lines); err != nil {
+		return normalizeErr("transfer_lines", err)
+	}
+
+	for _, line := range lines {
+		byID[line.TransferID].Lines = append(byID[line.TransferID].Lines, line)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Transfers", func() {
+
+	var (
+		repo        repos.Transfers
+		product     *types.Product
+		source      *types.Location
+		destination *types.Location
+	)
+
+	BeforeEach(func() {
+		clearDatabase("stock_movements", "transfers", "stock_levels", "locations", "products")
+
+		repo = gr.Transfers()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		product, err = gr.Products().Create(ctx, types.NewProduct{Name: "test", Sku: "test", Qty: 10})
+		Expect(err).To(BeNil())
+
+		source, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+		Expect(err).To(BeNil())
+
+		destination, err = gr.Locations().Create(ctx, types.NewLocation{Name: "Store", Code: "STORE"})
+		Expect(err).To(BeNil())
+
+		// 10 of the 20 on hand are put away at the source
+		_, err = gr.StockLevels().Set(ctx, types.SetStockLevel{ProductID: product.ID, LocationID: source.ID, Qty: utils.Ref[int64](10)})
+		Expect(err).To(BeNil())
+	})
+
+	draft := func(qty int64) *types.Transfer {
+		transfer, err := repo.Create(ctx, types.NewTransfer{
+			SourceLocationID:      source.ID,
+			DestinationLocationID: destination.ID,
+			Lines:                 []types.NewTransferLine{{ProductID: product.ID, Qty: qty}},
+		})
+		Expect(err).To(BeNil())
+		return transfer
+	}
+
+	levelAt := func(location *types.Location) int64 {
+		level, exists, err := gr.StockLevels().Get(ctx, product.ID, location.ID)
+		Expect(err).To(BeNil())
+		if !exists {
+			return 0
+		}
+		return level.Qty
+	}
+
+	onHand := func() *types.Product {
+		p, _, err := gr.Products().Get(ctx, product.ID)
+		Expect(err).To(BeNil())
+		return p
+	}
+
+	Context("Create(Tx)", func() {
+		It("should fail for unknown locations or products", func() {
+			_, err := repo.Create(ctx, types.NewTransfer{
+				SourceLocationID: source.ID, DestinationLocationID: 99999999,
+				Lines: []types.NewTransferLine{{ProductID: product.ID, Qty: 1}},
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewTransfer{
+				SourceLocationID: source.ID, DestinationLocationID: destination.ID,
+				Lines: []types.NewTransferLine{{ProductID: 99999999, Qty: 1}},
+			})
+			Expect(types.IsNotFoundError(err)).To(BeTrue())
+		})
+
+		It("should draft a transfer without moving stock", func() {
+			transfer := draft(4)
+			Expect(transfer.Status).To(Equal(types.TransferStatusDraft))
+			Expect(transfer.Lines).To(HaveLen(1))
+			Expect(levelAt(source)).To(BeNumerically("==", 10))
+		})
+	})
+
+	Context("Ship(Tx)", func() {
+		It("should move the stock from the source into transit", func() {
+			transfer := draft(4)
+
+			transfer, err := repo.Ship(ctx, &types.ShipTransfer{ID: transfer.ID})
+			Expect(err).To(BeNil())
+			Expect(transfer.Status).To(Equal(types.TransferStatusShipped))
+			Expect(transfer.ShippedAt).NotTo(BeNil())
+			Expect(transfer.Lines[0].QtyShipped).To(BeNumerically("==", 4))
+
+			Expect(levelAt(source)).To(BeNumerically("==", 6))
+			p := onHand()
+			Expect(p.Qty).To(BeNumerically("==", 20))
+			Expect(p.InTransit).To(BeNumerically("==", 4))
+
+			_, err = repo.Cancel(ctx, transfer.ID)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+
+		It("should not ship more than the source holds", func() {
+			transfer := draft(11)
+
+			_, err := repo.Ship(ctx, &types.ShipTransfer{ID: transfer.ID})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+			Expect(levelAt(source)).To(BeNumerically("==", 10))
+		})
+	})
+
+	Context("Receive(Tx)", func() {
+		It("should land the stock at the destination across receipts", func() {
+			transfer := draft(4)
+			transfer, err := repo.Ship(ctx, &types.ShipTransfer{ID: transfer.ID})
+			Expect(err).To(BeNil())
+
+			transfer, err = repo.Receive(ctx, &types.ReceiveTransfer{
+				ID: transfer.ID, Lines: []types.ReceiveTransferLine{{TransferLineID: transfer.Lines[0].ID, Qty: 1}},
+			})
+			Expect(err).To(BeNil())
+			Expect(transfer.Status).To(Equal(types.TransferStatusPartiallyReceived))
+			Expect(levelAt(destination)).To(BeNumerically("==", 1))
+			Expect(onHand().InTransit).To(BeNumerically("==", 3))
+
+			transfer, err = repo.Receive(ctx, &types.ReceiveTransfer{ID: transfer.ID})
+			Expect(err).To(BeNil())
+			Expect(transfer.Status).To(Equal(types.TransferStatusReceived))
+			Expect(transfer.ReceivedAt).NotTo(BeNil())
+			Expect(levelAt(destination)).To(BeNumerically("==", 4))
+
+			p := onHand()
+			Expect(p.Qty).To(BeNumerically("==", 20))
+			Expect(p.InTransit).To(BeNumerically("==", 0))
+		})
+
+		It("should write off what's missing when closed short", func() {
+			transfer := draft(4)
+			transfer, err := repo.Ship(ctx, &types.ShipTransfer{ID: transfer.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Receive(ctx, &types.ReceiveTransfer{
+				ID: transfer.ID, Lines: []types.ReceiveTransferLine{{TransferLineID: transfer.Lines[0].ID, Qty: 5}},
+			})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			transfer, err = repo.Receive(ctx, &types.ReceiveTransfer{
+				ID:    transfer.ID,
+				Lines: []types.ReceiveTransferLine{{TransferLineID: transfer.Lines[0].ID, Qty: 3}},
+				Close: true,
+			})
+			Expect(err).To(BeNil())
+			Expect(transfer.Status).To(Equal(types.TransferStatusReceived))
+			Expect(transfer.Lines[0].QtyReceived).To(BeNumerically("==", 3))
+			Expect(transfer.Lines[0].QtyLost).To(BeNumerically("==", 1))
+
+			p := onHand()
+			Expect(p.Qty).To(BeNumerically("==", 19))
+			Expect(p.InTransit).To(BeNumerically("==", 0))
+
+			movements, count, err := gr.StockMovements().Find(ctx, &repos.StockMovementsFind{
+				ReferenceType: utils.Ref("transfer"), ReferenceIDs: []int64{transfer.ID},
+			})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 3))
+			Expect(movements[0].Reason).To(Equal(types.StockMovementReasonTransferOut))
+			Expect(movements[0].Qty).To(BeNumerically("==", -4))
+			Expect(movements[1].Reason).To(Equal(types.StockMovementReasonTransferIn))
+			Expect(movements[1].Qty).To(BeNumerically("==", 3))
+			Expect(movements[2].Reason).To(Equal(types.StockMovementReasonTransferLoss))
+			Expect(movements[2].Qty).To(BeNumerically("==", -1))
+		})
+	})
+
+	Context("observers", func() {
+		It("should only hear about the locations, a transfer doesn't change the product total", func() {
+			// the 20 on hand is already at the reorder point
+			_, err := gr.Products().Update(ctx, &types.UpdateProduct{ID: product.ID, ReorderPoint: utils.Ref[int64](20)})
+			Expect(err).To(BeNil())
+
+			observer := &recordingObserver{}
+			products := repos.NewProducts(gr.DB(), observer)
+			repo := repos.NewTransfers(gr.DB(), products, repos.NewStockLevels(gr.DB(), products, observer), gr.StockMovements())
+
+			transfer := draft(4)
+			_, err = repo.Ship(ctx, &types.ShipTransfer{ID: transfer.ID})
+			Expect(err).To(BeNil())
+			_, err = repo.Receive(ctx, &types.ReceiveTransfer{ID: transfer.ID})
+			Expect(err).To(BeNil())
+
+			changes := observer.Changes()
+			Expect(changes).To(HaveLen(2))
+			for _, change := range changes {
+				Expect(change.LocationID).NotTo(BeNil())
+			}
+		})
+	})
+
+	Context("Cancel(Tx)", func() {
+		It("should cancel a draft", func() {
+			transfer, err := repo.Cancel(ctx, draft(4).ID)
+			Expect(err).To(BeNil())
+			Expect(transfer.Status).To(Equal(types.TransferStatusCancelled))
+		})
+	})
+})
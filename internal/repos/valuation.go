@@ -0,0 +1,191 @@
+package repos
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+// valueTx - records what an adjustment to a product's qty is worth. Qty coming in adds a cost
+// layer at its unit cost, qty going out draws down the oldest layers and is valued by the
+// product's cost method. Moves between locations leave the layers alone and are worth nothing,
+// the product's value just follows its qty to where it's held. The product has to be locked.
+func valueTx(tx *xorm.Session, product *types.Product, adj types.ProductAdjustment) error {
+	if adj.Qty == 0 {
+		return nil
+	}
+
+	entry := &types.ValuationEntry{
+		ProductID:  product.ID,
+		LocationID: adj.LocationID,
+		Qty:        adj.Qty,
+		Move:       adj.Move,
+		CreatedAt:  time.Now(),
+	}
+
+	switch {
+	case adj.Move:
+	case adj.Qty > 0:
+		unitCost := product.StandardCost
+		if product.CostMethod != types.CostMethodStandard {
+			if adj.UnitCost != nil {
+				unitCost = *adj.UnitCost
+			} else {
+				var err error
+				if unitCost, err = unitCostTx(tx, product); err != nil {
+					return err
+				}
+			}
+		}
+
+		layer := &types.CostLayer{
+			ProductID: product.ID,
+			Qty:       adj.Qty,
+			Remaining: adj.Qty,
+			UnitCost:  unitCost,
+			CreatedAt: entry.CreatedAt,
+		}
+		if _, err := tx.Insert(layer); err != nil {
+			return normalizeErr("cost_layers", err)
+		}
+
+		entry.Value = adj.Qty * unitCost
+	default:
+		value, err := drawDownTx(tx, product, -adj.Qty)
+		if err != nil {
+			return err
+		}
+		entry.Value = -value
+	}
+
+	if _, err := tx.Insert(entry); err != nil {
+		return normalizeErr("valuation_entries", err)
+	}
+
+	return nil
+}
+
+// drawDownTx - takes qty off the product's oldest cost layers and returns what it was worth.
+// FIFO values it at the layers' costs, average at its share of everything on hand and standard
+// at the standard cost.
+func drawDownTx(tx *xorm.Session, product *types.Product, qty int64) (int64, error) {
+	onHand, value, err := stockValueTx(tx, product.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	layers := []*types.CostLayer{}
+	if err := tx.Where("product_id = ? AND remaining > 0", product.ID).OrderBy("id").ForUpdate().Find(&layers); err != nil {
+		return 0, normalizeErr("cost_layers", err)
+	}
+
+	left, layered := qty, int64(0)
+	for _, layer := range layers {
+		if left == 0 {
+			break
+		}
+
+		take := min(left, layer.Remaining)
+		layer.Remaining -= take
+		if _, err := tx.ID(layer.ID).Cols("remaining").Update(layer); err != nil {
+			return 0, normalizeErr("cost_layers", err)
+		}
+
+		layered += take * layer.UnitCost
+		left -= take
+	}
+
+	// only stock that came in outside of the layers gets here, it goes out at what it's worth now
+	if left > 0 {
+		unitCost, err := unitCostTx(tx, product)
+		if err != nil {
+			return 0, err
+		}
+		layered += left * unitCost
+	}
+
+	switch product.CostMethod {
+	case types.CostMethodStandard:
+		return qty * product.StandardCost, nil
+	case types.CostMethodAverage:
+		if onHand <= 0 {
+			return layered, nil
+		}
+		if qty >= onHand {
+			return value, nil
+		}
+		return value * qty / onHand, nil
+	default:
+		return layered, nil
+	}
+}
+
+// unitCostTx - what one more unit of the product is worth right now. That's its standard cost
+// under the standard method, otherwise the average of what's on hand or, with nothing on hand,
+// the cost of the last layer it had.
+func unitCostTx(tx *xorm.Session, product *types.Product) (int64, error) {
+	if product.CostMethod == types.CostMethodStandard {
+		return product.StandardCost, nil
+	}
+
+	qty, value, err := stockValueTx(tx, product.ID)
+	if err != nil {
+		return 0, err
+	}
+	if qty > 0 {
+		return value / qty, nil
+	}
+
+	layer := &types.CostLayer{}
+	exists, err := tx.Where("product_id = ?", product.ID).Desc("id").Get(layer)
+	if err != nil {
+		return 0, normalizeErr("cost_layers", err)
+	}
+	if !exists {
+		return product.StandardCost, nil
+	}
+
+	return layer.UnitCost, nil
+}
+
+// stockValueTx - a product's qty and value as its valuation entries have them
+func stockValueTx(tx *xorm.Session, productID int64) (int64, int64, error) {
+	total := struct {
+		Qty   int64 `xorm:"qty"`
+		Value int64 `xorm:"value"`
+	}{}
+	if _, err := tx.SQL(`SELECT COALESCE(SUM(qty), 0) AS qty, COALESCE(SUM(value), 0) AS value
+		FROM valuation_entries WHERE product_id = ?`, productID).Get(&total); err != nil {
+		return 0, 0, normalizeErr("valuation_entries", err)
+	}
+
+	return total.Qty, total.Value, nil
+}
+
+// revalueTx - brings the value of what's on hand into line with a new standard cost
+func revalueTx(tx *xorm.Session, product *types.Product) error {
+	qty, value, err := stockValueTx(tx, product.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Table("cost_layers").Where("product_id = ? AND remaining > 0", product.ID).
+		Update(map[string]interface{}{"unit_cost": product.StandardCost}); err != nil {
+		return normalizeErr("cost_layers", err)
+	}
+
+	if qty*product.StandardCost == value {
+		return nil
+	}
+
+	if _, err := tx.Insert(&types.ValuationEntry{
+		ProductID: product.ID,
+		Value:     qty*product.StandardCost - value,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		return normalizeErr("valuation_entries", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: Valuation", func() {
+
+	BeforeEach(func() {
+		clearDatabase("valuation_entries", "cost_layers", "products")
+	})
+
+	create := func(newProduct types.NewProduct) *types.Product {
+		newProduct.Name, newProduct.Sku = "test", "test"
+		product, err := gr.Products().Create(ctx, newProduct)
+		Expect(err).To(BeNil())
+		return product
+	}
+
+	adjust := func(product *types.Product, qty int64, unitCost *int64) {
+		_, err := gr.Products().Adjust(ctx, product.ID, types.ProductAdjustment{Qty: qty, UnitCost: unitCost})
+		Expect(err).To(BeNil())
+	}
+
+	layersOf := func(product *types.Product) []*types.CostLayer {
+		layers := []*types.CostLayer{}
+		Expect(gr.DB().Where("product_id = ?", product.ID).OrderBy("id").Find(&layers)).To(Succeed())
+		return layers
+	}
+
+	entriesOf := func(product *types.Product) []*types.ValuationEntry {
+		entries := []*types.ValuationEntry{}
+		Expect(gr.DB().Where("product_id = ?", product.ID).OrderBy("id").Find(&entries)).To(Succeed())
+		return entries
+	}
+
+	valueOf := func(product *types.Product) int64 {
+		var value int64
+		for _, entry := range entriesOf(product) {
+			value += entry.Value
+		}
+		return value
+	}
+
+	Context("FIFO", func() {
+		It("should add a cost layer for each receipt at its unit cost", func() {
+			product := create(types.NewProduct{Qty: 10, UnitCost: utils.Ref(int64(100))})
+			adjust(product, 5, utils.Ref(int64(200)))
+
+			layers := layersOf(product)
+			Expect(layers).To(HaveLen(2))
+			Expect(layers[0].Remaining).To(BeNumerically("==", 10))
+			Expect(layers[0].UnitCost).To(BeNumerically("==", 100))
+			Expect(layers[1].Remaining).To(BeNumerically("==", 5))
+			Expect(layers[1].UnitCost).To(BeNumerically("==", 200))
+
+			Expect(valueOf(product)).To(BeNumerically("==", 2000))
+		})
+
+		It("should draw down the oldest layers first and value them at their own costs", func() {
+			product := create(types.NewProduct{Qty: 10, UnitCost: utils.Ref(int64(100))})
+			adjust(product, 5, utils.Ref(int64(200)))
+			adjust(product, -12, nil)
+
+			layers := layersOf(product)
+			Expect(layers[0].Remaining).To(BeNumerically("==", 0))
+			Expect(layers[1].Remaining).To(BeNumerically("==", 3))
+
+			entries := entriesOf(product)
+			Expect(entries[len(entries)-1].Qty).To(BeNumerically("==", -12))
+			Expect(entries[len(entries)-1].Value).To(BeNumerically("==", -1400))
+			Expect(valueOf(product)).To(BeNumerically("==", 600))
+		})
+
+		It("should receive stock without a unit cost at what the stock on hand is worth", func() {
+			product := create(types.NewProduct{Qty: 10, UnitCost: utils.Ref(int64(100))})
+			adjust(product, 10, utils.Ref(int64(300)))
+			adjust(product, 5, nil)
+
+			layers := layersOf(product)
+			Expect(layers[2].UnitCost).To(BeNumerically("==", 200))
+
+			// with nothing on hand it's the cost of the last layer
+			adjust(product, -25, nil)
+			adjust(product, 2, nil)
+
+			layers = layersOf(product)
+			Expect(layers[3].UnitCost).To(BeNumerically("==", 200))
+		})
+
+		It("should draw down stock no layer accounts for at what the stock on hand is worth", func() {
+			product := create(types.NewProduct{Qty: 10, UnitCost: utils.Ref(int64(100))})
+
+			// like stock that was on hand before it was valued
+			_, err := gr.DB().Exec("DELETE FROM cost_layers WHERE product_id = ?", product.ID)
+			Expect(err).To(BeNil())
+
+			adjust(product, -4, nil)
+
+			entries := entriesOf(product)
+			Expect(entries[len(entries)-1].Value).To(BeNumerically("==", -400))
+			Expect(valueOf(product)).To(BeNumerically("==", 600))
+		})
+	})
+
+	Context("Average", func() {
+		It("should value what goes out at its share of everything on hand", func() {
+			product := create(types.NewProduct{Qty: 10, UnitCost: utils.Ref(int64(100)), CostMethod: types.CostMethodAverage})
+			adjust(product, 10, utils.Ref(int64(200)))
+			adjust(product, -5, nil)
+
+			entries := entriesOf(product)
+			Expect(entries[len(entries)-1].Value).To(BeNumerically("==", -750))
+			Expect(valueOf(product)).To(BeNumerically("==", 2250))
+
+			// the layers are still drawn down oldest first
+			layers := layersOf(product)
+			Expect(layers[0].Remaining).To(BeNumerically("==", 5))
+			Expect(layers[1].Remaining).To(BeNumerically("==", 10))
+		})
+
+		It("should take all of the value with the last of the stock", func() {
+			product := create(types.NewProduct{Qty: 3, UnitCost: utils.Ref(int64(100)), CostMethod: types.CostMethodAverage})
+			adjust(product, 4, utils.Ref(int64(150)))
+			adjust(product, -7, nil)
+
+			Expect(valueOf(product)).To(BeNumerically("==", 0))
+		})
+	})
+
+	Context("Standard", func() {
+		It("should value everything at the standard cost whatever it was bought at", func() {
+			product := create(types.NewProduct{Qty: 4, CostMethod: types.CostMethodStandard, StandardCost: 300})
+			adjust(product, 2, utils.Ref(int64(999)))
+
+			layers := layersOf(product)
+			Expect(layers[1].UnitCost).To(BeNumerically("==", 300))
+
+			adjust(product, -1, nil)
+
+			entries := entriesOf(product)
+			Expect(entries[len(entries)-1].Value).To(BeNumerically("==", -300))
+			Expect(valueOf(product)).To(BeNumerically("==", 1500))
+		})
+
+		It("should revalue what's on hand when the standard cost changes", func() {
+			product := create(types.NewProduct{Qty: 4, CostMethod: types.CostMethodStandard, StandardCost: 300})
+			adjust(product, -1, nil)
+
+			_, err := gr.Products().Update(ctx, &types.UpdateProduct{ID: product.ID, StandardCost: utils.Ref(int64(250))})
+			Expect(err).To(BeNil())
+
+			entries := entriesOf(product)
+			Expect(entries[len(entries)-1].Qty).To(BeNumerically("==", 0))
+			Expect(entries[len(entries)-1].Value).To(BeNumerically("==", -150))
+			Expect(valueOf(product)).To(BeNumerically("==", 750))
+
+			layers := layersOf(product)
+			Expect(layers[0].UnitCost).To(BeNumerically("==", 250))
+		})
+
+		It("should not revalue when nothing is on hand", func() {
+			product := create(types.NewProduct{CostMethod: types.CostMethodStandard, StandardCost: 300})
+
+			_, err := gr.Products().Update(ctx, &types.UpdateProduct{ID: product.ID, StandardCost: utils.Ref(int64(250))})
+			Expect(err).To(BeNil())
+
+			Expect(entriesOf(product)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,422 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	"xorm.io/xorm"
+)
+
+type WorkOrdersFind struct {
+	Limit      int
+	Offset     int
+	IDs        []int64
+	ProductIDs []int64
+	Statuses   []types.WorkOrderStatus
+}
+
+//go:generate mockgen -source=./workOrders.go -destination=./mocks/WorkOrders.go -package=mock_repos WorkOrders
+type WorkOrders interface {
+	Find(ctx context.Context, opts *WorkOrdersFind) ([]*types.WorkOrder, int64, error)
+	FindTx(ctx context.Context, tx *xorm.Session, opts *WorkOrdersFind) ([]*types.WorkOrder, int64, error)
+	Get(ctx context.Context, id int64) (*types.WorkOrder, bool, error)
+	GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.WorkOrder, bool, error)
+	Create(ctx context.Context, newOrder types.NewWorkOrder) (*types.WorkOrder, error)
+	CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewWorkOrder) (*types.WorkOrder, error)
+	Complete(ctx context.Context, complete *types.CompleteWorkOrder) (*types.WorkOrder, error)
+	CompleteTx(ctx context.Context, tx *xorm.Session, complete *types.CompleteWorkOrder) (*types.WorkOrder, error)
+	Cancel(ctx context.Context, id int64) (*types.WorkOrder, error)
+	CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.WorkOrder, error)
+}
+
+// NewWorkOrders - work orders reserve, use up and make stock so they go through those repos, and
+// hand what they free up or make to waiting sales orders, all in the same transaction
+func NewWorkOrders(db *xorm.Engine, products Products, stockLevels StockLevels, stockMovements StockMovements, boms Boms, salesOrders SalesOrders) WorkOrders {
+	return &workOrdersRepo{db, products, stockLevels, stockMovements, boms, salesOrders}
+}
+
+type workOrdersRepo struct {
+	db             *xorm.Engine
+	products       Products
+	stockLevels    StockLevels
+	stockMovements StockMovements
+	boms           Boms
+	salesOrders    SalesOrders
+}
+
+// workOrderReference - the reference type work orders write to the ledger
+var workOrderReference = "work_order"
+
+func (r *workOrdersRepo) Find(ctx context.Context, opts *WorkOrdersFind) ([]*types.WorkOrder, int64, error) {
+	var count int64
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		w, c, e := r.FindTx(ctx, tx, opts)
+		if e != nil {
+			return nil, e
+		}
+		count = c
+		return w, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.([]*types.WorkOrder), count, nil
+}
+
+func (r *workOrdersRepo) FindTx(ctx context.Context, tx *xorm.Session, opts *WorkOrdersFind) ([]*types.WorkOrder, int64, error) {
+	if opts == nil {
+		opts = &WorkOrdersFind{Limit: 25}
+	}
+
+	if opts.Limit > 0 {
+		if opts.Offset > 0 {
+			tx = tx.Limit(opts.Limit, opts.Offset)
+		} else {
+			tx = tx.Limit(opts.Limit)
+		}
+	}
+
+	if len(opts.IDs) > 0 {
+		tx = tx.In("id", utils.Int64ArrToInterfaceArr(opts.IDs...)...)
+	}
+
+	if len(opts.ProductIDs) > 0 {
+		tx = tx.In("product_id", utils.Int64ArrToInterfaceArr(opts.ProductIDs...)...)
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := []interface{}{}
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, status)
+		}
+		tx = tx.In("status", statuses...)
+	}
+
+	objs := []*types.WorkOrder{}
+	count, err := tx.OrderBy("id").FindAndCount(&objs)
+	if err != nil {
+		return nil, 0, normalizeErr("work_orders", err)
+	}
+
+	if err := r.loadComponents(tx, objs...); err != nil {
+		return nil, 0, err
+	}
+
+	return objs, count, nil
+}
+
+func (r *workOrdersRepo) Get(ctx context.Context, id int64) (*types.WorkOrder, bool, error) {
+	var exists bool
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		w, ex, e := r.GetTx(ctx, tx, id)
+		if e != nil {
+			return nil, e
+		}
+		exists = ex
+		return w, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.(*types.WorkOrder), exists, nil
+}
+
+func (r *workOrdersRepo) GetTx(ctx context.Context, tx *xorm.Session, id int64) (*types.WorkOrder, bool, error) {
+	obj := &types.WorkOrder{}
+	exists, err := tx.Where("id = ?", id).Get(obj)
+	if err != nil {
+		return nil, false, normalizeErr("work_orders", err)
+	}
+	if !exists {
+		return nil, exists, nil
+	}
+
+	if err := r.loadComponents(tx, obj); err != nil {
+		return nil, false, err
+	}
+
+	return obj, exists, nil
+}
+
+func (r *workOrdersRepo) Create(ctx context.Context, newOrder types.NewWorkOrder) (*types.WorkOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CreateTx(ctx, tx, newOrder)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.WorkOrder), nil
+}
+
+// CreateTx - releases a work order for the product's bill of materials. Every component it
+// needs is reserved up front so it fails if any of them aren't available.
+func (r *workOrdersRepo) CreateTx(ctx context.Context, tx *xorm.Session, newOrder types.NewWorkOrder) (*types.WorkOrder, error) {
+	if err := types.Validate(newOrder); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	lines, err := r.boms.GetTx(ctx, tx, newOrder.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, types.NewBadRequestError("product has no bill of materials")
+	}
+
+	if newOrder.LocationID != nil {
+		exists, err := tx.Table("locations").Where("id = ?", *newOrder.LocationID).Exist()
+		if err != nil {
+			return nil, normalizeErr("locations", err)
+		}
+		if !exists {
+			return nil, types.NewNotFoundError("location not found by id")
+		}
+	}
+
+	obj := &types.WorkOrder{
+		ProductID:  newOrder.ProductID,
+		Qty:        newOrder.Qty,
+		Status:     types.WorkOrderStatusReleased,
+		LocationID: newOrder.LocationID,
+		Notes:      newOrder.Notes,
+		CreatedAt:  time.Now(),
+		Components: []*types.WorkOrderComponent{},
+	}
+
+	if _, err := tx.Insert(obj); err != nil {
+		return nil, normalizeErr("work_orders", err)
+	}
+
+	for _, line := range lines {
+		component := &types.WorkOrderComponent{
+			WorkOrderID: obj.ID,
+			ComponentID: line.ComponentID,
+			QtyPer:      line.Qty,
+			QtyReserved: line.Qty * obj.Qty,
+			CreatedAt:   time.Now(),
+		}
+
+		if _, err := r.products.AdjustTx(ctx, tx, component.ComponentID, types.ProductAdjustment{Allocated: component.QtyReserved}); err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.Insert(component); err != nil {
+			return nil, normalizeErr("work_order_components", err)
+		}
+
+		obj.Components = append(obj.Components, component)
+	}
+
+	return obj, nil
+}
+
+func (r *workOrdersRepo) Complete(ctx context.Context, complete *types.CompleteWorkOrder) (*types.WorkOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CompleteTx(ctx, tx, complete)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.WorkOrder), nil
+}
+
+// CompleteTx - records finished and scrapped units. Their components come out of the reservation
+// and off hand (see consumeStock), the finished units go on hand at the work order's location and
+// are offered to waiting sales orders. Finished units cost what the components used for them and
+// anything scrapped alongside them were worth. It can be called as units come off the line, the
+// work order is completed once nothing remains.
+func (r *workOrdersRepo) CompleteTx(ctx context.Context, tx *xorm.Session, complete *types.CompleteWorkOrder) (*types.WorkOrder, error) {
+	if err := types.Validate(complete); err != nil {
+		return nil, types.NewBadRequestError(err.Error())
+	}
+
+	done := complete.QtyCompleted + complete.QtyScrapped
+	if done == 0 {
+		return nil, types.NewBadRequestError("nothing was completed or scrapped")
+	}
+
+	obj, err := r.lockForTransition(tx, complete.ID, types.WorkOrderStatusReleased)
+	if err != nil {
+		return nil, err
+	}
+
+	if done > obj.Remaining() {
+		return nil, types.NewBadRequestError("can not complete more than remains on the work order")
+	}
+
+	if err := r.loadComponents(tx, obj); err != nil {
+		return nil, err
+	}
+
+	var cost int64
+	for _, component := range obj.Components {
+		qty := done * component.QtyPer
+
+		if _, err := r.products.AdjustTx(ctx, tx, component.ComponentID, types.ProductAdjustment{Allocated: -qty}); err != nil {
+			return nil, err
+		}
+
+		value, err := consumeStock(ctx, tx, r.products, r.stockLevels, r.stockMovements, component.ComponentID, qty, types.NewStockMovement{
+			Reason:        types.StockMovementReasonWorkOrder,
+			ReferenceType: &workOrderReference,
+			ReferenceID:   &obj.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cost += value
+
+		component.QtyReserved -= qty
+		component.QtyConsumed += qty
+		if _, err := tx.ID(component.ID).Cols("qty_reserved", "qty_consumed").Update(component); err != nil {
+			return nil, normalizeErr("work_order_components", err)
+		}
+	}
+
+	if complete.QtyCompleted > 0 {
+		unitCost := cost / complete.QtyCompleted
+		if obj.LocationID != nil {
+			if _, err := r.stockLevels.ReceiveTx(ctx, tx, obj.ProductID, *obj.LocationID, complete.QtyCompleted, unitCost); err != nil {
+				return nil, err
+			}
+		} else if _, err := r.products.AdjustTx(ctx, tx, obj.ProductID, types.ProductAdjustment{
+			Qty: complete.QtyCompleted, UnitCost: &unitCost,
+		}); err != nil {
+			return nil, err
+		}
+
+		if _, err := r.stockMovements.CreateTx(ctx, tx, types.NewStockMovement{
+			ProductID:     obj.ProductID,
+			LocationID:    obj.LocationID,
+			Qty:           complete.QtyCompleted,
+			Reason:        types.StockMovementReasonWorkOrder,
+			ReferenceType: &workOrderReference,
+			ReferenceID:   &obj.ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	obj.QtyCompleted += complete.QtyCompleted
+	obj.QtyScrapped += complete.QtyScrapped
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if obj.Remaining() == 0 {
+		obj.Status = types.WorkOrderStatusCompleted
+		obj.CompletedAt = obj.UpdatedAt
+	}
+
+	if _, err := tx.ID(obj.ID).Cols("qty_completed", "qty_scrapped", "status", "completed_at", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("work_orders", err)
+	}
+
+	if complete.QtyCompleted > 0 {
+		if _, err := r.salesOrders.AllocateTx(ctx, tx, obj.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func (r *workOrdersRepo) Cancel(ctx context.Context, id int64) (*types.WorkOrder, error) {
+	res, err := wrapInSession(r.db, func(tx *xorm.Session) (any, error) {
+		return r.CancelTx(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*types.WorkOrder), nil
+}
+
+// CancelTx - closes a released work order, whatever's already been completed stays made and the
+// components still reserved are given back and offered to waiting sales orders
+func (r *workOrdersRepo) CancelTx(ctx context.Context, tx *xorm.Session, id int64) (*types.WorkOrder, error) {
+	obj, err := r.lockForTransition(tx, id, types.WorkOrderStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadComponents(tx, obj); err != nil {
+		return nil, err
+	}
+
+	released := []int64{}
+	for _, component := range obj.Components {
+		if component.QtyReserved == 0 {
+			continue
+		}
+
+		if _, err := r.products.AdjustTx(ctx, tx, component.ComponentID, types.ProductAdjustment{Allocated: -component.QtyReserved}); err != nil {
+			return nil, err
+		}
+
+		component.QtyReserved = 0
+		if _, err := tx.ID(component.ID).Cols("qty_reserved").Update(component); err != nil {
+			return nil, normalizeErr("work_order_components", err)
+		}
+		released = append(released, component.ComponentID)
+	}
+
+	obj.Status = types.WorkOrderStatusCancelled
+	obj.UpdatedAt = utils.Ref(time.Now())
+	if _, err := tx.ID(obj.ID).Cols("status", "updated_at").Update(obj); err != nil {
+		return nil, normalizeErr("work_orders", err)
+	}
+
+	if len(released) > 0 {
+		if _, err := r.salesOrders.AllocateTx(ctx, tx, released...); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+func (r *workOrdersRepo) lockForTransition(tx *xorm.Session, id int64, to types.WorkOrderStatus) (*types.WorkOrder, error) {
+	obj := &types.WorkOrder{}
+	exists, err := tx.Where("id = ?", id).ForUpdate().Get(obj)
+	if err != nil {
+		return nil, normalizeErr("work_orders", err)
+	}
+	if !exists {
+		return nil, types.NewNotFoundError("work order not found by id")
+	}
+
+	if !obj.Status.CanTransition(to) {
+		return nil, types.NewBadRequestError("work order can not go from " + string(obj.Status) + " to " + string(to))
+	}
+
+	return obj, nil
+}
+
+func (r *workOrdersRepo) loadComponents(tx *xorm.Session, orders ...*types.WorkOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := map[int64]*types.WorkOrder{}
+	ids := []int64{}
+	for _, order := range orders {
+		order.Components = []*types.WorkOrderComponent{}
+		byID[order.ID] = order
+		ids = append(ids, order.ID)
+	}
+
+	components := []*types.WorkOrderComponent{}
+	if err := tx.In("work_order_id", utils.Int64ArrToInterfaceArr(ids...)...).OrderBy("id").Find(&components); err != nil {
+		return normalizeErr("work_order_components", err)
+	}
+
+	for _, component := range components {
+		byID[component.WorkOrderID].Components = append(byID[component.WorkOrderID].Components, component)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package repos_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/repos"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("REPOS: WorkOrders", func() {
+
+	var (
+		repo     repos.WorkOrders
+		finished *types.Product
+		shade    *types.Product
+		bulb     *types.Product
+	)
+
+	BeforeEach(func() {
+		clearDatabase("work_orders", "bom_lines", "stock_movements", "sales_orders", "sales_order_lines", "stock_levels", "locations", "products")
+
+		repo = gr.WorkOrders()
+		Expect(repo).NotTo(BeNil())
+
+		var err error
+		finished, err = gr.Products().Create(ctx, types.NewProduct{Name: "lamp", Sku: "lamp", Qty: 1})
+		Expect(err).To(BeNil())
+		shade, err = gr.Products().Create(ctx, types.NewProduct{Name: "shade", Sku: "shade", Qty: 10})
+		Expect(err).To(BeNil())
+		bulb, err = gr.Products().Create(ctx, types.NewProduct{Name: "bulb", Sku: "bulb", Qty: 20})
+		Expect(err).To(BeNil())
+
+		_, err = gr.Boms().Set(ctx, &types.SetBom{ProductID: finished.ID, Lines: []types.NewBomLine{
+			{ComponentID: shade.ID, Qty: 1},
+			{ComponentID: bulb.ID, Qty: 2},
+		}})
+		Expect(err).To(BeNil())
+	})
+
+	productOf := func(id int64) *types.Product {
+		p, _, err := gr.Products().Get(ctx, id)
+		Expect(err).To(BeNil())
+		return p
+	}
+
+	Context("Create(Tx)", func() {
+		It("should reserve the components", func() {
+			order, err := repo.Create(ctx, types.NewWorkOrder{ProductID: finished.ID, Qty: 5})
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.WorkOrderStatusReleased))
+			Expect(order.Components).To(HaveLen(2))
+
+			Expect(productOf(shade.ID).Allocated).To(BeNumerically("==", 5))
+			Expect(productOf(bulb.ID).Allocated).To(BeNumerically("==", 10))
+		})
+
+		It("should fail without a bill of materials or enough components", func() {
+			_, err := repo.Create(ctx, types.NewWorkOrder{ProductID: shade.ID, Qty: 1})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			_, err = repo.Create(ctx, types.NewWorkOrder{ProductID: finished.ID, Qty: 11})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+			Expect(productOf(bulb.ID).Allocated).To(BeNumerically("==", 0))
+		})
+	})
+
+	Context("Complete(Tx)", func() {
+		It("should use up components and make finished goods as units come off the line", func() {
+			location, err := gr.Locations().Create(ctx, types.NewLocation{Name: "Main", Code: "MAIN"})
+			Expect(err).To(BeNil())
+
+			order, err := repo.Create(ctx, types.NewWorkOrder{ProductID: finished.ID, Qty: 5, LocationID: &location.ID})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Complete(ctx, &types.CompleteWorkOrder{ID: order.ID, QtyCompleted: 5, QtyScrapped: 1})
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+
+			order, err = repo.Complete(ctx, &types.CompleteWorkOrder{ID: order.ID, QtyCompleted: 3, QtyScrapped: 1})
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.WorkOrderStatusReleased))
+			Expect(order.Remaining()).To(BeNumerically("==", 1))
+
+			// scrapped units use components up too
+			Expect(productOf(shade.ID).Qty).To(BeNumerically("==", 6))
+			Expect(productOf(shade.ID).Allocated).To(BeNumerically("==", 1))
+			Expect(productOf(bulb.ID).Qty).To(BeNumerically("==", 12))
+			Expect(productOf(finished.ID).Qty).To(BeNumerically("==", 4))
+
+			levels, _, err := gr.StockLevels().Find(ctx, &repos.StockLevelsFind{ProductIDs: []int64{finished.ID}})
+			Expect(err).To(BeNil())
+			Expect(levels[0].Qty).To(BeNumerically("==", 3))
+
+			order, err = repo.Complete(ctx, &types.CompleteWorkOrder{ID: order.ID, QtyCompleted: 1})
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.WorkOrderStatusCompleted))
+			Expect(order.CompletedAt).NotTo(BeNil())
+
+			_, count, err := gr.StockMovements().Find(ctx, &repos.StockMovementsFind{
+				ReferenceType: utils.Ref("work_order"), ReferenceIDs: []int64{order.ID},
+			})
+			Expect(err).To(BeNil())
+			Expect(count).To(BeNumerically("==", 6))
+		})
+
+		It("should hand finished goods to backorders", func() {
+			sale, err := gr.SalesOrders().Create(ctx, types.NewSalesOrder{
+				CustomerName: "Jane Doe",
+				Lines:        []types.NewSalesOrderLine{{ProductID: finished.ID, Qty: 3}},
+			})
+			Expect(err).To(BeNil())
+			_, err = gr.SalesOrders().Transition(ctx, sale.ID, types.SalesOrderStatusConfirmed)
+			Expect(err).To(BeNil())
+
+			order, err := repo.Create(ctx, types.NewWorkOrder{ProductID: finished.ID, Qty: 2})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Complete(ctx, &types.CompleteWorkOrder{ID: order.ID, QtyCompleted: 2})
+			Expect(err).To(BeNil())
+			Expect(productOf(finished.ID).Allocated).To(BeNumerically("==", 3))
+		})
+	})
+
+	Context("Cancel(Tx)", func() {
+		It("should give back what's still reserved", func() {
+			order, err := repo.Create(ctx, types.NewWorkOrder{ProductID: finished.ID, Qty: 4})
+			Expect(err).To(BeNil())
+
+			_, err = repo.Complete(ctx, &types.CompleteWorkOrder{ID: order.ID, QtyCompleted: 1})
+			Expect(err).To(BeNil())
+
+			order, err = repo.Cancel(ctx, order.ID)
+			Expect(err).To(BeNil())
+			Expect(order.Status).To(Equal(types.WorkOrderStatusCancelled))
+			Expect(productOf(shade.ID).Allocated).To(BeNumerically("==", 0))
+			Expect(productOf(shade.ID).Qty).To(BeNumerically("==", 9))
+
+			_, err = repo.Cancel(ctx, order.ID)
+			Expect(types.IsBadRequestError(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// NewLimited - wraps a backend so Put refuses anything over maxBytes
+func NewLimited(s Storage, maxBytes int64) Storage {
+	return &limitedStorage{Storage: s, maxBytes: maxBytes}
+}
+
+type limitedStorage struct {
+	Storage
+	maxBytes int64
+}
+
+func (s *limitedStorage) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	lr := &limitReader{r: r, remaining: s.maxBytes}
+
+	n, err := s.Storage.Put(ctx, key, lr)
+	if lr.exceeded {
+		if n > 0 {
+			// the backend may have kept what it was given before the limit was hit
+			s.Storage.Delete(ctx, key)
+		}
+		return 0, ErrTooLarge
+	}
+
+	return n, err
+}
+
+// limitReader - like io.LimitReader but fails instead of quietly truncating
+type limitReader struct {
+	r         io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		l.exceeded = true
+		return 0, ErrTooLarge
+	}
+
+	// read one byte past the limit so we can tell "exactly the limit" from "over it"
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		l.exceeded = true
+		return n, ErrTooLarge
+	}
+
+	return n, err
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+)
+
+// NewLocal - stores files under root on the local filesystem
+func NewLocal(root string) (Storage, error) {
+	if root == "" {
+		return nil, errors.New("local storage requires a path")
+	}
+
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &localStorage{root: abs}, nil
+}
+
+type localStorage struct {
+	root string
+}
+
+// path - maps a key to a file under root, keys can never escape it
+func (s *localStorage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || strings.HasSuffix(key, "/") {
+		return "", types.NewBadRequestError("invalid storage key")
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return 0, err
+	}
+
+	// write to a temp file first so a failed upload never leaves half a file behind
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return 0, err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, types.NewNotFoundError("file not found")
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return types.NewNotFoundError("file not found")
+		}
+		return err
+	}
+
+	return nil
+}
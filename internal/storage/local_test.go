@@ -0,0 +1,103 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/config"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/storage"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("STORAGE: Local", func() {
+	var (
+		ctx  context.Context
+		root string
+		s    storage.Storage
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		root = GinkgoT().TempDir()
+
+		var err error
+		s, err = storage.New(config.StorageConfig{Driver: "local", Path: root, MaxUploadBytes: 10})
+		Expect(err).To(BeNil())
+	})
+
+	It("should refuse unknown drivers", func() {
+		_, err := storage.New(config.StorageConfig{Driver: "ftp"})
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should round trip a file", func() {
+		n, err := s.Put(ctx, "products/1/a.txt", bytes.NewBufferString("hello"))
+		Expect(err).To(BeNil())
+		Expect(n).To(BeNumerically("==", 5))
+
+		rc, err := s.Get(ctx, "products/1/a.txt")
+		Expect(err).To(BeNil())
+		defer rc.Close()
+
+		bts, err := io.ReadAll(rc)
+		Expect(err).To(BeNil())
+		Expect(string(bts)).To(Equal("hello"))
+	})
+
+	It("should keep keys inside the root", func() {
+		_, err := s.Put(ctx, "../../escape.txt", bytes.NewBufferString("x"))
+		Expect(err).To(BeNil())
+
+		_, err = os.Stat(filepath.Join(root, "escape.txt"))
+		Expect(err).To(BeNil())
+
+		_, err = s.Put(ctx, "/", bytes.NewBufferString("x"))
+		Expect(types.IsBadRequestError(err)).To(BeTrue())
+	})
+
+	It("should return not found for missing files", func() {
+		_, err := s.Get(ctx, "nope")
+		Expect(types.IsNotFoundError(err)).To(BeTrue())
+		Expect(types.IsNotFoundError(s.Delete(ctx, "nope"))).To(BeTrue())
+	})
+
+	It("should delete files", func() {
+		_, err := s.Put(ctx, "a.txt", bytes.NewBufferString("x"))
+		Expect(err).To(BeNil())
+
+		Expect(s.Delete(ctx, "a.txt")).To(Succeed())
+
+		_, err = s.Get(ctx, "a.txt")
+		Expect(types.IsNotFoundError(err)).To(BeTrue())
+	})
+
+	It("should accept a file exactly at the limit", func() {
+		n, err := s.Put(ctx, "a.txt", bytes.NewBufferString("0123456789"))
+		Expect(err).To(BeNil())
+		Expect(n).To(BeNumerically("==", 10))
+	})
+
+	It("should reject files over the limit without leaving anything behind", func() {
+		_, err := s.Put(ctx, "a.txt", bytes.NewBufferString("0123456789a"))
+		Expect(err).To(Equal(storage.ErrTooLarge))
+
+		_, err = s.Get(ctx, "a.txt")
+		Expect(types.IsNotFoundError(err)).To(BeTrue())
+
+		entries, err := os.ReadDir(root)
+		Expect(err).To(BeNil())
+		Expect(entries).To(BeEmpty())
+	})
+	It("should limit uploads by default when the config doesn't", func() {
+		s, err := storage.New(config.StorageConfig{Driver: "local", Path: root})
+		Expect(err).To(BeNil())
+
+		_, err = s.Put(ctx, "a.bin", bytes.NewReader(make([]byte, storage.DefaultMaxUploadBytes+1)))
+		Expect(err).To(Equal(storage.ErrTooLarge))
+	})
+})
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/config"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+)
+
+// ErrTooLarge - returned by Put when a file is over the configured upload limit
+var ErrTooLarge = types.NewBadRequestError("file exceeds the upload limit")
+
+// DefaultMaxUploadBytes - the upload limit when the config doesn't set one
+const DefaultMaxUploadBytes = 10 << 20
+
+// Storage - where uploaded files live. Keys are slash separated paths like
+// "products/1/abc.png" so they map cleanly onto both a filesystem and an
+// S3 style bucket.
+//
+//go:generate mockgen -source=./storage.go -destination=./mocks/Storage.go -package=mock_storage Storage
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New - builds the storage backend described by the config
+func New(cfg config.StorageConfig) (Storage, error) {
+	var (
+		s   Storage
+		err error
+	)
+
+	switch cfg.Driver {
+	case "", "local":
+		s, err = NewLocal(cfg.Path)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver '%s'", cfg.Driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	maxBytes := cfg.MaxUploadBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxUploadBytes
+	}
+
+	return NewLimited(s, maxBytes), nil
+}
@@ -0,0 +1,198 @@
+package tax
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+)
+
+// Calculator - works out the tax on an order. Table is the built-in one, anything else, like an
+// outside tax service, can stand in for it.
+type Calculator interface {
+	Calculate(ctx context.Context, order Order) (*types.TaxBreakdown, error)
+}
+
+// Order - what's needed to work out the tax on a sales order
+type Order struct {
+	// Country, Region - where it ships to, nothing is charged without a country
+	Country string
+	Region  *string
+	// Exempt - the customer is tax exempt
+	Exempt bool
+	Lines  []Line
+}
+
+type Line struct {
+	// ID - the sales order line
+	ID int64
+	// Category - the product's tax category
+	Category *string
+	// Amount - in cents, the line's qty at its unit price
+	Amount int64
+}
+
+// Table - charges the rates that apply to each line, see types.TaxRate. At each level, country
+// wide then regional, rates of the line's own tax category beat the ones without a category so a
+// 0% rate exempts a category. Rates at the same level add up.
+type Table struct {
+	Rates []*types.TaxRate
+}
+
+func (t Table) Calculate(ctx context.Context, order Order) (*types.TaxBreakdown, error) {
+	breakdown := &types.TaxBreakdown{Exempt: order.Exempt, Rates: []*types.TaxAmount{}, Lines: []*types.LineTax{}}
+	if order.Country != "" {
+		breakdown.Country, breakdown.Region = &order.Country, order.Region
+	}
+
+	for _, line := range order.Lines {
+		breakdown.Subtotal += line.Amount
+		breakdown.Lines = append(breakdown.Lines, &types.LineTax{SalesOrderLineID: line.ID, Amount: line.Amount})
+	}
+	breakdown.Total = breakdown.Subtotal
+
+	if order.Exempt || order.Country == "" {
+		return breakdown, nil
+	}
+
+	// the lines each rate is charged on
+	charged := make([][]int, len(t.Rates))
+	for i, line := range order.Lines {
+		for _, regional := range []bool{false, true} {
+			for _, r := range t.applying(order, line, regional) {
+				charged[r] = append(charged[r], i)
+			}
+		}
+	}
+
+	for r, rate := range t.Rates {
+		if len(charged[r]) == 0 {
+			continue
+		}
+
+		amounts := make([]int64, len(charged[r]))
+		for j, i := range charged[r] {
+			amounts[j] = order.Lines[i].Amount
+		}
+
+		taxes := Charge(amounts, rate.Rate, rate.Rounding, rate.PerLine)
+
+		amount := &types.TaxAmount{TaxRateID: rate.ID, Name: rate.Name, Rate: rate.Rate}
+		for j, i := range charged[r] {
+			amount.Taxable += amounts[j]
+			amount.Tax += taxes[j]
+			breakdown.Lines[i].Tax += taxes[j]
+		}
+
+		breakdown.Rates = append(breakdown.Rates, amount)
+		breakdown.Tax += amount.Tax
+	}
+	breakdown.Total = breakdown.Subtotal + breakdown.Tax
+
+	return breakdown, nil
+}
+
+// applying - the indexes of the rates charged on the line at a level
+func (t Table) applying(order Order, line Line, regional bool) []int {
+	general, own := []int{}, []int{}
+	for r, rate := range t.Rates {
+		if !strings.EqualFold(rate.Country, order.Country) {
+			continue
+		}
+		if regional != (rate.Region != nil) {
+			continue
+		}
+		if regional && (order.Region == nil || !strings.EqualFold(*rate.Region, *order.Region)) {
+			continue
+		}
+
+		switch {
+		case rate.Category == nil:
+			general = append(general, r)
+		case line.Category != nil && strings.EqualFold(*rate.Category, *line.Category):
+			own = append(own, r)
+		}
+	}
+
+	if len(own) > 0 {
+		return own
+	}
+	return general
+}
+
+// Charge - the tax at a percent on each amount, in cents. Rounded per line each amount's tax is
+// rounded on its own, otherwise the tax on the total is rounded once and shared out in
+// proportion to the amounts so it still adds up.
+func Charge(amounts []int64, percent float64, rounding types.TaxRounding, perLine bool) []int64 {
+	// in millionths so the rounding is exact
+	ppm := int64(math.Round(percent * 10000))
+
+	taxes := make([]int64, len(amounts))
+	if perLine {
+		for i, amount := range amounts {
+			q, rem := mulDiv(amount, ppm, 1000000)
+			taxes[i] = Round(q, rem, 1000000, rounding)
+		}
+		return taxes
+	}
+
+	var taxable int64
+	for _, amount := range amounts {
+		taxable += amount
+	}
+	if taxable <= 0 {
+		return taxes
+	}
+
+	q, rem := mulDiv(taxable, ppm, 1000000)
+	total := Round(q, rem, 1000000, rounding)
+
+	// largest remainder, ties go to the earlier amount
+	remainders := make([]int64, len(amounts))
+	ranked := make([]int, len(amounts))
+	left := total
+	for i, amount := range amounts {
+		taxes[i], remainders[i] = mulDiv(amount, total, taxable)
+		left -= taxes[i]
+		ranked[i] = i
+	}
+	sort.SliceStable(ranked, func(a, b int) bool { return remainders[ranked[a]] > remainders[ranked[b]] })
+	for _, i := range ranked[:left] {
+		taxes[i]++
+	}
+
+	return taxes
+}
+
+// Round - rounds q and a remainder of rem out of d to a whole number
+func Round(q, rem, d int64, rounding types.TaxRounding) int64 {
+	if rem == 0 {
+		return q
+	}
+
+	switch rounding {
+	case types.TaxRoundingDown:
+		return q
+	case types.TaxRoundingUp:
+		return q + 1
+	case types.TaxRoundingHalfEven:
+		if 2*rem > d || (2*rem == d && q%2 == 1) {
+			return q + 1
+		}
+		return q
+	default:
+		if 2*rem >= d {
+			return q + 1
+		}
+		return q
+	}
+}
+
+// mulDiv - a*b/c and its remainder without a*b overflowing
+func mulDiv(a, b, c int64) (q, rem int64) {
+	quo, mod := new(big.Int).QuoRem(new(big.Int).Mul(big.NewInt(a), big.NewInt(b)), big.NewInt(c), new(big.Int))
+	return quo.Int64(), mod.Int64()
+}
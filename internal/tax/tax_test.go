@@ -0,0 +1,108 @@
+package tax_test
+
+import (
+	"context"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/tax"
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TAX", func() {
+
+	Context("Round", func() {
+		It("should round by each rule", func() {
+			Expect(tax.Round(2, 50, 100, types.TaxRoundingHalfUp)).To(BeNumerically("==", 3))
+			Expect(tax.Round(2, 49, 100, types.TaxRoundingHalfUp)).To(BeNumerically("==", 2))
+			Expect(tax.Round(2, 50, 100, types.TaxRoundingHalfEven)).To(BeNumerically("==", 2))
+			Expect(tax.Round(3, 50, 100, types.TaxRoundingHalfEven)).To(BeNumerically("==", 4))
+			Expect(tax.Round(2, 1, 100, types.TaxRoundingUp)).To(BeNumerically("==", 3))
+			Expect(tax.Round(2, 99, 100, types.TaxRoundingDown)).To(BeNumerically("==", 2))
+			Expect(tax.Round(2, 0, 100, types.TaxRoundingUp)).To(BeNumerically("==", 2))
+		})
+	})
+
+	Context("Charge", func() {
+		It("should round each line on its own", func() {
+			// 8.25% of 10 cents is 0.825
+			Expect(tax.Charge([]int64{10, 10, 10}, 8.25, types.TaxRoundingHalfUp, true)).To(Equal([]int64{1, 1, 1}))
+		})
+
+		It("should round the total once and share it out", func() {
+			taxes := tax.Charge([]int64{10, 10, 10}, 8.25, types.TaxRoundingHalfUp, false)
+			Expect(taxes).To(Equal([]int64{1, 1, 0}))
+
+			// 7% of 150 is 10.5, shared out as 6.67 and 3.33
+			Expect(tax.Charge([]int64{100, 50}, 7, types.TaxRoundingHalfEven, false)).To(Equal([]int64{7, 3}))
+			Expect(tax.Charge([]int64{1999, 4999, 12}, 7, types.TaxRoundingHalfUp, false)).To(Equal([]int64{140, 350, 1}))
+		})
+
+		It("should charge nothing on nothing", func() {
+			Expect(tax.Charge([]int64{0}, 10, types.TaxRoundingUp, false)).To(Equal([]int64{0}))
+		})
+	})
+
+	Context("Table", func() {
+		var table tax.Table
+
+		BeforeEach(func() {
+			table = tax.Table{Rates: []*types.TaxRate{
+				{ID: 1, Name: "Federal", Country: "US", Rate: 5},
+				{ID: 2, Name: "Texas", Country: "US", Region: utils.Ref("TX"), Rate: 1.25},
+				{ID: 3, Name: "Texas food", Country: "US", Region: utils.Ref("TX"), Category: utils.Ref("food"), Rate: 0},
+				{ID: 4, Name: "VAT", Country: "GB", Rate: 20},
+			}}
+		})
+
+		lines := []tax.Line{
+			{ID: 10, Amount: 10000},
+			{ID: 11, Category: utils.Ref("food"), Amount: 2000},
+		}
+
+		It("should add up country wide and regional rates", func() {
+			res, err := table.Calculate(context.Background(), tax.Order{Country: "us", Region: utils.Ref("tx"), Lines: lines})
+			Expect(err).To(BeNil())
+			Expect(res.Subtotal).To(BeNumerically("==", 12000))
+			// 5% on both, 1.25% only on what isn't food
+			Expect(res.Tax).To(BeNumerically("==", 600+125))
+			Expect(res.Total).To(BeNumerically("==", 12725))
+
+			Expect(res.Rates).To(HaveLen(3))
+			Expect(res.Rates[0].TaxRateID).To(BeNumerically("==", 1))
+			Expect(res.Rates[0].Taxable).To(BeNumerically("==", 12000))
+			Expect(res.Rates[1].TaxRateID).To(BeNumerically("==", 2))
+			Expect(res.Rates[1].Taxable).To(BeNumerically("==", 10000))
+			Expect(res.Rates[2].TaxRateID).To(BeNumerically("==", 3))
+			Expect(res.Rates[2].Tax).To(BeZero())
+
+			Expect(res.Lines[0].Tax).To(BeNumerically("==", 625))
+			Expect(res.Lines[1].Tax).To(BeNumerically("==", 100))
+		})
+
+		It("should only charge the country wide rates outside of a region", func() {
+			res, err := table.Calculate(context.Background(), tax.Order{Country: "US", Region: utils.Ref("CA"), Lines: lines})
+			Expect(err).To(BeNil())
+			Expect(res.Tax).To(BeNumerically("==", 600))
+			Expect(res.Rates).To(HaveLen(1))
+		})
+
+		It("should not charge tax exempt customers", func() {
+			res, err := table.Calculate(context.Background(), tax.Order{Country: "US", Region: utils.Ref("TX"), Exempt: true, Lines: lines})
+			Expect(err).To(BeNil())
+			Expect(res.Exempt).To(BeTrue())
+			Expect(res.Tax).To(BeZero())
+			Expect(res.Total).To(BeNumerically("==", 12000))
+			Expect(res.Rates).To(BeEmpty())
+		})
+
+		It("should not charge anything without a country", func() {
+			res, err := table.Calculate(context.Background(), tax.Order{Lines: lines})
+			Expect(err).To(BeNil())
+			Expect(res.Country).To(BeNil())
+			Expect(res.Tax).To(BeZero())
+			Expect(res.Lines).To(HaveLen(2))
+		})
+	})
+})
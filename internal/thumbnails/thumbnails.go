@@ -0,0 +1,114 @@
+package thumbnails
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+// DefaultSize - thumbnails fit inside a square this many pixels wide
+const DefaultSize = 256
+
+// MaxPixels - images with more pixels than this aren't decoded. A few kilobytes of png or gif
+// can claim to be 30000x30000 and decoding that would take gigabytes.
+const MaxPixels = 40_000_000
+
+// ErrTooLarge - returned by Generate for images over MaxPixels
+var ErrTooLarge = errors.New("image is too large to thumbnail")
+
+// Supported - whether a thumbnail can be made for files of this content type
+func Supported(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Generate - decodes a jpeg, png or gif and returns a jpeg that fits inside
+// size x size while keeping the aspect ratio. Images that are already small
+// enough are re-encoded but not enlarged. The header is checked first so images over
+// MaxPixels are refused before anything is allocated for them.
+func Generate(r io.Reader, size int) ([]byte, error) {
+	// keep what DecodeConfig reads so the full decode can start from the top again
+	head := new(bytes.Buffer)
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, head))
+	if err != nil {
+		return nil, err
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, ErrTooLarge
+	}
+
+	src, _, err := image.Decode(io.MultiReader(head, r))
+	if err != nil {
+		return nil, err
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w > size || h > size {
+		if w >= h {
+			h = max(1, h*size/w)
+			w = size
+		} else {
+			w = max(1, w*size/h)
+			h = size
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jpeg.Encode(buf, shrink(src, w, h), &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// shrink - box filter downscale, every destination pixel is the average of the
+// source pixels it covers which is plenty for thumbnails
+func shrink(src image.Image, w, h int) *image.RGBA {
+	// flatten onto white first so transparent pngs don't turn black in the jpeg
+	b := src.Bounds()
+	flat := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(flat, flat.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, b.Min, draw.Over)
+
+	sw, sh := flat.Bounds().Dx(), flat.Bounds().Dy()
+	if sw == w && sh == h {
+		return flat
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+
+			var r, g, bl, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := flat.Pix[sy*flat.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					px := row[sx*4 : sx*4+4]
+					r += int(px[0])
+					g += int(px[1])
+					bl += int(px[2])
+					a += int(px[3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(bl / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,13 @@
+package thumbnails_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestThumbnails(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Thumbnails Suite")
+}
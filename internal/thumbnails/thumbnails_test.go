@@ -0,0 +1,83 @@
+package thumbnails_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/thumbnails"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("THUMBNAILS", func() {
+	encodePNG := func(w, h int) *bytes.Buffer {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				img.Set(x, y, color.RGBA{R: 200, A: 255})
+			}
+		}
+
+		buf := new(bytes.Buffer)
+		Expect(png.Encode(buf, img)).To(Succeed())
+		return buf
+	}
+
+	It("should only support formats the standard library can decode", func() {
+		Expect(thumbnails.Supported("image/png")).To(BeTrue())
+		Expect(thumbnails.Supported("image/jpeg")).To(BeTrue())
+		Expect(thumbnails.Supported("image/gif")).To(BeTrue())
+		Expect(thumbnails.Supported("image/webp")).To(BeFalse())
+		Expect(thumbnails.Supported("application/pdf")).To(BeFalse())
+	})
+
+	It("should shrink large images keeping the aspect ratio", func() {
+		bts, err := thumbnails.Generate(encodePNG(1000, 500), 256)
+		Expect(err).To(BeNil())
+
+		thumb, err := jpeg.Decode(bytes.NewReader(bts))
+		Expect(err).To(BeNil())
+		Expect(thumb.Bounds().Dx()).To(Equal(256))
+		Expect(thumb.Bounds().Dy()).To(Equal(128))
+
+		r, _, _, _ := thumb.At(10, 10).RGBA()
+		Expect(r >> 8).To(BeNumerically("~", 200, 10))
+	})
+
+	It("should not enlarge small images", func() {
+		bts, err := thumbnails.Generate(encodePNG(40, 80), 256)
+		Expect(err).To(BeNil())
+
+		thumb, err := jpeg.Decode(bytes.NewReader(bts))
+		Expect(err).To(BeNil())
+		Expect(thumb.Bounds().Dx()).To(Equal(40))
+		Expect(thumb.Bounds().Dy()).To(Equal(80))
+	})
+
+	It("should refuse images that claim more pixels than it will decode", func() {
+		// just the png signature and a header claiming 30000x30000, that's all DecodeConfig reads
+		ihdr := make([]byte, 17)
+		copy(ihdr, "IHDR")
+		binary.BigEndian.PutUint32(ihdr[4:], 30000)
+		binary.BigEndian.PutUint32(ihdr[8:], 30000)
+		ihdr[12], ihdr[13] = 8, 6 // 8 bit rgba
+
+		buf := bytes.NewBufferString("\x89PNG\r\n\x1a\n")
+		Expect(binary.Write(buf, binary.BigEndian, uint32(13))).To(Succeed())
+		buf.Write(ihdr)
+		Expect(binary.Write(buf, binary.BigEndian, crc32.ChecksumIEEE(ihdr))).To(Succeed())
+
+		_, err := thumbnails.Generate(buf, 256)
+		Expect(err).To(Equal(thumbnails.ErrTooLarge))
+	})
+
+	It("should fail on data that is not an image", func() {
+		_, err := thumbnails.Generate(bytes.NewBufferString("not an image"), 256)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -15,3 +15,11 @@ func Int64ArrToInterfaceArr(vals ...int64) (arr []interface{}) {
 
 	return arr
 }
+
+func StringArrToInterfaceArr(vals ...string) (arr []interface{}) {
+	for _, val := range vals {
+		arr = append(arr, val)
+	}
+
+	return arr
+}
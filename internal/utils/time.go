@@ -0,0 +1,13 @@
+package utils
+
+import "time"
+
+// ParseAsOf - an as_of query parameter, either an RFC 3339 time or a bare date like 2026-09-30
+// which means the end of that day
+func ParseAsOf(raw string) (time.Time, error) {
+	if day, err := time.Parse(time.DateOnly, raw); err == nil {
+		return day.Add(24*time.Hour - time.Nanosecond), nil
+	}
+
+	return time.Parse(time.RFC3339, raw)
+}
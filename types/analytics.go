@@ -0,0 +1,78 @@
+package types
+
+import "time"
+
+// ABCBy - what ABC analysis ranks products by, the value or the qty of what was used
+type ABCBy string
+
+const (
+	ABCByValue    ABCBy = "value"
+	ABCByVelocity ABCBy = "velocity"
+)
+
+// ABCClass - A is the few products making up most of what's used, C the many making up little
+type ABCClass string
+
+const (
+	ABCClassA ABCClass = "A"
+	ABCClassB ABCClass = "B"
+	ABCClassC ABCClass = "C"
+)
+
+// ProductUsage - a product's stock and what of it was used over a report window, values in cents.
+// Used is everything that went out of stock, shipped, consumed, scrapped or written off. Stock
+// moving between locations isn't used.
+type ProductUsage struct {
+	ProductID int64   `json:"productId" xorm:"product_id"`
+	Sku       string  `json:"sku" xorm:"sku"`
+	Name      string  `json:"name" xorm:"name"`
+	Category  *string `json:"category" xorm:"category"`
+	// Qty, Value - on hand now
+	Qty   int64 `json:"qty" xorm:"qty"`
+	Value int64 `json:"value" xorm:"value"`
+	// OpeningQty, OpeningValue - on hand when the window started
+	OpeningQty   int64 `json:"openingQty" xorm:"opening_qty"`
+	OpeningValue int64 `json:"openingValue" xorm:"opening_value"`
+	UsedQty      int64 `json:"usedQty" xorm:"used_qty"`
+	UsedValue    int64 `json:"usedValue" xorm:"used_value"`
+	// LastUsedAt - the last time any was used, in the window or not, nil when it never has been
+	LastUsedAt *time.Time `json:"lastUsedAt" xorm:"last_used_at"`
+	// Turnover - how many times the average value on hand was used over the window, nil when
+	// nothing was on hand
+	Turnover *float64 `json:"turnover" xorm:"-"`
+	// DaysOfSupply - how many days what's on hand lasts at the window's rate of use, nil when
+	// none was used
+	DaysOfSupply *float64 `json:"daysOfSupply" xorm:"-"`
+}
+
+// ABCLine - a product ranked by what of it was used over a report window
+type ABCLine struct {
+	ProductID int64   `json:"productId"`
+	Sku       string  `json:"sku"`
+	Name      string  `json:"name"`
+	Category  *string `json:"category"`
+	UsedQty   int64   `json:"usedQty"`
+	UsedValue int64   `json:"usedValue"`
+	// Share - its part of everything used, by what the analysis ranks by
+	Share float64 `json:"share"`
+	// CumulativeShare - Share added up over it and every product ranked above it
+	CumulativeShare float64  `json:"cumulativeShare"`
+	Class           ABCClass `json:"class"`
+}
+
+// AgingLine - how long a product's stock on hand at a location, or outside of them when
+// LocationID is nil, has been sitting since it was received, in days
+type AgingLine struct {
+	ProductID    int64   `json:"productId" xorm:"product_id"`
+	Sku          string  `json:"sku" xorm:"sku"`
+	Name         string  `json:"name" xorm:"name"`
+	LocationID   *int64  `json:"locationId" xorm:"location_id"`
+	LocationCode *string `json:"locationCode" xorm:"location_code"`
+	Qty          int64   `json:"qty" xorm:"qty"`
+	UpTo30       int64   `json:"upTo30" xorm:"-"`
+	UpTo90       int64   `json:"upTo90" xorm:"-"`
+	UpTo180      int64   `json:"upTo180" xorm:"-"`
+	Over180      int64   `json:"over180" xorm:"-"`
+	// OldestReceivedAt - when the oldest of the product's stock on hand was received
+	OldestReceivedAt *time.Time `json:"oldestReceivedAt" xorm:"-"`
+}
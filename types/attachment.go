@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+type Attachment struct {
+	ID           int64     `json:"id" xorm:"'id' pk autoincr"`
+	ProductID    int64     `validate:"required" json:"productId" xorm:"product_id"`
+	FileName     string    `validate:"required" json:"fileName" xorm:"file_name"`
+	ContentType  string    `validate:"required" json:"contentType" xorm:"content_type"`
+	Size         int64     `json:"size" xorm:"size"`
+	StorageKey   string    `validate:"required" json:"-" xorm:"storage_key"`
+	ThumbnailKey *string   `json:"-" xorm:"thumbnail_key"`
+	CreatedAt    time.Time `json:"createdAt" xorm:"created_at"`
+
+	DownloadURL  string  `json:"downloadUrl" xorm:"-"`
+	ThumbnailURL *string `json:"thumbnailUrl" xorm:"-"`
+}
+
+func (*Attachment) TableName() string {
+	return "product_attachments"
+}
+
+// SetURLs - fills in where the file (and thumbnail when there is one) can be downloaded from
+func (a *Attachment) SetURLs() *Attachment {
+	a.DownloadURL = fmt.Sprintf("/v1/attachments/%d/download", a.ID)
+	a.ThumbnailURL = nil
+	if a.ThumbnailKey != nil {
+		url := fmt.Sprintf("/v1/attachments/%d/thumbnail", a.ID)
+		a.ThumbnailURL = &url
+	}
+	return a
+}
+
+type NewAttachment struct {
+	ProductID    int64   `validate:"required"`
+	FileName     string  `validate:"required"`
+	ContentType  string  `validate:"required"`
+	Size         int64   `validate:"min=0"`
+	StorageKey   string  `validate:"required"`
+	ThumbnailKey *string ``
+}
@@ -0,0 +1,96 @@
+package types
+
+import "time"
+
+type BarcodeType string
+
+const (
+	BarcodeTypeUPCA   BarcodeType = "upc_a"
+	BarcodeTypeEAN8   BarcodeType = "ean_8"
+	BarcodeTypeEAN13  BarcodeType = "ean_13"
+	BarcodeTypeGTIN14 BarcodeType = "gtin_14"
+)
+
+// Length - the number of digits a code of this type must have
+func (t BarcodeType) Length() int {
+	switch t {
+	case BarcodeTypeUPCA:
+		return 12
+	case BarcodeTypeEAN8:
+		return 8
+	case BarcodeTypeEAN13:
+		return 13
+	case BarcodeTypeGTIN14:
+		return 14
+	}
+	return 0
+}
+
+type Barcode struct {
+	ID        int64       `json:"id" xorm:"'id' pk autoincr"`
+	ProductID int64       `validate:"required" json:"productId" xorm:"product_id"`
+	Code      string      `validate:"required,gtin" json:"code" xorm:"code"`
+	Gtin      string      `validate:"required,len=14" json:"gtin" xorm:"gtin"`
+	Type      BarcodeType `validate:"required,oneof=upc_a ean_8 ean_13 gtin_14" json:"type" xorm:"type"`
+	PackSize  int64       `validate:"required,min=1" json:"packSize" xorm:"pack_size"`
+	CreatedAt time.Time   `json:"createdAt" xorm:"created_at"`
+	UpdatedAt *time.Time  `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*Barcode) TableName() string {
+	return "product_barcodes"
+}
+
+type NewBarcode struct {
+	ProductID int64       `validate:"required" json:"productId"`
+	Code      string      `validate:"required,gtin" json:"code"`
+	Type      BarcodeType `validate:"required,oneof=upc_a ean_8 ean_13 gtin_14" json:"type"`
+	// PackSize - how many units a single scan represents, defaults to 1
+	PackSize int64 `json:"packSize"`
+}
+
+// BarcodeScan - what a scanner gets back when it reads a barcode
+type BarcodeScan struct {
+	Barcode  *Barcode `json:"barcode"`
+	Product  *Product `json:"product"`
+	PackSize int64    `json:"packSize"`
+}
+
+// IsValidGTIN - checks that code is an 8, 12, 13 or 14 digit GTIN with a correct check digit
+func IsValidGTIN(code string) bool {
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return false
+	}
+
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+
+		digit := int(code[i] - '0')
+		// weights alternate 3,1,3,1... starting from the digit next to the check digit
+		if (len(code)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	check := code[len(code)-1]
+	if check < '0' || check > '9' {
+		return false
+	}
+
+	return int(check-'0') == (10-sum%10)%10
+}
+
+// NormalizeGTIN - left pads a code to the 14 digit GTIN form so UPC-A, EAN-13
+// and GTIN-14 representations of the same item compare equal
+func NormalizeGTIN(code string) string {
+	for len(code) < 14 {
+		code = "0" + code
+	}
+	return code
+}
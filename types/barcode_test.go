@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Barcode", func() {
+	Context("IsValidGTIN", func() {
+		It("should accept codes with a correct check digit", func() {
+			Expect(types.IsValidGTIN("96385074")).To(BeTrue())       // EAN-8
+			Expect(types.IsValidGTIN("036000291452")).To(BeTrue())   // UPC-A
+			Expect(types.IsValidGTIN("4006381333931")).To(BeTrue())  // EAN-13
+			Expect(types.IsValidGTIN("10036000291459")).To(BeTrue()) // GTIN-14
+		})
+
+		It("should reject codes with a bad check digit", func() {
+			Expect(types.IsValidGTIN("036000291453")).To(BeFalse())
+			Expect(types.IsValidGTIN("4006381333932")).To(BeFalse())
+		})
+
+		It("should reject codes with the wrong length or non digits", func() {
+			Expect(types.IsValidGTIN("")).To(BeFalse())
+			Expect(types.IsValidGTIN("12345")).To(BeFalse())
+			Expect(types.IsValidGTIN("03600029145a")).To(BeFalse())
+			Expect(types.IsValidGTIN("0360002914x2")).To(BeFalse())
+		})
+	})
+
+	Context("NormalizeGTIN", func() {
+		It("should pad every representation to 14 digits", func() {
+			Expect(types.NormalizeGTIN("036000291452")).To(Equal("00036000291452"))
+			Expect(types.NormalizeGTIN("0036000291452")).To(Equal("00036000291452"))
+			Expect(types.NormalizeGTIN("10036000291459")).To(Equal("10036000291459"))
+		})
+	})
+
+	Context("Validate", func() {
+		It("should use the gtin tag", func() {
+			Expect(types.Validate(types.NewBarcode{
+				ProductID: 1, Code: "036000291452", Type: types.BarcodeTypeUPCA,
+			})).To(Succeed())
+			Expect(types.Validate(types.NewBarcode{
+				ProductID: 1, Code: "036000291453", Type: types.BarcodeTypeUPCA,
+			})).NotTo(Succeed())
+		})
+	})
+})
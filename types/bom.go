@@ -0,0 +1,29 @@
+package types
+
+import "time"
+
+// BomLine - one line of a product's bill of materials, how many of a component go into making
+// one of it on a work order
+type BomLine struct {
+	ID          int64     `json:"id" xorm:"'id' pk autoincr"`
+	ProductID   int64     `json:"productId" xorm:"product_id"`
+	ComponentID int64     `validate:"required" json:"componentId" xorm:"component_id"`
+	Qty         int64     `validate:"min=1" json:"qty" xorm:"qty"`
+	CreatedAt   time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*BomLine) TableName() string {
+	return "bom_lines"
+}
+
+// SetBom - replaces a product's bill of materials, work orders already released keep the one
+// they were released with
+type SetBom struct {
+	ProductID int64        `json:"productId"`
+	Lines     []NewBomLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type NewBomLine struct {
+	ComponentID int64 `validate:"required" json:"componentId"`
+	Qty         int64 `validate:"required,min=1" json:"qty"`
+}
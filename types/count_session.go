@@ -0,0 +1,129 @@
+package types
+
+import "time"
+
+type CountSessionStatus string
+
+const (
+	// CountSessionStatusOpen - counts are being taken
+	CountSessionStatusOpen CountSessionStatus = "open"
+	// CountSessionStatusReview - counting is finished and the variances are waiting on approval
+	CountSessionStatusReview    CountSessionStatus = "review"
+	CountSessionStatusPosted    CountSessionStatus = "posted"
+	CountSessionStatusCancelled CountSessionStatus = "cancelled"
+)
+
+var countSessionTransitions = map[CountSessionStatus][]CountSessionStatus{
+	CountSessionStatusOpen:   {CountSessionStatusReview, CountSessionStatusCancelled},
+	CountSessionStatusReview: {CountSessionStatusOpen, CountSessionStatusPosted, CountSessionStatusCancelled},
+}
+
+// CanTransition - whether a count session in this status may move to the next one. A session
+// in review can be reopened for a recount, once it's posted it's final.
+func (s CountSessionStatus) CanTransition(to CountSessionStatus) bool {
+	for _, allowed := range countSessionTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CountSession - a cycle count or physical inventory of a set of products at a set of locations
+type CountSession struct {
+	ID    int64   `json:"id" xorm:"'id' pk autoincr"`
+	Name  *string `json:"name" xorm:"name"`
+	Notes *string `json:"notes" xorm:"notes"`
+	// Blind - counters don't get to see the expected quantities until counting is finished
+	Blind     bool               `json:"blind" xorm:"blind"`
+	Status    CountSessionStatus `json:"status" xorm:"status"`
+	PostedAt  *time.Time         `json:"postedAt" xorm:"posted_at"`
+	CreatedAt time.Time          `json:"createdAt" xorm:"created_at"`
+	UpdatedAt *time.Time         `json:"updatedAt" xorm:"updated_at"`
+
+	Lines []*CountLine `json:"lines" xorm:"-"`
+}
+
+func (*CountSession) TableName() string {
+	return "count_sessions"
+}
+
+// Redact - hides the expected quantities and variances of a blind session that's still being counted
+func (s *CountSession) Redact() {
+	if !s.Blind || s.Status != CountSessionStatusOpen {
+		return
+	}
+
+	for _, line := range s.Lines {
+		line.ExpectedQty = nil
+		line.Variance = nil
+	}
+}
+
+type CountLine struct {
+	ID             int64 `json:"id" xorm:"'id' pk autoincr"`
+	CountSessionID int64 `json:"countSessionId" xorm:"count_session_id"`
+	ProductID      int64 `json:"productId" xorm:"product_id"`
+	LocationID     int64 `json:"locationId" xorm:"location_id"`
+	// ExpectedQty - the stock level when the line was added to the session, it's only nil when redacted
+	ExpectedQty *int64 `json:"expectedQty" xorm:"expected_qty"`
+	// CountedQty - nil until the line has been counted
+	CountedQty *int64 `json:"countedQty" xorm:"counted_qty"`
+	// Variance - counted less expected, nil until the line has been counted
+	Variance *int64 `json:"variance" xorm:"variance"`
+	// Approved - the variance was posted to stock
+	Approved  bool       `json:"approved" xorm:"approved"`
+	CreatedAt time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*CountLine) TableName() string {
+	return "count_lines"
+}
+
+// NewCountSession - snapshots the expected quantity of every product stocked at the locations,
+// or of just the listed products when there are any
+type NewCountSession struct {
+	Name        *string `json:"name"`
+	Notes       *string `json:"notes"`
+	Blind       bool    `json:"blind"`
+	LocationIDs []int64 `validate:"required,min=1" json:"locationIds"`
+	ProductIDs  []int64 `json:"productIds"`
+}
+
+// CountCountSession - counted quantities, by product or by a scanned barcode. Accumulate adds to
+// what's already been counted, which suits scanners sending a scan at a time, otherwise the
+// count replaces it.
+type CountCountSession struct {
+	ID         int64        `json:"id"`
+	Accumulate bool         `json:"accumulate"`
+	Entries    []CountEntry `validate:"required,min=1,dive" json:"entries"`
+}
+
+type CountEntry struct {
+	LocationID int64 `validate:"required" json:"locationId"`
+	ProductID  int64 `validate:"required_without=Barcode" json:"productId"`
+	// Barcode - a scanned code, it counts as its pack size in units
+	Barcode string `validate:"required_without=ProductID" json:"barcode"`
+	Qty     int64  `validate:"min=0" json:"qty"`
+}
+
+// SubmitCountSession - finishes counting. Every line has to be counted unless ZeroUncounted is
+// set, which takes whatever wasn't counted as not found.
+type SubmitCountSession struct {
+	ID            int64 `json:"id"`
+	ZeroUncounted bool  `json:"zeroUncounted"`
+}
+
+// PostCountSession - LineIDs are the approved lines, left out every line with a variance is approved
+type PostCountSession struct {
+	ID      int64   `json:"id"`
+	LineIDs []int64 `json:"lineIds"`
+	// Lots - the lot each lot tracked product's variance is posted to
+	Lots []CountLineLot `json:"lots"`
+}
+
+type CountLineLot struct {
+	CountLineID int64 `json:"countLineId"`
+	LotID       int64 `json:"lotId"`
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: CountSession", func() {
+	Context("CanTransition", func() {
+		DescribeTable("the count session lifecycle",
+			func(from, to types.CountSessionStatus, allowed bool) {
+				Expect(from.CanTransition(to)).To(Equal(allowed))
+			},
+			Entry("open to review", types.CountSessionStatusOpen, types.CountSessionStatusReview, true),
+			Entry("open to posted", types.CountSessionStatusOpen, types.CountSessionStatusPosted, false),
+			Entry("open to cancelled", types.CountSessionStatusOpen, types.CountSessionStatusCancelled, true),
+			Entry("review to open", types.CountSessionStatusReview, types.CountSessionStatusOpen, true),
+			Entry("review to posted", types.CountSessionStatusReview, types.CountSessionStatusPosted, true),
+			Entry("posted to cancelled", types.CountSessionStatusPosted, types.CountSessionStatusCancelled, false),
+			Entry("cancelled to open", types.CountSessionStatusCancelled, types.CountSessionStatusOpen, false),
+		)
+	})
+
+	Context("Redact", func() {
+		newSession := func(blind bool, status types.CountSessionStatus) *types.CountSession {
+			return &types.CountSession{
+				Blind:  blind,
+				Status: status,
+				Lines: []*types.CountLine{{
+					ExpectedQty: utils.Ref[int64](7), CountedQty: utils.Ref[int64](6), Variance: utils.Ref[int64](-1),
+				}},
+			}
+		}
+
+		It("should hide what a blind session expects while it's being counted", func() {
+			session := newSession(true, types.CountSessionStatusOpen)
+			session.Redact()
+			Expect(session.Lines[0].ExpectedQty).To(BeNil())
+			Expect(session.Lines[0].Variance).To(BeNil())
+			Expect(*session.Lines[0].CountedQty).To(BeNumerically("==", 6))
+		})
+
+		It("should show everything once counting is finished or when the session isn't blind", func() {
+			session := newSession(true, types.CountSessionStatusReview)
+			session.Redact()
+			Expect(*session.Lines[0].ExpectedQty).To(BeNumerically("==", 7))
+
+			session = newSession(false, types.CountSessionStatusOpen)
+			session.Redact()
+			Expect(*session.Lines[0].Variance).To(BeNumerically("==", -1))
+		})
+	})
+
+	Context("CountEntry", func() {
+		It("should need a product or a barcode", func() {
+			count := types.CountCountSession{Entries: []types.CountEntry{{LocationID: 1, Qty: 1}}}
+			Expect(types.Validate(count)).NotTo(Succeed())
+
+			count.Entries[0].Barcode = "036000291452"
+			Expect(types.Validate(count)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package types
+
+import "time"
+
+// Customer - someone we sell to
+type Customer struct {
+	ID    int64   `json:"id" xorm:"'id' pk autoincr"`
+	Name  string  `validate:"required" json:"name" xorm:"name"`
+	Code  string  `validate:"required" json:"code" xorm:"code"`
+	Email *string `validate:"omitempty,email" json:"email" xorm:"email"`
+	Phone *string `json:"phone" xorm:"phone"`
+	// Group - customers in the same group are treated alike, e.g. wholesale or retail
+	Group     *string `json:"group" xorm:"customer_group"`
+	TaxExempt bool    `json:"taxExempt" xorm:"tax_exempt"`
+	// TaxExemptID - the exemption certificate, only kept for tax exempt customers
+	TaxExemptID *string `json:"taxExemptId" xorm:"tax_exempt_id"`
+	// PaymentTermsDays - how many days they have to pay an invoice, 0 is due on receipt
+	PaymentTermsDays int64      `validate:"min=0" json:"paymentTermsDays" xorm:"payment_terms_days"`
+	Notes            *string    `json:"notes" xorm:"notes"`
+	CreatedAt        time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt        *time.Time `json:"updatedAt" xorm:"updated_at"`
+
+	Contacts  []*CustomerContact `json:"contacts" xorm:"-"`
+	Addresses []*CustomerAddress `json:"addresses" xorm:"-"`
+}
+
+func (*Customer) TableName() string {
+	return "customers"
+}
+
+// DefaultAddress - the customer's default address of a kind, nil when they don't have one
+func (c *Customer) DefaultAddress(kind AddressKind) *CustomerAddress {
+	for _, address := range c.Addresses {
+		if address.Kind == kind && address.Default {
+			return address
+		}
+	}
+	return nil
+}
+
+// CustomerContact - a person to talk to at a customer
+type CustomerContact struct {
+	ID         int64     `json:"id" xorm:"'id' pk autoincr"`
+	CustomerID int64     `json:"customerId" xorm:"customer_id"`
+	Name       string    `validate:"required" json:"name" xorm:"name"`
+	Role       *string   `json:"role" xorm:"role"`
+	Email      *string   `validate:"omitempty,email" json:"email" xorm:"email"`
+	Phone      *string   `json:"phone" xorm:"phone"`
+	CreatedAt  time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*CustomerContact) TableName() string {
+	return "customer_contacts"
+}
+
+type AddressKind string
+
+const (
+	AddressKindShipping AddressKind = "shipping"
+	AddressKindBilling  AddressKind = "billing"
+)
+
+// CustomerAddress - somewhere a customer has orders shipped or invoices sent
+type CustomerAddress struct {
+	ID         int64       `json:"id" xorm:"'id' pk autoincr"`
+	CustomerID int64       `json:"customerId" xorm:"customer_id"`
+	Kind       AddressKind `validate:"oneof=shipping billing" json:"kind" xorm:"kind"`
+	// Name - who it's addressed to when it's not the customer
+	Name       *string `json:"name" xorm:"name"`
+	Line1      string  `validate:"required" json:"line1" xorm:"line1"`
+	Line2      *string `json:"line2" xorm:"line2"`
+	City       string  `validate:"required" json:"city" xorm:"city"`
+	Region     *string `json:"region" xorm:"region"`
+	PostalCode *string `json:"postalCode" xorm:"postal_code"`
+	// Country - ISO 3166-1 alpha-2 like US
+	Country string `validate:"iso3166_1_alpha2" json:"country" xorm:"country"`
+	// Default - the one used for its kind unless another is picked, each customer has one of
+	// each kind they have addresses for
+	Default   bool      `json:"default" xorm:"is_default"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*CustomerAddress) TableName() string {
+	return "customer_addresses"
+}
+
+type NewCustomer struct {
+	Name        string  `validate:"required" json:"name"`
+	Code        string  `validate:"required" json:"code"`
+	Email       *string `validate:"omitempty,email" json:"email"`
+	Phone       *string `json:"phone"`
+	Group       *string `json:"group"`
+	TaxExempt   bool    `json:"taxExempt"`
+	TaxExemptID *string `json:"taxExemptId"`
+	// PaymentTermsDays - defaults to 30
+	PaymentTermsDays *int64               `validate:"omitempty,min=0" json:"paymentTermsDays"`
+	Notes            *string              `json:"notes"`
+	Contacts         []NewCustomerContact `validate:"dive" json:"contacts"`
+	Addresses        []NewCustomerAddress `validate:"dive" json:"addresses"`
+}
+
+type NewCustomerContact struct {
+	Name  string  `validate:"required" json:"name"`
+	Role  *string `json:"role"`
+	Email *string `validate:"omitempty,email" json:"email"`
+	Phone *string `json:"phone"`
+}
+
+type NewCustomerAddress struct {
+	Kind       AddressKind `validate:"oneof=shipping billing" json:"kind"`
+	Name       *string     `json:"name"`
+	Line1      string      `validate:"required" json:"line1"`
+	Line2      *string     `json:"line2"`
+	City       string      `validate:"required" json:"city"`
+	Region     *string     `json:"region"`
+	PostalCode *string     `json:"postalCode"`
+	Country    string      `validate:"iso3166_1_alpha2" json:"country"`
+	// Default - at most one of each kind, the first of a kind is the default when none are
+	Default bool `json:"default"`
+}
+
+// UpdateCustomer - contacts and addresses are replaced when they're passed, an empty list
+// removes them all
+type UpdateCustomer struct {
+	ID               int64                 `json:"id"`
+	Name             *string               `validate:"omitempty,min=1" json:"name"`
+	Code             *string               `validate:"omitempty,min=1" json:"code"`
+	Email            *string               `validate:"omitempty,email" json:"email"`
+	Phone            *string               `json:"phone"`
+	Group            *string               `json:"group"`
+	TaxExempt        *bool                 `json:"taxExempt"`
+	TaxExemptID      *string               `json:"taxExemptId"`
+	PaymentTermsDays *int64                `validate:"omitempty,min=0" json:"paymentTermsDays"`
+	Notes            *string               `json:"notes"`
+	Contacts         *[]NewCustomerContact `validate:"omitempty,dive" json:"contacts"`
+	Addresses        *[]NewCustomerAddress `validate:"omitempty,dive" json:"addresses"`
+}
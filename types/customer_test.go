@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/internal/utils"
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Customer", func() {
+	Context("NewCustomer", func() {
+		var newCustomer types.NewCustomer
+
+		BeforeEach(func() {
+			newCustomer = types.NewCustomer{
+				Name: "Jane Doe", Code: "JDOE", Email: utils.Ref("jane@example.com"),
+				Contacts: []types.NewCustomerContact{{Name: "Jane Doe", Email: utils.Ref("jane@example.com")}},
+				Addresses: []types.NewCustomerAddress{
+					{Kind: types.AddressKindShipping, Line1: "1 Main St", City: "Springfield", Country: "US"},
+				},
+			}
+		})
+
+		It("should validate the customer with its contacts and addresses", func() {
+			Expect(types.Validate(newCustomer)).To(Succeed())
+
+			newCustomer.Email = utils.Ref("jane")
+			Expect(types.Validate(newCustomer)).NotTo(Succeed())
+		})
+
+		It("should validate every contact", func() {
+			newCustomer.Contacts = append(newCustomer.Contacts, types.NewCustomerContact{Name: "Bob", Email: utils.Ref("bob")})
+			Expect(types.Validate(newCustomer)).NotTo(Succeed())
+		})
+
+		It("should validate every address", func() {
+			for _, address := range []types.NewCustomerAddress{
+				{Kind: "home", Line1: "1 Main St", City: "Springfield", Country: "US"},
+				{Kind: types.AddressKindBilling, City: "Springfield", Country: "US"},
+				{Kind: types.AddressKindBilling, Line1: "1 Main St", City: "Springfield", Country: "USA"},
+			} {
+				newCustomer.Addresses = []types.NewCustomerAddress{address}
+				Expect(types.Validate(newCustomer)).NotTo(Succeed(), address.Line1+string(address.Kind)+address.Country)
+			}
+		})
+	})
+
+	Context("UpdateCustomer", func() {
+		It("should validate replacement addresses", func() {
+			diff := types.UpdateCustomer{ID: 1}
+			Expect(types.Validate(diff)).To(Succeed())
+
+			diff.Addresses = &[]types.NewCustomerAddress{}
+			Expect(types.Validate(diff)).To(Succeed())
+
+			diff.Addresses = &[]types.NewCustomerAddress{{Kind: types.AddressKindBilling, Line1: "1 Main St", City: "Springfield"}}
+			Expect(types.Validate(diff)).NotTo(Succeed())
+		})
+	})
+
+	Context("DefaultAddress", func() {
+		It("should find the default address of a kind", func() {
+			customer := &types.Customer{Addresses: []*types.CustomerAddress{
+				{ID: 1, Kind: types.AddressKindShipping},
+				{ID: 2, Kind: types.AddressKindShipping, Default: true},
+				{ID: 3, Kind: types.AddressKindBilling},
+			}}
+
+			Expect(customer.DefaultAddress(types.AddressKindShipping).ID).To(BeNumerically("==", 2))
+			Expect(customer.DefaultAddress(types.AddressKindBilling)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package types
+
+// ForecastMethod - how demand is forecast from its history
+type ForecastMethod string
+
+const (
+	// ForecastMethodMovingAverage - the average demand of the latest periods carries on
+	ForecastMethodMovingAverage ForecastMethod = "moving_average"
+	// ForecastMethodExponential - exponential smoothing with a trend and seasonality once there's
+	// enough history for them
+	ForecastMethodExponential ForecastMethod = "exponential"
+)
+
+// SuggestedPurchase - how much of a product to order from its preferred supplier to cover its
+// forecast demand. Demand is everything that went out of stock other than moves between
+// locations, the same as the inventory analytics reports.
+type SuggestedPurchase struct {
+	ProductID int64  `json:"productId"`
+	Sku       string `json:"sku"`
+	Name      string `json:"name"`
+	// SupplierID - the preferred supplier, nil when there isn't one and it can't be drafted
+	SupplierID *int64 `json:"supplierId"`
+	// UnitCost - in cents, the preferred supplier's cost
+	UnitCost     int64 `json:"unitCost"`
+	LeadTimeDays int64 `json:"leadTimeDays"`
+	// Position - on hand less allocated plus on order
+	Position    int64   `json:"position"`
+	DailyDemand float64 `json:"dailyDemand"`
+	// Seasonal - whether the forecast followed seasonality, it needs two seasons of history
+	Seasonal    bool  `json:"seasonal"`
+	SafetyStock int64 `json:"safetyStock"`
+	// ReorderPoint - the forecast demand over the lead time plus safety stock
+	ReorderPoint int64 `json:"reorderPoint"`
+	// OrderUpTo - the forecast demand over the lead time and the review after it plus safety stock
+	OrderUpTo int64 `json:"orderUpTo"`
+	// Qty - brings the position back up to OrderUpTo, rounded up to the minimum order qty
+	Qty int64 `json:"qty"`
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+type InvoiceStatus string
+
+const (
+	InvoiceStatusOpen InvoiceStatus = "open"
+	InvoiceStatusPaid InvoiceStatus = "paid"
+)
+
+// Invoice - what's owed for a shipment once it's shipped, amounts in cents. Its lines, taxes and
+// customer details are copied in when it's issued so later changes don't alter it.
+type Invoice struct {
+	ID int64 `json:"id" xorm:"'id' pk autoincr"`
+	// Number - INV-<year>-<sequence>, sequences start at 1 each year and are never skipped
+	Number       string `json:"number" xorm:"number"`
+	Year         int    `json:"year" xorm:"year"`
+	Sequence     int64  `json:"sequence" xorm:"sequence"`
+	SalesOrderID int64  `json:"salesOrderId" xorm:"sales_order_id"`
+	ShipmentID   int64  `json:"shipmentId" xorm:"shipment_id"`
+	CustomerID   *int64 `json:"customerId" xorm:"customer_id"`
+	CustomerName string `json:"customerName" xorm:"customer_name"`
+	// BillTo - the customer's default billing address, one line after another
+	BillTo     *string       `json:"billTo" xorm:"bill_to"`
+	Status     InvoiceStatus `json:"status" xorm:"status"`
+	Subtotal   int64         `json:"subtotal" xorm:"subtotal"`
+	Tax        int64         `json:"tax" xorm:"tax"`
+	Total      int64         `json:"total" xorm:"total"`
+	AmountPaid int64         `json:"amountPaid" xorm:"amount_paid"`
+	// TermsDays - how many days after it's issued it's due, 0 is due on receipt
+	TermsDays int64      `json:"termsDays" xorm:"terms_days"`
+	IssuedAt  time.Time  `json:"issuedAt" xorm:"issued_at"`
+	DueAt     time.Time  `json:"dueAt" xorm:"due_at"`
+	PaidAt    *time.Time `json:"paidAt" xorm:"paid_at"`
+	CreatedAt time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt *time.Time `json:"updatedAt" xorm:"updated_at"`
+
+	Lines    []*InvoiceLine    `json:"lines" xorm:"-"`
+	Taxes    []*InvoiceTax     `json:"taxes" xorm:"-"`
+	Payments []*InvoicePayment `json:"payments" xorm:"-"`
+}
+
+func (*Invoice) TableName() string {
+	return "invoices"
+}
+
+// Balance - what's still owed
+func (i *Invoice) Balance() int64 {
+	return i.Total - i.AmountPaid
+}
+
+// Terms - how the payment terms are printed
+func (i *Invoice) Terms() string {
+	if i.TermsDays == 0 {
+		return "Due on receipt"
+	}
+	return fmt.Sprintf("Net %d", i.TermsDays)
+}
+
+// InvoiceNumber - the number of an invoice from its year and sequence
+func InvoiceNumber(year int, sequence int64) string {
+	return fmt.Sprintf("INV-%d-%06d", year, sequence)
+}
+
+// InvoiceLine - what was shipped of a sales order line
+type InvoiceLine struct {
+	ID               int64  `json:"id" xorm:"'id' pk autoincr"`
+	InvoiceID        int64  `json:"invoiceId" xorm:"invoice_id"`
+	SalesOrderLineID int64  `json:"salesOrderLineId" xorm:"sales_order_line_id"`
+	ProductID        int64  `json:"productId" xorm:"product_id"`
+	Sku              string `json:"sku" xorm:"sku"`
+	Name             string `json:"name" xorm:"name"`
+	Qty              int64  `json:"qty" xorm:"qty"`
+	UnitPrice        int64  `json:"unitPrice" xorm:"unit_price"`
+	// Amount - Qty at UnitPrice, before tax
+	Amount int64 `json:"amount" xorm:"amount"`
+	Tax    int64 `json:"tax" xorm:"tax"`
+}
+
+func (*InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
+
+// InvoiceTax - what a tax rate charged on the invoice, see TaxAmount
+type InvoiceTax struct {
+	ID        int64   `json:"id" xorm:"'id' pk autoincr"`
+	InvoiceID int64   `json:"invoiceId" xorm:"invoice_id"`
+	TaxRateID int64   `json:"taxRateId" xorm:"tax_rate_id"`
+	Name      string  `json:"name" xorm:"name"`
+	Rate      float64 `json:"rate" xorm:"rate"`
+	Taxable   int64   `json:"taxable" xorm:"taxable"`
+	Tax       int64   `json:"tax" xorm:"tax"`
+}
+
+func (*InvoiceTax) TableName() string {
+	return "invoice_taxes"
+}
+
+type InvoicePayment struct {
+	ID        int64     `json:"id" xorm:"'id' pk autoincr"`
+	InvoiceID int64     `json:"invoiceId" xorm:"invoice_id"`
+	Amount    int64     `json:"amount" xorm:"amount"`
+	Method    string    `json:"method" xorm:"method"`
+	Reference *string   `json:"reference" xorm:"reference"`
+	PaidAt    time.Time `json:"paidAt" xorm:"paid_at"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*InvoicePayment) TableName() string {
+	return "invoice_payments"
+}
+
+// NewInvoicePayment - a payment can't be more than the invoice's balance
+type NewInvoicePayment struct {
+	InvoiceID int64 `json:"invoiceId"`
+	// Amount - in cents
+	Amount int64 `validate:"required,min=1" json:"amount"`
+	// Method - how it was paid, e.g. card, check or wire
+	Method    string  `validate:"required" json:"method"`
+	Reference *string `json:"reference"`
+	// PaidAt - defaults to now
+	PaidAt *time.Time `json:"paidAt"`
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Invoice", func() {
+	It("should number invoices by year and sequence", func() {
+		Expect(types.InvoiceNumber(2026, 42)).To(Equal("INV-2026-000042"))
+	})
+
+	It("should work out the balance and terms", func() {
+		invoice := &types.Invoice{Total: 2125, AmountPaid: 1000, TermsDays: 30}
+		Expect(invoice.Balance()).To(BeNumerically("==", 1125))
+		Expect(invoice.Terms()).To(Equal("Net 30"))
+
+		invoice.TermsDays = 0
+		Expect(invoice.Terms()).To(Equal("Due on receipt"))
+	})
+
+	Context("NewInvoicePayment", func() {
+		It("should require a positive amount and a method", func() {
+			Expect(types.Validate(types.NewInvoicePayment{InvoiceID: 1, Amount: 100, Method: "card"})).To(Succeed())
+			Expect(types.Validate(types.NewInvoicePayment{InvoiceID: 1, Amount: 0, Method: "card"})).NotTo(Succeed())
+			Expect(types.Validate(types.NewInvoicePayment{InvoiceID: 1, Amount: -5, Method: "card"})).NotTo(Succeed())
+			Expect(types.Validate(types.NewInvoicePayment{InvoiceID: 1, Amount: 100})).NotTo(Succeed())
+		})
+	})
+})
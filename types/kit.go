@@ -0,0 +1,67 @@
+package types
+
+import "time"
+
+// KitComponent - one line of a kit's bill of materials, how many of a product go into one kit
+type KitComponent struct {
+	ID          int64     `json:"id" xorm:"'id' pk autoincr"`
+	KitID       int64     `json:"kitId" xorm:"kit_id"`
+	ComponentID int64     `validate:"required" json:"componentId" xorm:"component_id"`
+	Qty         int64     `validate:"min=1" json:"qty" xorm:"qty"`
+	CreatedAt   time.Time `json:"createdAt" xorm:"created_at"`
+	// Available - the component's own available stock, filled in when the kit is loaded
+	Available int64 `json:"available" xorm:"-"`
+}
+
+func (*KitComponent) TableName() string {
+	return "kit_components"
+}
+
+// Kit - a product sold as a bundle of other products. Kits are built from their components as
+// they're allocated to sales orders so a kit's own qty is only what's already been built.
+type Kit struct {
+	Product    *Product        `json:"product"`
+	Components []*KitComponent `json:"components"`
+	// Available - built kits that aren't allocated plus however many more the components can make
+	Available int64 `json:"available"`
+}
+
+// Buildable - how many more kits the components' available stock can make, the least of
+// each component's stock over what one kit needs
+func (k *Kit) Buildable() int64 {
+	if len(k.Components) == 0 {
+		return 0
+	}
+
+	var buildable int64 = -1
+	for _, component := range k.Components {
+		n := max(component.Available, 0) / component.Qty
+		if buildable < 0 || n < buildable {
+			buildable = n
+		}
+	}
+	return buildable
+}
+
+type NewKit struct {
+	Name       string            `validate:"required" json:"name"`
+	Sku        string            `validate:"required" json:"sku"`
+	Components []NewKitComponent `validate:"required,min=1,dive" json:"components"`
+}
+
+type NewKitComponent struct {
+	ComponentID int64 `validate:"required" json:"componentId"`
+	Qty         int64 `validate:"required,min=1" json:"qty"`
+}
+
+// UpdateKit - replaces a kit's bill of materials, the name and sku change through its product
+type UpdateKit struct {
+	ID         int64             `json:"id"`
+	Components []NewKitComponent `validate:"required,min=1,dive" json:"components"`
+}
+
+// AssembleKit - builds kits ahead of orders, they're otherwise built as they're allocated
+type AssembleKit struct {
+	ID  int64 `json:"id"`
+	Qty int64 `validate:"required,min=1" json:"qty"`
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Kit", func() {
+	Context("Buildable", func() {
+		It("should be limited by the scarcest component", func() {
+			kit := &types.Kit{}
+			Expect(kit.Buildable()).To(BeNumerically("==", 0))
+
+			kit.Components = []*types.KitComponent{
+				{ComponentID: 1, Qty: 1, Available: 10},
+				{ComponentID: 2, Qty: 2, Available: 5},
+				{ComponentID: 3, Qty: 1, Available: 3},
+			}
+			Expect(kit.Buildable()).To(BeNumerically("==", 2))
+
+			kit.Components[2].Available = -1
+			Expect(kit.Buildable()).To(BeNumerically("==", 0))
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package types
+
+import "time"
+
+// LocationKind - where a location sits in a warehouse, from the site itself down to a single bin
+type LocationKind string
+
+const (
+	LocationKindWarehouse LocationKind = "warehouse"
+	LocationKindZone      LocationKind = "zone"
+	LocationKindAisle     LocationKind = "aisle"
+	LocationKindShelf     LocationKind = "shelf"
+	LocationKindBin       LocationKind = "bin"
+)
+
+var locationKindDepths = map[LocationKind]int{
+	LocationKindWarehouse: 0,
+	LocationKindZone:      1,
+	LocationKindAisle:     2,
+	LocationKindShelf:     3,
+	LocationKindBin:       4,
+}
+
+// CanContain - whether a location of this kind may be the parent of one of the child kind.
+// Levels can be skipped, a warehouse can hold bins directly.
+func (k LocationKind) CanContain(child LocationKind) bool {
+	parentDepth, exists := locationKindDepths[k]
+	if !exists {
+		return false
+	}
+	childDepth, exists := locationKindDepths[child]
+	return exists && childDepth > parentDepth
+}
+
+// Location - a warehouse or site that holds stock, or a zone, aisle, shelf or bin inside one
+type Location struct {
+	ID   int64  `json:"id" xorm:"'id' pk autoincr"`
+	Name string `validate:"required" json:"name" xorm:"name"`
+	Code string `validate:"required" json:"code" xorm:"code"`
+	// ParentID - the location this one is inside of, nil for a warehouse
+	ParentID *int64       `json:"parentId" xorm:"parent_id"`
+	Kind     LocationKind `validate:"required,oneof=warehouse zone aisle shelf bin" json:"kind" xorm:"kind"`
+	// PickSequence - where the location falls on the walk through the warehouse, pick lists
+	// visit lower numbers first
+	PickSequence int64      `validate:"min=0" json:"pickSequence" xorm:"pick_sequence"`
+	CreatedAt    time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*Location) TableName() string {
+	return "locations"
+}
+
+// NewLocation - Kind defaults to a warehouse, anything else needs a parent
+type NewLocation struct {
+	Name         string       `validate:"required" json:"name"`
+	Code         string       `validate:"required" json:"code"`
+	ParentID     *int64       `json:"parentId"`
+	Kind         LocationKind `validate:"omitempty,oneof=warehouse zone aisle shelf bin" json:"kind"`
+	PickSequence int64        `validate:"min=0" json:"pickSequence"`
+}
+
+type UpdateLocation struct {
+	ID           int64   `json:"id"`
+	Name         *string `json:"name"`
+	Code         *string `json:"code"`
+	PickSequence *int64  `json:"pickSequence"`
+}
+
+// MoveStock - moves stock of a product from one location to another in the same go, like
+// putting away received goods into a bin
+type MoveStock struct {
+	ProductID      int64 `validate:"required" json:"productId"`
+	FromLocationID int64 `validate:"required" json:"fromLocationId"`
+	ToLocationID   int64 `validate:"required,nefield=FromLocationID" json:"toLocationId"`
+	Qty            int64 `validate:"required,min=1" json:"qty"`
+}
+
+// PutawaySuggestion - where to put the units accepted on a receipt line. Bins already holding
+// the product come first so stock is kept together, then empty bins in pick sequence.
+type PutawaySuggestion struct {
+	ReceiptLineID int64   `json:"receiptLineId"`
+	ProductID     int64   `json:"productId"`
+	Qty           int64   `json:"qty"`
+	LocationID    *int64  `json:"locationId"`
+	LocationCode  *string `json:"locationCode"`
+	// Reason - consolidate, empty, or none when there's no bin to suggest
+	Reason string `json:"reason"`
+}
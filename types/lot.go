@@ -0,0 +1,46 @@
+package types
+
+import "time"
+
+// Lot - a batch of a product received together, every unit in it shares its dates
+type Lot struct {
+	ID             int64      `json:"id" xorm:"'id' pk autoincr"`
+	ProductID      int64      `json:"productId" xorm:"product_id"`
+	LotNumber      string     `json:"lotNumber" xorm:"lot_number"`
+	ManufacturedAt *time.Time `json:"manufacturedAt" xorm:"manufactured_at"`
+	// ExpiresAt - nil for lots that don't expire, they're picked after any that do
+	ExpiresAt *time.Time `json:"expiresAt" xorm:"expires_at"`
+	// Qty - units of this lot on hand
+	Qty       int64      `json:"qty" xorm:"qty"`
+	CreatedAt time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*Lot) TableName() string {
+	return "lots"
+}
+
+// Expired - whether the lot is past its expiry date at the given time
+func (l *Lot) Expired(at time.Time) bool {
+	return l.ExpiresAt != nil && !l.ExpiresAt.After(at)
+}
+
+// NewLot - the lot a receipt line came from. Receiving the same lot number again adds to it.
+type NewLot struct {
+	LotNumber      string     `validate:"required" json:"lotNumber"`
+	ManufacturedAt *time.Time `json:"manufacturedAt"`
+	ExpiresAt      *time.Time `json:"expiresAt"`
+}
+
+// ExpiringLot - a lot with stock on hand that expires within the report window, or already has
+type ExpiringLot struct {
+	LotID     int64     `json:"lotId" xorm:"lot_id"`
+	LotNumber string    `json:"lotNumber" xorm:"lot_number"`
+	ProductID int64     `json:"productId" xorm:"product_id"`
+	Sku       string    `json:"sku" xorm:"sku"`
+	Name      string    `json:"name" xorm:"name"`
+	Qty       int64     `json:"qty" xorm:"qty"`
+	ExpiresAt time.Time `json:"expiresAt" xorm:"expires_at"`
+	// DaysLeft - whole days until it expires, negative once it has
+	DaysLeft int64 `json:"daysLeft" xorm:"-"`
+}
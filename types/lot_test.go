@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"time"
+
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Lot", func() {
+	Context("Expired", func() {
+		It("should only expire lots with a date that has passed", func() {
+			now := time.Now()
+			lot := &types.Lot{}
+			Expect(lot.Expired(now)).To(BeFalse())
+
+			lot.ExpiresAt = &now
+			Expect(lot.Expired(now)).To(BeTrue())
+			Expect(lot.Expired(now.Add(-time.Minute))).To(BeFalse())
+		})
+	})
+})
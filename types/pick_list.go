@@ -0,0 +1,86 @@
+package types
+
+import "time"
+
+type PickListStatus string
+
+const (
+	PickListStatusOpen      PickListStatus = "open"
+	PickListStatusPicked    PickListStatus = "picked"
+	PickListStatusPacked    PickListStatus = "packed"
+	PickListStatusShipped   PickListStatus = "shipped"
+	PickListStatusCancelled PickListStatus = "cancelled"
+)
+
+var pickListTransitions = map[PickListStatus][]PickListStatus{
+	PickListStatusOpen:   {PickListStatusPicked, PickListStatusCancelled},
+	PickListStatusPicked: {PickListStatusPacked, PickListStatusCancelled},
+	PickListStatusPacked: {PickListStatusShipped, PickListStatusCancelled},
+}
+
+// CanTransition - whether a pick list in this status may move to the next one
+func (s PickListStatus) CanTransition(to PickListStatus) bool {
+	for _, allowed := range pickListTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// InProgress - whether the stock on a pick list in this status is still on its way out
+func (s PickListStatus) InProgress() bool {
+	return s == PickListStatusOpen || s == PickListStatusPicked || s == PickListStatusPacked
+}
+
+// PickList - what to pull from the shelves for a sales order
+type PickList struct {
+	ID           int64          `json:"id" xorm:"'id' pk autoincr"`
+	SalesOrderID int64          `json:"salesOrderId" xorm:"sales_order_id"`
+	Status       PickListStatus `json:"status" xorm:"status"`
+	PickedAt     *time.Time     `json:"pickedAt" xorm:"picked_at"`
+	CreatedAt    time.Time      `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time     `json:"updatedAt" xorm:"updated_at"`
+
+	Lines []*PickListLine `json:"lines" xorm:"-"`
+}
+
+func (*PickList) TableName() string {
+	return "pick_lists"
+}
+
+type PickListLine struct {
+	ID               int64 `json:"id" xorm:"'id' pk autoincr"`
+	PickListID       int64 `json:"pickListId" xorm:"pick_list_id"`
+	SalesOrderLineID int64 `json:"salesOrderLineId" xorm:"sales_order_line_id"`
+	ProductID        int64 `json:"productId" xorm:"product_id"`
+	// LocationID - where to pick from, nil when the stock isn't held at a location
+	LocationID *int64 `json:"locationId" xorm:"location_id"`
+	// LotID - which lot to pick, only set for lot tracked products
+	LotID     *int64    `json:"lotId" xorm:"lot_id"`
+	Qty       int64     `json:"qty" xorm:"qty"`
+	QtyPicked int64     `json:"qtyPicked" xorm:"qty_picked"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*PickListLine) TableName() string {
+	return "pick_list_lines"
+}
+
+type NewPickList struct {
+	SalesOrderID int64 `validate:"required" json:"salesOrderId"`
+}
+
+// PickPickList - confirms what came off the shelves, lines that aren't listed were picked in full.
+// Lines of serialized products always have to be listed with their serial numbers.
+type PickPickList struct {
+	ID    int64        `json:"id"`
+	Lines []PickedLine `validate:"omitempty,dive" json:"lines"`
+}
+
+type PickedLine struct {
+	PickListLineID int64 `validate:"required" json:"pickListLineId"`
+	QtyPicked      int64 `validate:"min=0" json:"qtyPicked"`
+	// SerialNumbers - the units picked of a serialized product, one for each
+	SerialNumbers []string `json:"serialNumbers"`
+}
@@ -3,27 +3,97 @@ package types
 import "time"
 
 type Product struct {
-	ID        int64      `json:"id" xorm:"'id' pk autoincr"`
-	Name      string     `validate:"required" json:"name" xorm:"name"`
-	Sku       string     `validate:"required" json:"sku" xorm:"sku"`
-	Qty       int64      `validate:"required,min=1" json:"qty" xorm:"qty"`
-	CreatedAt time.Time  `json:"createdAt" xorm:"created_at"`
-	UpdatedAt *time.Time `json:"updatedAt" xorm:"updated_at"`
+	ID   int64  `json:"id" xorm:"'id' pk autoincr"`
+	Name string `validate:"required" json:"name" xorm:"name"`
+	Sku  string `validate:"required" json:"sku" xorm:"sku"`
+	// Qty - the total on hand, including anything held at locations
+	Qty int64 `validate:"min=0" json:"qty" xorm:"qty"`
+	// OnOrder - ordered from suppliers on open purchase orders but not yet received
+	OnOrder int64 `validate:"min=0" json:"onOrder" xorm:"on_order"`
+	// Allocated - on hand but reserved for confirmed sales orders
+	Allocated int64 `validate:"min=0" json:"allocated" xorm:"allocated"`
+	// InTransit - part of Qty on its way between locations on a transfer, it can't be picked
+	// until it's received
+	InTransit int64 `validate:"min=0" json:"inTransit" xorm:"in_transit"`
+	// ReorderPoint - low stock alerts fire when Qty drops to or below this, 0 turns them off
+	ReorderPoint int64 `validate:"min=0" json:"reorderPoint" xorm:"reorder_point"`
+	ReorderQty   int64 `validate:"min=0" json:"reorderQty" xorm:"reorder_qty"`
+	// LotTracked - every unit received has to come from a lot and lots are picked first expiry first out
+	LotTracked bool `json:"lotTracked" xorm:"lot_tracked"`
+	// Serialized - every unit has its own serial number that's captured as it's received, picked and returned
+	Serialized bool `json:"serialized" xorm:"serialized"`
+	// Kit - sold as a bundle of other products, see Kit
+	Kit      bool    `json:"kit" xorm:"kit"`
+	Category *string `json:"category" xorm:"category"`
+	// TaxCategory - which tax rates it's charged, the ones without a category when it's nil
+	TaxCategory *string `json:"taxCategory" xorm:"tax_category"`
+	// CostMethod - how its stock is valued as it goes out, see CostMethod
+	CostMethod CostMethod `validate:"oneof=fifo average standard" json:"costMethod" xorm:"cost_method"`
+	// StandardCost - in cents, what every unit is worth when CostMethod is standard
+	StandardCost int64      `validate:"min=0" json:"standardCost" xorm:"standard_cost"`
+	CreatedAt    time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time `json:"updatedAt" xorm:"updated_at"`
 }
 
 func (*Product) TableName() string {
 	return "products"
 }
 
+// Available - on hand and not yet allocated to a sales order
+func (p *Product) Available() int64 {
+	return p.Qty - p.Allocated
+}
+
 type NewProduct struct {
-	Name string `validate:"required" json:"name"`
-	Sku  string `validate:"required" json:"sku"`
-	Qty  int64  `validate:"required,min=1" json:"qty"`
+	Name         string     `validate:"required" json:"name"`
+	Sku          string     `validate:"required" json:"sku"`
+	Qty          int64      `validate:"required,min=1" json:"qty"`
+	ReorderPoint int64      `validate:"min=0" json:"reorderPoint"`
+	ReorderQty   int64      `validate:"min=0" json:"reorderQty"`
+	LotTracked   bool       `json:"lotTracked"`
+	Serialized   bool       `json:"serialized"`
+	Category     *string    `json:"category"`
+	TaxCategory  *string    `json:"taxCategory"`
+	CostMethod   CostMethod `validate:"omitempty,oneof=fifo average standard" json:"costMethod"`
+	StandardCost int64      `validate:"min=0" json:"standardCost"`
+	// UnitCost - in cents, what the opening qty cost. It's valued at the standard cost when
+	// CostMethod is standard and nothing when it's left out.
+	UnitCost *int64 `validate:"omitempty,min=0" json:"unitCost"`
 }
 
 type UpdateProduct struct {
-	ID   int64   `json:"id"`
-	Name *string `json:"name"`
-	Sku  *string `json:"sku"`
-	Qty  *int64  `json:"qty"`
+	ID           int64   `json:"id"`
+	Name         *string `json:"name"`
+	Sku          *string `json:"sku"`
+	Qty          *int64  `json:"qty"`
+	ReorderPoint *int64  `json:"reorderPoint"`
+	ReorderQty   *int64  `json:"reorderQty"`
+	LotTracked   *bool   `json:"lotTracked"`
+	Serialized   *bool   `json:"serialized"`
+	Category     *string `json:"category"`
+	TaxCategory  *string `json:"taxCategory"`
+	// CostMethod - can only change while nothing is on hand
+	CostMethod *CostMethod `validate:"omitempty,oneof=fifo average standard" json:"costMethod"`
+	// StandardCost - changing it revalues what's on hand when CostMethod is standard
+	StandardCost *int64 `validate:"omitempty,min=0" json:"standardCost"`
+	// UnitCost - in cents, what any qty added by raising Qty cost, see NewProduct
+	UnitCost *int64 `validate:"omitempty,min=0" json:"unitCost"`
+	// LotID - the lot a change in Qty goes into or comes out of, needed for lot tracked products
+	LotID *int64 `json:"lotId"`
+}
+
+// ProductAdjustment - relative changes applied to a product's stock counters
+type ProductAdjustment struct {
+	Qty       int64
+	OnOrder   int64
+	Allocated int64
+	InTransit int64
+	// LocationID - where Qty changed, nil when it isn't held at a location
+	LocationID *int64
+	// UnitCost - in cents, what any qty added cost. When it's nil qty added comes in at the
+	// product's current unit cost.
+	UnitCost *int64
+	// Move - Qty is going between locations, or in and out of transit, and is matched by an
+	// opposite adjustment in the same transaction. It leaves the cost layers and value alone.
+	Move bool
 }
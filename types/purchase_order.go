@@ -0,0 +1,100 @@
+package types
+
+import "time"
+
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderStatusDraft             PurchaseOrderStatus = "draft"
+	PurchaseOrderStatusSubmitted         PurchaseOrderStatus = "submitted"
+	PurchaseOrderStatusPartiallyReceived PurchaseOrderStatus = "partially_received"
+	PurchaseOrderStatusReceived          PurchaseOrderStatus = "received"
+	PurchaseOrderStatusCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+var purchaseOrderTransitions = map[PurchaseOrderStatus][]PurchaseOrderStatus{
+	PurchaseOrderStatusDraft:             {PurchaseOrderStatusSubmitted, PurchaseOrderStatusCancelled},
+	PurchaseOrderStatusSubmitted:         {PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusReceived, PurchaseOrderStatusCancelled},
+	PurchaseOrderStatusPartiallyReceived: {PurchaseOrderStatusPartiallyReceived, PurchaseOrderStatusReceived, PurchaseOrderStatusCancelled},
+}
+
+// CanTransition - whether a purchase order in this status may move to the next one.
+// Received and cancelled orders are closed and can't move anywhere.
+func (s PurchaseOrderStatus) CanTransition(to PurchaseOrderStatus) bool {
+	for _, allowed := range purchaseOrderTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Open - whether stock is still expected against an order in this status
+func (s PurchaseOrderStatus) Open() bool {
+	return s == PurchaseOrderStatusSubmitted || s == PurchaseOrderStatusPartiallyReceived
+}
+
+type PurchaseOrder struct {
+	ID          int64               `json:"id" xorm:"'id' pk autoincr"`
+	SupplierID  int64               `validate:"required" json:"supplierId" xorm:"supplier_id"`
+	Status      PurchaseOrderStatus `json:"status" xorm:"status"`
+	ExpectedAt  *time.Time          `json:"expectedAt" xorm:"expected_at"`
+	Notes       *string             `json:"notes" xorm:"notes"`
+	SubmittedAt *time.Time          `json:"submittedAt" xorm:"submitted_at"`
+	CreatedAt   time.Time           `json:"createdAt" xorm:"created_at"`
+	UpdatedAt   *time.Time          `json:"updatedAt" xorm:"updated_at"`
+
+	Lines []*PurchaseOrderLine `json:"lines" xorm:"-"`
+}
+
+func (*PurchaseOrder) TableName() string {
+	return "purchase_orders"
+}
+
+type PurchaseOrderLine struct {
+	ID              int64 `json:"id" xorm:"'id' pk autoincr"`
+	PurchaseOrderID int64 `json:"purchaseOrderId" xorm:"purchase_order_id"`
+	ProductID       int64 `validate:"required" json:"productId" xorm:"product_id"`
+	QtyOrdered      int64 `validate:"min=1" json:"qtyOrdered" xorm:"qty_ordered"`
+	QtyReceived     int64 `validate:"min=0" json:"qtyReceived" xorm:"qty_received"`
+	// Closed - nothing more is expected on this line, either it was received in full or
+	// it was closed short
+	Closed bool `json:"closed" xorm:"closed"`
+	// UnitCost - in cents
+	UnitCost  int64     `validate:"min=0" json:"unitCost" xorm:"unit_cost"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*PurchaseOrderLine) TableName() string {
+	return "purchase_order_lines"
+}
+
+// Outstanding - how many are still expected on this line
+func (l *PurchaseOrderLine) Outstanding() int64 {
+	if l.Closed || l.QtyReceived >= l.QtyOrdered {
+		return 0
+	}
+	return l.QtyOrdered - l.QtyReceived
+}
+
+type NewPurchaseOrder struct {
+	SupplierID int64                  `validate:"required" json:"supplierId"`
+	ExpectedAt *time.Time             `json:"expectedAt"`
+	Notes      *string                `json:"notes"`
+	Lines      []NewPurchaseOrderLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type NewPurchaseOrderLine struct {
+	ProductID int64 `validate:"required" json:"productId"`
+	Qty       int64 `validate:"required,min=1" json:"qty"`
+	// UnitCost - in cents, defaults to the supplier's cost for the product
+	UnitCost *int64 `validate:"omitempty,min=0" json:"unitCost"`
+}
+
+// UpdatePurchaseOrder - only draft orders can have their lines replaced
+type UpdatePurchaseOrder struct {
+	ID         int64                  `json:"id"`
+	ExpectedAt *time.Time             `json:"expectedAt"`
+	Notes      *string                `json:"notes"`
+	Lines      []NewPurchaseOrderLine `validate:"omitempty,dive" json:"lines"`
+}
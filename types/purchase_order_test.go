@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: PurchaseOrder", func() {
+	Context("CanTransition", func() {
+		DescribeTable("the purchase order lifecycle",
+			func(from, to types.PurchaseOrderStatus, allowed bool) {
+				Expect(from.CanTransition(to)).To(Equal(allowed))
+			},
+			Entry("draft to submitted", types.PurchaseOrderStatusDraft, types.PurchaseOrderStatusSubmitted, true),
+			Entry("draft to cancelled", types.PurchaseOrderStatusDraft, types.PurchaseOrderStatusCancelled, true),
+			Entry("draft to received", types.PurchaseOrderStatusDraft, types.PurchaseOrderStatusReceived, false),
+			Entry("submitted to partially received", types.PurchaseOrderStatusSubmitted, types.PurchaseOrderStatusPartiallyReceived, true),
+			Entry("submitted to received", types.PurchaseOrderStatusSubmitted, types.PurchaseOrderStatusReceived, true),
+			Entry("submitted to draft", types.PurchaseOrderStatusSubmitted, types.PurchaseOrderStatusDraft, false),
+			Entry("partially received to received", types.PurchaseOrderStatusPartiallyReceived, types.PurchaseOrderStatusReceived, true),
+			Entry("partially received to cancelled", types.PurchaseOrderStatusPartiallyReceived, types.PurchaseOrderStatusCancelled, true),
+			Entry("received to cancelled", types.PurchaseOrderStatusReceived, types.PurchaseOrderStatusCancelled, false),
+			Entry("cancelled to submitted", types.PurchaseOrderStatusCancelled, types.PurchaseOrderStatusSubmitted, false),
+		)
+	})
+
+	Context("Outstanding", func() {
+		It("should never go below zero on an over delivery", func() {
+			line := &types.PurchaseOrderLine{QtyOrdered: 10, QtyReceived: 12}
+			Expect(line.Outstanding()).To(BeNumerically("==", 0))
+
+			line.QtyReceived = 4
+			Expect(line.Outstanding()).To(BeNumerically("==", 6))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package types
+
+import "time"
+
+// Receipt - a delivery received against a purchase order
+type Receipt struct {
+	ID              int64 `json:"id" xorm:"'id' pk autoincr"`
+	PurchaseOrderID int64 `json:"purchaseOrderId" xorm:"purchase_order_id"`
+	// LocationID - where the goods were put, nil leaves them unassigned on the product total
+	LocationID *int64    `json:"locationId" xorm:"location_id"`
+	Notes      *string   `json:"notes" xorm:"notes"`
+	ReceivedAt time.Time `json:"receivedAt" xorm:"received_at"`
+	CreatedAt  time.Time `json:"createdAt" xorm:"created_at"`
+
+	Lines []*ReceiptLine `json:"lines" xorm:"-"`
+}
+
+func (*Receipt) TableName() string {
+	return "receipts"
+}
+
+type ReceiptLine struct {
+	ID                  int64 `json:"id" xorm:"'id' pk autoincr"`
+	ReceiptID           int64 `json:"receiptId" xorm:"receipt_id"`
+	PurchaseOrderLineID int64 `json:"purchaseOrderLineId" xorm:"purchase_order_line_id"`
+	ProductID           int64 `json:"productId" xorm:"product_id"`
+	// QtyReceived - everything that came off the truck, damaged units included
+	QtyReceived int64 `json:"qtyReceived" xorm:"qty_received"`
+	// QtyDamaged - units that arrived unusable, they don't go into stock or count against the order
+	QtyDamaged int64 `json:"qtyDamaged" xorm:"qty_damaged"`
+	// LotID - the lot the accepted units went into
+	LotID     *int64    `json:"lotId" xorm:"lot_id"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*ReceiptLine) TableName() string {
+	return "receipt_lines"
+}
+
+// Accepted - the units that went into stock
+func (l *ReceiptLine) Accepted() int64 {
+	return l.QtyReceived - l.QtyDamaged
+}
+
+type NewReceipt struct {
+	PurchaseOrderID int64            `validate:"required" json:"purchaseOrderId"`
+	LocationID      *int64           `json:"locationId"`
+	Notes           *string          `json:"notes"`
+	ReceivedAt      *time.Time       `json:"receivedAt"`
+	Lines           []NewReceiptLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type NewReceiptLine struct {
+	PurchaseOrderLineID int64 `validate:"required" json:"purchaseOrderLineId"`
+	QtyReceived         int64 `validate:"min=0" json:"qtyReceived"`
+	QtyDamaged          int64 `validate:"min=0,ltefield=QtyReceived" json:"qtyDamaged"`
+	// CloseShort - don't expect the rest of this line, the supplier isn't sending it
+	CloseShort bool `json:"closeShort"`
+	// Lot - required for lot tracked products
+	Lot *NewLot `json:"lot"`
+	// SerialNumbers - one for every accepted unit of a serialized product
+	SerialNumbers []string `json:"serialNumbers"`
+}
@@ -0,0 +1,117 @@
+package types
+
+import "time"
+
+type ReturnStatus string
+
+const (
+	ReturnStatusAuthorized        ReturnStatus = "authorized"
+	ReturnStatusPartiallyReceived ReturnStatus = "partially_received"
+	ReturnStatusReceived          ReturnStatus = "received"
+	ReturnStatusCancelled         ReturnStatus = "cancelled"
+)
+
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusAuthorized:        {ReturnStatusPartiallyReceived, ReturnStatusReceived, ReturnStatusCancelled},
+	ReturnStatusPartiallyReceived: {ReturnStatusPartiallyReceived, ReturnStatusReceived},
+}
+
+// CanTransition - whether a return in this status may move to the next one. Once anything
+// has come back the return can't be cancelled.
+func (s ReturnStatus) CanTransition(to ReturnStatus) bool {
+	for _, allowed := range returnTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Open - whether goods can still be received against a return in this status
+func (s ReturnStatus) Open() bool {
+	return s == ReturnStatusAuthorized || s == ReturnStatusPartiallyReceived
+}
+
+// ReturnDisposition - what inspection decided to do with returned units
+type ReturnDisposition string
+
+const (
+	// ReturnDispositionRestock - back into sellable stock
+	ReturnDispositionRestock ReturnDisposition = "restock"
+	// ReturnDispositionQuarantine - held off stock until it's restocked or scrapped
+	ReturnDispositionQuarantine ReturnDisposition = "quarantine"
+	// ReturnDispositionScrap - written off, it never reaches stock
+	ReturnDispositionScrap ReturnDisposition = "scrap"
+)
+
+// Return - a return authorization (RMA) against a sales order
+type Return struct {
+	ID           int64        `json:"id" xorm:"'id' pk autoincr"`
+	SalesOrderID int64        `json:"salesOrderId" xorm:"sales_order_id"`
+	Status       ReturnStatus `json:"status" xorm:"status"`
+	Reason       *string      `json:"reason" xorm:"reason"`
+	Notes        *string      `json:"notes" xorm:"notes"`
+	CreatedAt    time.Time    `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time   `json:"updatedAt" xorm:"updated_at"`
+
+	Lines []*ReturnLine `json:"lines" xorm:"-"`
+}
+
+func (*Return) TableName() string {
+	return "returns"
+}
+
+type ReturnLine struct {
+	ID               int64 `json:"id" xorm:"'id' pk autoincr"`
+	ReturnID         int64 `json:"returnId" xorm:"return_id"`
+	SalesOrderLineID int64 `json:"salesOrderLineId" xorm:"sales_order_line_id"`
+	ProductID        int64 `json:"productId" xorm:"product_id"`
+	QtyAuthorized    int64 `json:"qtyAuthorized" xorm:"qty_authorized"`
+	QtyReceived      int64 `json:"qtyReceived" xorm:"qty_received"`
+	QtyRestocked     int64 `json:"qtyRestocked" xorm:"qty_restocked"`
+	// QtyQuarantined - received units waiting on a decision, they aren't in stock
+	QtyQuarantined int64     `json:"qtyQuarantined" xorm:"qty_quarantined"`
+	QtyScrapped    int64     `json:"qtyScrapped" xorm:"qty_scrapped"`
+	CreatedAt      time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*ReturnLine) TableName() string {
+	return "return_lines"
+}
+
+// Outstanding - how many are authorized but haven't come back yet
+func (l *ReturnLine) Outstanding() int64 {
+	return l.QtyAuthorized - l.QtyReceived
+}
+
+type NewReturn struct {
+	SalesOrderID int64           `validate:"required" json:"salesOrderId"`
+	Reason       *string         `json:"reason"`
+	Notes        *string         `json:"notes"`
+	Lines        []NewReturnLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type NewReturnLine struct {
+	SalesOrderLineID int64 `validate:"required" json:"salesOrderLineId"`
+	Qty              int64 `validate:"required,min=1" json:"qty"`
+}
+
+// DisposeReturn - gives returned units a disposition, either as they're received or,
+// for quarantined units, once a decision has been made
+type DisposeReturn struct {
+	ID int64 `json:"id"`
+	// LocationID - where restocked units are put, nil leaves them unassigned on the product total
+	LocationID *int64              `json:"locationId"`
+	Lines      []DisposeReturnLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type DisposeReturnLine struct {
+	ReturnLineID int64             `validate:"required" json:"returnLineId"`
+	Qty          int64             `validate:"required,min=1" json:"qty"`
+	Disposition  ReturnDisposition `validate:"required,oneof=restock quarantine scrap" json:"disposition"`
+	// SerialNumbers - the units of a serialized product, needed when they're received and
+	// when they're restocked
+	SerialNumbers []string `json:"serialNumbers"`
+	// LotID - the lot restocked units of a lot tracked product go back into
+	LotID *int64 `json:"lotId"`
+}
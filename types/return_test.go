@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Return", func() {
+	Context("CanTransition", func() {
+		DescribeTable("the return lifecycle",
+			func(from, to types.ReturnStatus, allowed bool) {
+				Expect(from.CanTransition(to)).To(Equal(allowed))
+			},
+			Entry("authorized to partially received", types.ReturnStatusAuthorized, types.ReturnStatusPartiallyReceived, true),
+			Entry("authorized to received", types.ReturnStatusAuthorized, types.ReturnStatusReceived, true),
+			Entry("authorized to cancelled", types.ReturnStatusAuthorized, types.ReturnStatusCancelled, true),
+			Entry("partially received to received", types.ReturnStatusPartiallyReceived, types.ReturnStatusReceived, true),
+			Entry("partially received to cancelled", types.ReturnStatusPartiallyReceived, types.ReturnStatusCancelled, false),
+			Entry("received to partially received", types.ReturnStatusReceived, types.ReturnStatusPartiallyReceived, false),
+			Entry("cancelled to authorized", types.ReturnStatusCancelled, types.ReturnStatusAuthorized, false),
+		)
+	})
+
+	Context("DisposeReturn", func() {
+		It("should only accept known dispositions", func() {
+			dispose := types.DisposeReturn{Lines: []types.DisposeReturnLine{{ReturnLineID: 1, Qty: 1, Disposition: "resell"}}}
+			Expect(types.Validate(dispose)).NotTo(Succeed())
+
+			dispose.Lines[0].Disposition = types.ReturnDispositionQuarantine
+			Expect(types.Validate(dispose)).To(Succeed())
+		})
+	})
+})
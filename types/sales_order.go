@@ -0,0 +1,106 @@
+package types
+
+import "time"
+
+type SalesOrderStatus string
+
+const (
+	SalesOrderStatusDraft            SalesOrderStatus = "draft"
+	SalesOrderStatusConfirmed        SalesOrderStatus = "confirmed"
+	SalesOrderStatusPartiallyShipped SalesOrderStatus = "partially_shipped"
+	SalesOrderStatusShipped          SalesOrderStatus = "shipped"
+	SalesOrderStatusCancelled        SalesOrderStatus = "cancelled"
+)
+
+var salesOrderTransitions = map[SalesOrderStatus][]SalesOrderStatus{
+	SalesOrderStatusDraft:            {SalesOrderStatusConfirmed, SalesOrderStatusCancelled},
+	SalesOrderStatusConfirmed:        {SalesOrderStatusPartiallyShipped, SalesOrderStatusShipped, SalesOrderStatusCancelled},
+	SalesOrderStatusPartiallyShipped: {SalesOrderStatusPartiallyShipped, SalesOrderStatusShipped, SalesOrderStatusCancelled},
+}
+
+// CanTransition - whether a sales order in this status may move to the next one.
+// Shipped and cancelled orders are closed and can't move anywhere.
+func (s SalesOrderStatus) CanTransition(to SalesOrderStatus) bool {
+	for _, allowed := range salesOrderTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Open - whether an order in this status still has stock to ship
+func (s SalesOrderStatus) Open() bool {
+	return s == SalesOrderStatusConfirmed || s == SalesOrderStatusPartiallyShipped
+}
+
+type SalesOrder struct {
+	ID           int64            `json:"id" xorm:"'id' pk autoincr"`
+	CustomerID   *int64           `json:"customerId" xorm:"customer_id"`
+	CustomerName string           `validate:"required" json:"customerName" xorm:"customer_name"`
+	Reference    *string          `json:"reference" xorm:"reference"`
+	Status       SalesOrderStatus `json:"status" xorm:"status"`
+	Notes        *string          `json:"notes" xorm:"notes"`
+	ConfirmedAt  *time.Time       `json:"confirmedAt" xorm:"confirmed_at"`
+	CreatedAt    time.Time        `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time       `json:"updatedAt" xorm:"updated_at"`
+
+	Lines []*SalesOrderLine `json:"lines" xorm:"-"`
+	// Tax - only worked out when a single order is looked up
+	Tax *TaxBreakdown `json:"tax,omitempty" xorm:"-"`
+}
+
+func (*SalesOrder) TableName() string {
+	return "sales_orders"
+}
+
+type SalesOrderLine struct {
+	ID           int64 `json:"id" xorm:"'id' pk autoincr"`
+	SalesOrderID int64 `json:"salesOrderId" xorm:"sales_order_id"`
+	ProductID    int64 `validate:"required" json:"productId" xorm:"product_id"`
+	Qty          int64 `validate:"min=1" json:"qty" xorm:"qty"`
+	// QtyAllocated - stock on hand reserved for this line and not shipped yet
+	QtyAllocated int64 `validate:"min=0" json:"qtyAllocated" xorm:"qty_allocated"`
+	QtyShipped   int64 `validate:"min=0" json:"qtyShipped" xorm:"qty_shipped"`
+	// Backorder - split off when there wasn't enough stock on confirmation, it's
+	// allocated as stock arrives
+	Backorder bool `json:"backorder" xorm:"backorder"`
+	// UnitPrice - in cents
+	UnitPrice int64     `validate:"min=0" json:"unitPrice" xorm:"unit_price"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*SalesOrderLine) TableName() string {
+	return "sales_order_lines"
+}
+
+// Unallocated - how many still need stock
+func (l *SalesOrderLine) Unallocated() int64 {
+	return l.Qty - l.QtyAllocated - l.QtyShipped
+}
+
+type NewSalesOrder struct {
+	CustomerID *int64 `json:"customerId"`
+	// CustomerName - defaults to the customer's name
+	CustomerName string              `validate:"required_without=CustomerID" json:"customerName"`
+	Reference    *string             `json:"reference"`
+	Notes        *string             `json:"notes"`
+	Lines        []NewSalesOrderLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type NewSalesOrderLine struct {
+	ProductID int64 `validate:"required" json:"productId"`
+	Qty       int64 `validate:"required,min=1" json:"qty"`
+	// UnitPrice - in cents
+	UnitPrice int64 `validate:"min=0" json:"unitPrice"`
+}
+
+// UpdateSalesOrder - only draft orders can have their lines replaced
+type UpdateSalesOrder struct {
+	ID           int64               `json:"id"`
+	CustomerID   *int64              `json:"customerId"`
+	CustomerName *string             `validate:"omitempty,min=1" json:"customerName"`
+	Reference    *string             `json:"reference"`
+	Notes        *string             `json:"notes"`
+	Lines        []NewSalesOrderLine `validate:"omitempty,dive" json:"lines"`
+}
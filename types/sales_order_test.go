@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: SalesOrder", func() {
+	Context("CanTransition", func() {
+		DescribeTable("the sales order lifecycle",
+			func(from, to types.SalesOrderStatus, allowed bool) {
+				Expect(from.CanTransition(to)).To(Equal(allowed))
+			},
+			Entry("draft to confirmed", types.SalesOrderStatusDraft, types.SalesOrderStatusConfirmed, true),
+			Entry("draft to cancelled", types.SalesOrderStatusDraft, types.SalesOrderStatusCancelled, true),
+			Entry("confirmed to cancelled", types.SalesOrderStatusConfirmed, types.SalesOrderStatusCancelled, true),
+			Entry("confirmed to partially shipped", types.SalesOrderStatusConfirmed, types.SalesOrderStatusPartiallyShipped, true),
+			Entry("partially shipped to shipped", types.SalesOrderStatusPartiallyShipped, types.SalesOrderStatusShipped, true),
+			Entry("partially shipped to cancelled", types.SalesOrderStatusPartiallyShipped, types.SalesOrderStatusCancelled, true),
+			Entry("shipped to cancelled", types.SalesOrderStatusShipped, types.SalesOrderStatusCancelled, false),
+			Entry("confirmed to draft", types.SalesOrderStatusConfirmed, types.SalesOrderStatusDraft, false),
+			Entry("confirmed to confirmed", types.SalesOrderStatusConfirmed, types.SalesOrderStatusConfirmed, false),
+			Entry("cancelled to confirmed", types.SalesOrderStatusCancelled, types.SalesOrderStatusConfirmed, false),
+		)
+	})
+
+	Context("Unallocated", func() {
+		It("should count shipped units as filled", func() {
+			line := &types.SalesOrderLine{Qty: 10, QtyAllocated: 3, QtyShipped: 4}
+			Expect(line.Unallocated()).To(BeNumerically("==", 3))
+		})
+	})
+})
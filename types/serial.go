@@ -0,0 +1,86 @@
+package types
+
+import "time"
+
+type SerialStatus string
+
+const (
+	SerialStatusInStock SerialStatus = "in_stock"
+	// SerialStatusAllocated - picked for a sales order and waiting to ship
+	SerialStatusAllocated SerialStatus = "allocated"
+	SerialStatusShipped   SerialStatus = "shipped"
+	// SerialStatusReturned - came back from a customer and hasn't been restocked
+	SerialStatusReturned SerialStatus = "returned"
+)
+
+var serialTransitions = map[SerialStatus][]SerialStatus{
+	SerialStatusInStock:   {SerialStatusAllocated},
+	SerialStatusAllocated: {SerialStatusInStock, SerialStatusShipped},
+	SerialStatusShipped:   {SerialStatusReturned},
+	SerialStatusReturned:  {SerialStatusInStock},
+}
+
+// CanTransition - whether a serial in this status may move to the next one. An allocated
+// serial goes back in stock when its pick list is cancelled.
+func (s SerialStatus) CanTransition(to SerialStatus) bool {
+	for _, allowed := range serialTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Serial - a single unit of a serialized product, serial numbers are unique across every product
+type Serial struct {
+	ID           int64        `json:"id" xorm:"'id' pk autoincr"`
+	ProductID    int64        `json:"productId" xorm:"product_id"`
+	SerialNumber string       `json:"serialNumber" xorm:"serial_number"`
+	Status       SerialStatus `json:"status" xorm:"status"`
+	// LocationID - where the unit is, nil when it isn't held at a location or has shipped
+	LocationID *int64 `json:"locationId" xorm:"location_id"`
+	// PickListLineID - the pick list line an allocated unit was picked for
+	PickListLineID *int64     `json:"pickListLineId" xorm:"pick_list_line_id"`
+	CreatedAt      time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt      *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*Serial) TableName() string {
+	return "serials"
+}
+
+// SerialEvent - a serial's status and location after something moved it
+type SerialEvent struct {
+	ID         int64        `json:"id" xorm:"'id' pk autoincr"`
+	SerialID   int64        `json:"serialId" xorm:"serial_id"`
+	Status     SerialStatus `json:"status" xorm:"status"`
+	LocationID *int64       `json:"locationId" xorm:"location_id"`
+	// ReferenceType and ReferenceID - the record that moved it, e.g. "shipment" and the shipment's id
+	ReferenceType *string   `json:"referenceType" xorm:"reference_type"`
+	ReferenceID   *int64    `json:"referenceId" xorm:"reference_id"`
+	CreatedAt     time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*SerialEvent) TableName() string {
+	return "serial_events"
+}
+
+// SerialHistory - a serial along with everything that has happened to it, oldest first
+type SerialHistory struct {
+	Serial *Serial        `json:"serial"`
+	Events []*SerialEvent `json:"events"`
+}
+
+// SerialMove - moves units of a product to a new status. Serialized products need a serial
+// number for each of the Qty units, for other products the move is a no-op.
+type SerialMove struct {
+	ProductID     int64
+	Qty           int64
+	SerialNumbers []string
+	To            SerialStatus
+	LocationID    *int64
+	// PickListLineID - the line allocated units are picked for
+	PickListLineID *int64
+	ReferenceType  *string
+	ReferenceID    *int64
+}
@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Serial", func() {
+	Context("CanTransition", func() {
+		DescribeTable("the serial lifecycle",
+			func(from, to types.SerialStatus, allowed bool) {
+				Expect(from.CanTransition(to)).To(Equal(allowed))
+			},
+			Entry("in stock to allocated", types.SerialStatusInStock, types.SerialStatusAllocated, true),
+			Entry("in stock to shipped", types.SerialStatusInStock, types.SerialStatusShipped, false),
+			Entry("allocated to shipped", types.SerialStatusAllocated, types.SerialStatusShipped, true),
+			Entry("allocated back in stock", types.SerialStatusAllocated, types.SerialStatusInStock, true),
+			Entry("shipped to returned", types.SerialStatusShipped, types.SerialStatusReturned, true),
+			Entry("shipped to in stock", types.SerialStatusShipped, types.SerialStatusInStock, false),
+			Entry("returned to in stock", types.SerialStatusReturned, types.SerialStatusInStock, true),
+			Entry("returned to allocated", types.SerialStatusReturned, types.SerialStatusAllocated, false),
+		)
+	})
+})
@@ -0,0 +1,81 @@
+package types
+
+import "time"
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusPacked    ShipmentStatus = "packed"
+	ShipmentStatusShipped   ShipmentStatus = "shipped"
+	ShipmentStatusCancelled ShipmentStatus = "cancelled"
+)
+
+// Shipment - the cartons packed from a pick list and how they went out
+type Shipment struct {
+	ID             int64          `json:"id" xorm:"'id' pk autoincr"`
+	SalesOrderID   int64          `json:"salesOrderId" xorm:"sales_order_id"`
+	PickListID     int64          `json:"pickListId" xorm:"pick_list_id"`
+	Status         ShipmentStatus `json:"status" xorm:"status"`
+	Carrier        *string        `json:"carrier" xorm:"carrier"`
+	TrackingNumber *string        `json:"trackingNumber" xorm:"tracking_number"`
+	ShippedAt      *time.Time     `json:"shippedAt" xorm:"shipped_at"`
+	CreatedAt      time.Time      `json:"createdAt" xorm:"created_at"`
+	UpdatedAt      *time.Time     `json:"updatedAt" xorm:"updated_at"`
+
+	Cartons []*Carton `json:"cartons" xorm:"-"`
+}
+
+func (*Shipment) TableName() string {
+	return "shipments"
+}
+
+type Carton struct {
+	ID         int64 `json:"id" xorm:"'id' pk autoincr"`
+	ShipmentID int64 `json:"shipmentId" xorm:"shipment_id"`
+	// Reference - whatever is written on the box, defaults to its number in the shipment
+	Reference   string    `json:"reference" xorm:"reference"`
+	WeightGrams int64     `json:"weightGrams" xorm:"weight_grams"`
+	CreatedAt   time.Time `json:"createdAt" xorm:"created_at"`
+
+	Items []*CartonItem `json:"items" xorm:"-"`
+}
+
+func (*Carton) TableName() string {
+	return "shipment_cartons"
+}
+
+type CartonItem struct {
+	ID               int64 `json:"id" xorm:"'id' pk autoincr"`
+	CartonID         int64 `json:"cartonId" xorm:"carton_id"`
+	PickListLineID   int64 `json:"pickListLineId" xorm:"pick_list_line_id"`
+	SalesOrderLineID int64 `json:"salesOrderLineId" xorm:"sales_order_line_id"`
+	ProductID        int64 `json:"productId" xorm:"product_id"`
+	Qty              int64 `json:"qty" xorm:"qty"`
+}
+
+func (*CartonItem) TableName() string {
+	return "shipment_carton_items"
+}
+
+// NewShipment - packs a picked pick list, everything that was picked has to go in a carton
+type NewShipment struct {
+	PickListID int64       `validate:"required" json:"pickListId"`
+	Cartons    []NewCarton `validate:"required,min=1,dive" json:"cartons"`
+}
+
+type NewCarton struct {
+	Reference   string          `json:"reference"`
+	WeightGrams int64           `validate:"min=0" json:"weightGrams"`
+	Items       []NewCartonItem `validate:"required,min=1,dive" json:"items"`
+}
+
+type NewCartonItem struct {
+	PickListLineID int64 `validate:"required" json:"pickListLineId"`
+	Qty            int64 `validate:"required,min=1" json:"qty"`
+}
+
+type ShipShipment struct {
+	ID             int64  `json:"id"`
+	Carrier        string `validate:"required" json:"carrier"`
+	TrackingNumber string `validate:"required" json:"trackingNumber"`
+}
@@ -0,0 +1,30 @@
+package types
+
+import "time"
+
+// StockSnapshot - everything on hand at a point in time, by product and location. Stock as of
+// any time is rebuilt from the last snapshot before it and the qty changes since.
+type StockSnapshot struct {
+	ID      int64     `json:"id" xorm:"'id' pk autoincr"`
+	TakenAt time.Time `json:"takenAt" xorm:"taken_at"`
+	// Lines - how many product and location pairs it holds
+	Lines     int64     `json:"lines" xorm:"lines"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*StockSnapshot) TableName() string {
+	return "stock_snapshots"
+}
+
+type StockSnapshotLine struct {
+	ID         int64 `json:"id" xorm:"'id' pk autoincr"`
+	SnapshotID int64 `json:"snapshotId" xorm:"snapshot_id"`
+	ProductID  int64 `json:"productId" xorm:"product_id"`
+	// LocationID - nil for stock that isn't held at a location, including anything in transit
+	LocationID *int64 `json:"locationId" xorm:"location_id"`
+	Qty        int64  `json:"qty" xorm:"qty"`
+}
+
+func (*StockSnapshotLine) TableName() string {
+	return "stock_snapshot_lines"
+}
@@ -0,0 +1,123 @@
+package types
+
+import "time"
+
+// StockLevel - how much of a product is held at a location
+type StockLevel struct {
+	ID         int64 `json:"id" xorm:"'id' pk autoincr"`
+	ProductID  int64 `validate:"required" json:"productId" xorm:"product_id"`
+	LocationID int64 `validate:"required" json:"locationId" xorm:"location_id"`
+	Qty        int64 `validate:"min=0" json:"qty" xorm:"qty"`
+	// ReorderPoint - low stock alerts fire when Qty drops to or below this, 0 turns them off
+	ReorderPoint int64      `validate:"min=0" json:"reorderPoint" xorm:"reorder_point"`
+	ReorderQty   int64      `validate:"min=0" json:"reorderQty" xorm:"reorder_qty"`
+	CreatedAt    time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*StockLevel) TableName() string {
+	return "stock_levels"
+}
+
+// SetStockLevel - creates or updates the stock level for a product at a location,
+// nil fields are left alone
+type SetStockLevel struct {
+	ProductID    int64  `validate:"required" json:"productId"`
+	LocationID   int64  `validate:"required" json:"locationId"`
+	Qty          *int64 `validate:"omitempty,min=0" json:"qty"`
+	ReorderPoint *int64 `validate:"omitempty,min=0" json:"reorderPoint"`
+	ReorderQty   *int64 `validate:"omitempty,min=0" json:"reorderQty"`
+	// UnitCost - in cents, what any qty added cost, see ProductAdjustment
+	UnitCost *int64 `validate:"omitempty,min=0" json:"unitCost"`
+	// LotID - the lot a change in Qty goes into or comes out of, needed for lot tracked products
+	LotID *int64 `json:"lotId"`
+	// Move - see ProductAdjustment, stock can only be moved in code
+	Move bool `json:"-"`
+}
+
+// StockChange - a committed change to a product's quantity, either its total
+// (LocationID nil) or at a single location
+type StockChange struct {
+	ProductID    int64  `json:"productId"`
+	Sku          string `json:"sku"`
+	Name         string `json:"name"`
+	LocationID   *int64 `json:"locationId"`
+	Before       int64  `json:"before"`
+	After        int64  `json:"after"`
+	ReorderPoint int64  `json:"reorderPoint"`
+	ReorderQty   int64  `json:"reorderQty"`
+}
+
+// CrossedReorderPoint - whether this change took the quantity from above the
+// reorder point to at or below it
+func (c StockChange) CrossedReorderPoint() bool {
+	return c.ReorderPoint > 0 && c.Before > c.ReorderPoint && c.After <= c.ReorderPoint
+}
+
+// LowStockItem - a product, or a product at a location, at or below its reorder point
+type LowStockItem struct {
+	ProductID    int64   `json:"productId" xorm:"product_id"`
+	Sku          string  `json:"sku" xorm:"sku"`
+	Name         string  `json:"name" xorm:"name"`
+	LocationID   *int64  `json:"locationId" xorm:"location_id"`
+	LocationCode *string `json:"locationCode" xorm:"location_code"`
+	Qty          int64   `json:"qty" xorm:"qty"`
+	ReorderPoint int64   `json:"reorderPoint" xorm:"reorder_point"`
+	ReorderQty   int64   `json:"reorderQty" xorm:"reorder_qty"`
+	Shortfall    int64   `json:"shortfall" xorm:"shortfall"`
+}
+
+type StockMovementReason string
+
+const (
+	// StockMovementReasonReturn - returned goods put back into stock
+	StockMovementReasonReturn StockMovementReason = "return"
+	// StockMovementReasonReturnQuarantine - returned goods held in quarantine, in when they're
+	// received and out when they're released, they're never on hand while they're held
+	StockMovementReasonReturnQuarantine StockMovementReason = "return_quarantine"
+	// StockMovementReasonReturnScrap - returned goods written off, they never reach on hand
+	StockMovementReasonReturnScrap StockMovementReason = "return_scrap"
+	// StockMovementReasonTransferOut - shipped from a transfer's source location, it's in transit
+	StockMovementReasonTransferOut StockMovementReason = "transfer_out"
+	// StockMovementReasonTransferIn - received at a transfer's destination location
+	StockMovementReasonTransferIn StockMovementReason = "transfer_in"
+	// StockMovementReasonTransferLoss - in transit stock written off when a transfer is closed short
+	StockMovementReasonTransferLoss StockMovementReason = "transfer_loss"
+	// StockMovementReasonCount - a variance found by a count session and approved
+	StockMovementReasonCount StockMovementReason = "count"
+	// StockMovementReasonMove - moved between locations in one go, like a putaway into a bin
+	StockMovementReasonMove StockMovementReason = "move"
+	// StockMovementReasonKit - components used up building a kit and the kits they made
+	StockMovementReasonKit StockMovementReason = "kit"
+	// StockMovementReasonWorkOrder - components used up on a work order and the finished goods it made
+	StockMovementReasonWorkOrder StockMovementReason = "work_order"
+)
+
+// StockMovement - a recorded change to on hand stock and what caused it
+type StockMovement struct {
+	ID        int64 `json:"id" xorm:"'id' pk autoincr"`
+	ProductID int64 `json:"productId" xorm:"product_id"`
+	// LocationID - nil when the change was to stock that isn't held at a location
+	LocationID *int64 `json:"locationId" xorm:"location_id"`
+	// Qty - signed, what was added to or taken off on hand. Returned goods quarantined or
+	// scrapped are recorded without changing on hand, see their reasons.
+	Qty    int64               `json:"qty" xorm:"qty"`
+	Reason StockMovementReason `json:"reason" xorm:"reason"`
+	// ReferenceType and ReferenceID - the record that caused it, e.g. "return" and the return's id
+	ReferenceType *string   `json:"referenceType" xorm:"reference_type"`
+	ReferenceID   *int64    `json:"referenceId" xorm:"reference_id"`
+	CreatedAt     time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*StockMovement) TableName() string {
+	return "stock_movements"
+}
+
+type NewStockMovement struct {
+	ProductID     int64               `validate:"required" json:"productId"`
+	LocationID    *int64              `json:"locationId"`
+	Qty           int64               `validate:"required" json:"qty"`
+	Reason        StockMovementReason `validate:"required" json:"reason"`
+	ReferenceType *string             `json:"referenceType"`
+	ReferenceID   *int64              `json:"referenceId"`
+}
@@ -0,0 +1,77 @@
+package types
+
+import "time"
+
+// Supplier - a vendor we buy products from
+type Supplier struct {
+	ID          int64      `json:"id" xorm:"'id' pk autoincr"`
+	Name        string     `validate:"required" json:"name" xorm:"name"`
+	Code        string     `validate:"required" json:"code" xorm:"code"`
+	ContactName *string    `json:"contactName" xorm:"contact_name"`
+	Email       *string    `validate:"omitempty,email" json:"email" xorm:"email"`
+	Phone       *string    `json:"phone" xorm:"phone"`
+	CreatedAt   time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt   *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*Supplier) TableName() string {
+	return "suppliers"
+}
+
+type NewSupplier struct {
+	Name        string  `validate:"required" json:"name"`
+	Code        string  `validate:"required" json:"code"`
+	ContactName *string `json:"contactName"`
+	Email       *string `validate:"omitempty,email" json:"email"`
+	Phone       *string `json:"phone"`
+}
+
+type UpdateSupplier struct {
+	ID          int64   `json:"id"`
+	Name        *string `json:"name"`
+	Code        *string `json:"code"`
+	ContactName *string `json:"contactName"`
+	Email       *string `json:"email"`
+	Phone       *string `json:"phone"`
+}
+
+// ProductSupplier - the terms a supplier sells a product to us on
+type ProductSupplier struct {
+	ID          int64   `json:"id" xorm:"'id' pk autoincr"`
+	ProductID   int64   `validate:"required" json:"productId" xorm:"product_id"`
+	SupplierID  int64   `validate:"required" json:"supplierId" xorm:"supplier_id"`
+	SupplierSku *string `json:"supplierSku" xorm:"supplier_sku"`
+	// UnitCost - in cents
+	UnitCost     int64      `validate:"min=0" json:"unitCost" xorm:"unit_cost"`
+	MinOrderQty  int64      `validate:"min=1" json:"minOrderQty" xorm:"min_order_qty"`
+	LeadTimeDays int64      `validate:"min=0" json:"leadTimeDays" xorm:"lead_time_days"`
+	Preferred    bool       `json:"preferred" xorm:"preferred"`
+	CreatedAt    time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt    *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*ProductSupplier) TableName() string {
+	return "product_suppliers"
+}
+
+type NewProductSupplier struct {
+	ProductID   int64   `validate:"required" json:"productId"`
+	SupplierID  int64   `validate:"required" json:"supplierId"`
+	SupplierSku *string `json:"supplierSku"`
+	UnitCost    int64   `validate:"min=0" json:"unitCost"`
+	// MinOrderQty - defaults to 1
+	MinOrderQty  int64 `validate:"min=0" json:"minOrderQty"`
+	LeadTimeDays int64 `validate:"min=0" json:"leadTimeDays"`
+	// Preferred - only one supplier per product can be preferred, setting it clears the others
+	Preferred bool `json:"preferred"`
+}
+
+type UpdateProductSupplier struct {
+	ProductID    int64   `json:"productId"`
+	SupplierID   int64   `json:"supplierId"`
+	SupplierSku  *string `json:"supplierSku"`
+	UnitCost     *int64  `json:"unitCost"`
+	MinOrderQty  *int64  `json:"minOrderQty"`
+	LeadTimeDays *int64  `json:"leadTimeDays"`
+	Preferred    *bool   `json:"preferred"`
+}
@@ -0,0 +1,92 @@
+package types
+
+import "time"
+
+// TaxRounding - how tax is rounded to the cent
+type TaxRounding string
+
+const (
+	// TaxRoundingHalfUp - halves round up, the default
+	TaxRoundingHalfUp TaxRounding = "half_up"
+	// TaxRoundingHalfEven - halves round to the even cent
+	TaxRoundingHalfEven TaxRounding = "half_even"
+	TaxRoundingUp       TaxRounding = "up"
+	TaxRoundingDown     TaxRounding = "down"
+)
+
+// TaxRate - a tax charged in a country, or one of its regions when Region is set, on products
+// of a tax category, or every product without a rate of their own category when it's nil.
+// Country wide and regional rates add up, a region's sales tax on top of a national one.
+type TaxRate struct {
+	ID   int64  `json:"id" xorm:"'id' pk autoincr"`
+	Name string `validate:"required" json:"name" xorm:"name"`
+	// Country - the ISO 3166 alpha-2 code, the same as on addresses
+	Country  string  `validate:"iso3166_1_alpha2" json:"country" xorm:"country"`
+	Region   *string `validate:"omitempty,min=1" json:"region" xorm:"region"`
+	Category *string `validate:"omitempty,min=1" json:"category" xorm:"tax_category"`
+	// Rate - a percent, 8.25 is 8.25%. Only 4 decimal places count.
+	Rate     float64     `validate:"min=0" json:"rate" xorm:"rate"`
+	Rounding TaxRounding `validate:"oneof=half_up half_even up down" json:"rounding" xorm:"rounding"`
+	// PerLine - the tax is rounded on each line rather than once on everything it's charged on
+	PerLine   bool       `json:"perLine" xorm:"per_line"`
+	CreatedAt time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt *time.Time `json:"updatedAt" xorm:"updated_at"`
+}
+
+func (*TaxRate) TableName() string {
+	return "tax_rates"
+}
+
+type NewTaxRate struct {
+	Name     string  `validate:"required" json:"name"`
+	Country  string  `validate:"iso3166_1_alpha2" json:"country"`
+	Region   *string `validate:"omitempty,min=1" json:"region"`
+	Category *string `validate:"omitempty,min=1" json:"category"`
+	Rate     float64 `validate:"min=0" json:"rate"`
+	// Rounding - defaults to half_up
+	Rounding TaxRounding `validate:"omitempty,oneof=half_up half_even up down" json:"rounding"`
+	PerLine  bool        `json:"perLine"`
+}
+
+type UpdateTaxRate struct {
+	ID       int64        `json:"id"`
+	Name     *string      `validate:"omitempty,min=1" json:"name"`
+	Country  *string      `validate:"omitempty,iso3166_1_alpha2" json:"country"`
+	Region   *string      `json:"region"`
+	Category *string      `json:"category"`
+	Rate     *float64     `validate:"omitempty,min=0" json:"rate"`
+	Rounding *TaxRounding `validate:"omitempty,oneof=half_up half_even up down" json:"rounding"`
+	PerLine  *bool        `json:"perLine"`
+}
+
+// TaxBreakdown - the tax on a sales order, amounts in cents. It's charged where the order ships
+// to, the customer's default shipping address, and there's none without one.
+type TaxBreakdown struct {
+	Country *string `json:"country"`
+	Region  *string `json:"region"`
+	// Exempt - the customer is tax exempt and nothing is charged
+	Exempt   bool  `json:"exempt"`
+	Subtotal int64 `json:"subtotal"`
+	Tax      int64 `json:"tax"`
+	Total    int64 `json:"total"`
+	// Rates - what each rate charged
+	Rates []*TaxAmount `json:"rates"`
+	// Lines - the tax on each line, adding up to Tax
+	Lines []*LineTax `json:"lines"`
+}
+
+type TaxAmount struct {
+	TaxRateID int64   `json:"taxRateId"`
+	Name      string  `json:"name"`
+	Rate      float64 `json:"rate"`
+	// Taxable - the total of the lines it was charged on
+	Taxable int64 `json:"taxable"`
+	Tax     int64 `json:"tax"`
+}
+
+type LineTax struct {
+	SalesOrderLineID int64 `json:"salesOrderLineId"`
+	// Amount - the line's qty at its unit price
+	Amount int64 `json:"amount"`
+	Tax    int64 `json:"tax"`
+}
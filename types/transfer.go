@@ -0,0 +1,112 @@
+package types
+
+import "time"
+
+type TransferStatus string
+
+const (
+	TransferStatusDraft             TransferStatus = "draft"
+	TransferStatusShipped           TransferStatus = "shipped"
+	TransferStatusPartiallyReceived TransferStatus = "partially_received"
+	TransferStatusReceived          TransferStatus = "received"
+	TransferStatusCancelled         TransferStatus = "cancelled"
+)
+
+var transferTransitions = map[TransferStatus][]TransferStatus{
+	TransferStatusDraft:             {TransferStatusShipped, TransferStatusCancelled},
+	TransferStatusShipped:           {TransferStatusPartiallyReceived, TransferStatusReceived},
+	TransferStatusPartiallyReceived: {TransferStatusPartiallyReceived, TransferStatusReceived},
+}
+
+// CanTransition - whether a transfer in this status may move to the next one. Once it has
+// shipped the stock is in transit and the transfer can't be cancelled.
+func (s TransferStatus) CanTransition(to TransferStatus) bool {
+	for _, allowed := range transferTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// InTransit - whether a transfer in this status has stock on the way
+func (s TransferStatus) InTransit() bool {
+	return s == TransferStatusShipped || s == TransferStatusPartiallyReceived
+}
+
+// Transfer - stock moving from one location to another
+type Transfer struct {
+	ID                    int64          `json:"id" xorm:"'id' pk autoincr"`
+	SourceLocationID      int64          `json:"sourceLocationId" xorm:"source_location_id"`
+	DestinationLocationID int64          `json:"destinationLocationId" xorm:"destination_location_id"`
+	Status                TransferStatus `json:"status" xorm:"status"`
+	Notes                 *string        `json:"notes" xorm:"notes"`
+	ShippedAt             *time.Time     `json:"shippedAt" xorm:"shipped_at"`
+	ReceivedAt            *time.Time     `json:"receivedAt" xorm:"received_at"`
+	CreatedAt             time.Time      `json:"createdAt" xorm:"created_at"`
+	UpdatedAt             *time.Time     `json:"updatedAt" xorm:"updated_at"`
+
+	Lines []*TransferLine `json:"lines" xorm:"-"`
+}
+
+func (*Transfer) TableName() string {
+	return "transfers"
+}
+
+type TransferLine struct {
+	ID         int64 `json:"id" xorm:"'id' pk autoincr"`
+	TransferID int64 `json:"transferId" xorm:"transfer_id"`
+	ProductID  int64 `json:"productId" xorm:"product_id"`
+	Qty        int64 `json:"qty" xorm:"qty"`
+	QtyShipped int64 `json:"qtyShipped" xorm:"qty_shipped"`
+	// QtyReceived - what arrived at the destination
+	QtyReceived int64 `json:"qtyReceived" xorm:"qty_received"`
+	// QtyLost - shipped but written off as a discrepancy when the transfer was closed
+	QtyLost   int64     `json:"qtyLost" xorm:"qty_lost"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*TransferLine) TableName() string {
+	return "transfer_lines"
+}
+
+// InTransit - shipped and not yet received or written off
+func (l *TransferLine) InTransit() int64 {
+	return l.QtyShipped - l.QtyReceived - l.QtyLost
+}
+
+type NewTransfer struct {
+	SourceLocationID      int64             `validate:"required" json:"sourceLocationId"`
+	DestinationLocationID int64             `validate:"required,nefield=SourceLocationID" json:"destinationLocationId"`
+	Notes                 *string           `json:"notes"`
+	Lines                 []NewTransferLine `validate:"required,min=1,dive" json:"lines"`
+}
+
+type NewTransferLine struct {
+	ProductID int64 `validate:"required" json:"productId"`
+	Qty       int64 `validate:"required,min=1" json:"qty"`
+}
+
+// ShipTransfer - lines left out ship their full qty
+type ShipTransfer struct {
+	ID    int64              `json:"id"`
+	Lines []ShipTransferLine `validate:"omitempty,dive" json:"lines"`
+}
+
+type ShipTransferLine struct {
+	TransferLineID int64 `validate:"required" json:"transferLineId"`
+	Qty            int64 `validate:"min=0" json:"qty"`
+}
+
+// ReceiveTransfer - records what arrived, lines left out arrived in full. Close writes off
+// whatever is still in transit as a discrepancy, otherwise it stays in transit for a later receipt.
+type ReceiveTransfer struct {
+	ID    int64                 `json:"id"`
+	Lines []ReceiveTransferLine `validate:"omitempty,dive" json:"lines"`
+	Close bool                  `json:"close"`
+}
+
+type ReceiveTransferLine struct {
+	TransferLineID int64 `validate:"required" json:"transferLineId"`
+	Qty            int64 `validate:"min=0" json:"qty"`
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"github.com/happilymarrieddad/product-inventory-management-system/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TYPES: Transfer", func() {
+	Context("CanTransition", func() {
+		DescribeTable("the transfer lifecycle",
+			func(from, to types.TransferStatus, allowed bool) {
+				Expect(from.CanTransition(to)).To(Equal(allowed))
+			},
+			Entry("draft to shipped", types.TransferStatusDraft, types.TransferStatusShipped, true),
+			Entry("draft to cancelled", types.TransferStatusDraft, types.TransferStatusCancelled, true),
+			Entry("draft to received", types.TransferStatusDraft, types.TransferStatusReceived, false),
+			Entry("shipped to partially received", types.TransferStatusShipped, types.TransferStatusPartiallyReceived, true),
+			Entry("shipped to received", types.TransferStatusShipped, types.TransferStatusReceived, true),
+			Entry("shipped to cancelled", types.TransferStatusShipped, types.TransferStatusCancelled, false),
+			Entry("partially received to received", types.TransferStatusPartiallyReceived, types.TransferStatusReceived, true),
+			Entry("received to shipped", types.TransferStatusReceived, types.TransferStatusShipped, false),
+		)
+	})
+
+	Context("NewTransfer", func() {
+		It("should not allow a transfer to the same location", func() {
+			newTransfer := types.NewTransfer{
+				SourceLocationID: 1, DestinationLocationID: 1,
+				Lines: []types.NewTransferLine{{ProductID: 1, Qty: 1}},
+			}
+			Expect(types.Validate(newTransfer)).NotTo(Succeed())
+
+			newTransfer.DestinationLocationID = 2
+			Expect(types.Validate(newTransfer)).To(Succeed())
+		})
+	})
+
+	Context("TransferLine", func() {
+		It("should count what's shipped and not yet received or lost as in transit", func() {
+			line := &types.TransferLine{Qty: 10, QtyShipped: 8, QtyReceived: 5, QtyLost: 1}
+			Expect(line.InTransit()).To(BeNumerically("==", 2))
+		})
+	})
+})
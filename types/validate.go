@@ -8,6 +8,12 @@ var validator *vCop.Validate
 
 func init() {
 	validator = vCop.New()
+
+	if err := validator.RegisterValidation("gtin", func(fl vCop.FieldLevel) bool {
+		return IsValidGTIN(fl.Field().String())
+	}); err != nil {
+		panic(err)
+	}
 }
 
 // Validate - validates an object based on it's tags
@@ -0,0 +1,74 @@
+package types
+
+import "time"
+
+// CostMethod - how a product's stock is valued as it goes out
+type CostMethod string
+
+const (
+	// CostMethodFIFO - outbound stock is valued at the cost of the oldest layers it comes from
+	CostMethodFIFO CostMethod = "fifo"
+	// CostMethodAverage - outbound stock is valued at the average cost of everything on hand
+	CostMethodAverage CostMethod = "average"
+	// CostMethodStandard - everything is valued at the product's standard cost whatever was paid
+	CostMethodStandard CostMethod = "standard"
+)
+
+// CostLayer - a batch of stock that came in at one unit cost. Outbound stock always draws down
+// the oldest layers first, the product's cost method decides what it's worth as it goes.
+type CostLayer struct {
+	ID        int64 `json:"id" xorm:"'id' pk autoincr"`
+	ProductID int64 `json:"productId" xorm:"product_id"`
+	Qty       int64 `json:"qty" xorm:"qty"`
+	// Remaining - what's left of Qty on hand
+	Remaining int64 `json:"remaining" xorm:"remaining"`
+	// UnitCost - in cents
+	UnitCost  int64     `json:"unitCost" xorm:"unit_cost"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*CostLayer) TableName() string {
+	return "cost_layers"
+}
+
+// ValuationEntry - what a change to a product's qty was worth, in cents. Adding up a product's
+// entries to a point in time gives its qty and value at that time. Entries with no qty revalue
+// stock when its standard cost changes.
+type ValuationEntry struct {
+	ID        int64 `json:"id" xorm:"'id' pk autoincr"`
+	ProductID int64 `json:"productId" xorm:"product_id"`
+	// LocationID - where the qty changed, nil for stock that isn't held at a location
+	LocationID *int64 `json:"locationId" xorm:"location_id"`
+	Qty        int64  `json:"qty" xorm:"qty"`
+	Value      int64  `json:"value" xorm:"value"`
+	// Move - one side of stock moving between locations, see ProductAdjustment
+	Move      bool      `json:"move" xorm:"move"`
+	CreatedAt time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*ValuationEntry) TableName() string {
+	return "valuation_entries"
+}
+
+// ValuationGroupBy - what the valuation report adds its lines up by
+type ValuationGroupBy string
+
+const (
+	ValuationGroupByProduct  ValuationGroupBy = "product"
+	ValuationGroupByCategory ValuationGroupBy = "category"
+	ValuationGroupByLocation ValuationGroupBy = "location"
+)
+
+// ValuationLine - a product, category or location's qty on hand and what it's worth in cents.
+// Only the fields for what the report is grouped by are filled in, a nil category or location
+// is stock without one.
+type ValuationLine struct {
+	ProductID    *int64  `json:"productId"`
+	Sku          *string `json:"sku"`
+	Name         *string `json:"name"`
+	Category     *string `json:"category"`
+	LocationID   *int64  `json:"locationId"`
+	LocationCode *string `json:"locationCode"`
+	Qty          int64   `json:"qty"`
+	Value        int64   `json:"value"`
+}
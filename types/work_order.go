@@ -0,0 +1,89 @@
+package types
+
+import "time"
+
+type WorkOrderStatus string
+
+const (
+	// WorkOrderStatusReleased - components are reserved and completions can be recorded
+	WorkOrderStatusReleased  WorkOrderStatus = "released"
+	WorkOrderStatusCompleted WorkOrderStatus = "completed"
+	WorkOrderStatusCancelled WorkOrderStatus = "cancelled"
+)
+
+var workOrderTransitions = map[WorkOrderStatus][]WorkOrderStatus{
+	WorkOrderStatusReleased: {WorkOrderStatusReleased, WorkOrderStatusCompleted, WorkOrderStatusCancelled},
+}
+
+// CanTransition - whether a work order in this status may move to the next one. Completed and
+// cancelled work orders are closed.
+func (s WorkOrderStatus) CanTransition(to WorkOrderStatus) bool {
+	for _, allowed := range workOrderTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkOrder - making a product from its bill of materials
+type WorkOrder struct {
+	ID        int64 `json:"id" xorm:"'id' pk autoincr"`
+	ProductID int64 `json:"productId" xorm:"product_id"`
+	// Qty - how many were planned
+	Qty          int64 `validate:"min=1" json:"qty" xorm:"qty"`
+	QtyCompleted int64 `validate:"min=0" json:"qtyCompleted" xorm:"qty_completed"`
+	// QtyScrapped - started but spoiled, their components are used up and nothing is made
+	QtyScrapped int64           `validate:"min=0" json:"qtyScrapped" xorm:"qty_scrapped"`
+	Status      WorkOrderStatus `json:"status" xorm:"status"`
+	// LocationID - where finished goods are put, nil leaves them outside of any location
+	LocationID  *int64     `json:"locationId" xorm:"location_id"`
+	Notes       *string    `json:"notes" xorm:"notes"`
+	CompletedAt *time.Time `json:"completedAt" xorm:"completed_at"`
+	CreatedAt   time.Time  `json:"createdAt" xorm:"created_at"`
+	UpdatedAt   *time.Time `json:"updatedAt" xorm:"updated_at"`
+
+	Components []*WorkOrderComponent `json:"components" xorm:"-"`
+}
+
+func (*WorkOrder) TableName() string {
+	return "work_orders"
+}
+
+// Remaining - how many haven't been completed or scrapped yet
+func (w *WorkOrder) Remaining() int64 {
+	return w.Qty - w.QtyCompleted - w.QtyScrapped
+}
+
+// WorkOrderComponent - a component the work order uses, copied from the bill of materials when
+// it's released
+type WorkOrderComponent struct {
+	ID          int64 `json:"id" xorm:"'id' pk autoincr"`
+	WorkOrderID int64 `json:"workOrderId" xorm:"work_order_id"`
+	ComponentID int64 `json:"componentId" xorm:"component_id"`
+	// QtyPer - how many go into one finished unit
+	QtyPer int64 `json:"qtyPer" xorm:"qty_per"`
+	// QtyReserved - allocated to the work order and not used up yet
+	QtyReserved int64     `json:"qtyReserved" xorm:"qty_reserved"`
+	QtyConsumed int64     `json:"qtyConsumed" xorm:"qty_consumed"`
+	CreatedAt   time.Time `json:"createdAt" xorm:"created_at"`
+}
+
+func (*WorkOrderComponent) TableName() string {
+	return "work_order_components"
+}
+
+// NewWorkOrder - work orders are released as they're created, reserving every component they need
+type NewWorkOrder struct {
+	ProductID  int64   `validate:"required" json:"productId"`
+	Qty        int64   `validate:"required,min=1" json:"qty"`
+	LocationID *int64  `json:"locationId"`
+	Notes      *string `json:"notes"`
+}
+
+// CompleteWorkOrder - records units finished and scrapped since the last completion
+type CompleteWorkOrder struct {
+	ID           int64 `json:"id"`
+	QtyCompleted int64 `validate:"min=0" json:"qtyCompleted"`
+	QtyScrapped  int64 `validate:"min=0" json:"qtyScrapped"`
+}